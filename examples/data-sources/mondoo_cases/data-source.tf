@@ -0,0 +1,8 @@
+data "mondoo_cases" "open" {
+  space_id = "your-space-1234567"
+}
+
+output "open_case_tickets" {
+  value       = { for c in data.mondoo_cases.open.cases : c.title => c.ticket_urls }
+  description = "The ticket URLs of the open cases in the space."
+}
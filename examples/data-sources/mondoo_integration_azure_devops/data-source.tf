@@ -0,0 +1,18 @@
+variable "azure_devops_integration_name" {
+  description = "The name of the AzureDevops integration"
+  type        = string
+}
+
+provider "mondoo" {
+  space = "hungry-poet-123456"
+}
+
+# Look up an existing AzureDevops integration by name
+data "mondoo_integration_azure_devops" "example" {
+  name = var.azure_devops_integration_name
+}
+
+output "azure_devops_integration_mrn" {
+  description = "The MRN of the AzureDevops integration"
+  value       = data.mondoo_integration_azure_devops.example.mrn
+}
@@ -0,0 +1,18 @@
+variable "google_workspace_integration_name" {
+  description = "The name of the GoogleWorkspace integration"
+  type        = string
+}
+
+provider "mondoo" {
+  space = "hungry-poet-123456"
+}
+
+# Look up an existing GoogleWorkspace integration by name
+data "mondoo_integration_google_workspace" "example" {
+  name = var.google_workspace_integration_name
+}
+
+output "google_workspace_integration_mrn" {
+  description = "The MRN of the GoogleWorkspace integration"
+  value       = data.mondoo_integration_google_workspace.example.mrn
+}
@@ -0,0 +1,18 @@
+variable "okta_integration_name" {
+  description = "The name of the Okta integration"
+  type        = string
+}
+
+provider "mondoo" {
+  space = "hungry-poet-123456"
+}
+
+# Look up an existing Okta integration by name
+data "mondoo_integration_okta" "example" {
+  name = var.okta_integration_name
+}
+
+output "okta_integration_mrn" {
+  description = "The MRN of the Okta integration"
+  value       = data.mondoo_integration_okta.example.mrn
+}
@@ -0,0 +1,9 @@
+data "mondoo_service_account" "ci" {
+  space_id = "your-space-1234567"
+  name     = "CI pipeline"
+}
+
+output "ci_service_account_roles" {
+  value       = data.mondoo_service_account.ci.roles
+  description = "The roles of the CI pipeline service account."
+}
@@ -0,0 +1,9 @@
+data "mondoo_service_accounts" "stale" {
+  space_id    = "your-space-1234567"
+  unused_days = 90
+}
+
+output "stale_service_accounts" {
+  value       = { for sa in data.mondoo_service_accounts.stale.service_accounts : sa.name => sa.last_used_at }
+  description = "The service accounts that weren't used in the last 90 days."
+}
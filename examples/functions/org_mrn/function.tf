@@ -0,0 +1,12 @@
+resource "mondoo_asset_routing_rule" "production" {
+  org_mrn          = provider::mondoo::org_mrn("reverent-ride-275852")
+  target_space_mrn = provider::mondoo::space_mrn("hungry-poet-123456")
+  priority         = 10
+
+  condition {
+    field    = "LABEL"
+    operator = "EQUAL"
+    key      = "env"
+    values   = ["production"]
+  }
+}
@@ -0,0 +1,13 @@
+locals {
+  integration = provider::mondoo::parse_mrn("//integration.api.mondoo.app/spaces/hungry-poet-123456/integrations/2Abd08lk860")
+}
+
+# "hungry-poet-123456"
+output "integration_space_id" {
+  value = local.integration.space_id
+}
+
+# "//captain.api.mondoo.app/spaces/hungry-poet-123456"
+output "integration_scope_mrn" {
+  value = local.integration.scope_mrn
+}
@@ -0,0 +1,9 @@
+# Policy maintained by Mondoo
+output "aws_security_policy" {
+  value = provider::mondoo::policy_mrn("mondoo", "mondoo-aws-security")
+}
+
+# Custom policy uploaded to a space
+output "custom_policy" {
+  value = provider::mondoo::policy_mrn("hungry-poet-123456", "my-custom-policy")
+}
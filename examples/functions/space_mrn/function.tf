@@ -0,0 +1,9 @@
+resource "mondoo_policy_assignment" "space" {
+  scope_mrn = provider::mondoo::space_mrn("hungry-poet-123456")
+
+  policies = [
+    provider::mondoo::policy_mrn("mondoo", "mondoo-aws-security"),
+  ]
+
+  state = "enabled"
+}
@@ -0,0 +1,2 @@
+# Import using the case MRN.
+terraform import mondoo_case.bastion_ssh "//captain.api.mondoo.app/spaces/hungry-poet-123456/cases/2wMrrVnPsDDu9Mvm0IvyoU1MRF1"
@@ -0,0 +1,39 @@
+variable "jira_token" {
+  description = "The Jira API Token"
+  type        = string
+  sensitive   = true
+}
+
+provider "mondoo" {
+  space = "hungry-poet-123456"
+}
+
+resource "mondoo_integration_jira" "jira" {
+  name            = "Security Jira"
+  host            = "https://your-instance.atlassian.net"
+  email           = "jira.owner@email.com"
+  default_project = "SEC"
+
+  credentials = {
+    token = var.jira_token
+  }
+}
+
+# Track the remediation of the OpenSSH findings that the bastion host rollout fixes
+resource "mondoo_case" "bastion_ssh" {
+  integration_mrn = mondoo_integration_jira.jira.mrn
+  title           = "Harden SSH on bastion hosts"
+  notes           = "Remediated by the bastion host rollout in the infrastructure repository."
+
+  check_mrns = [
+    "//policy.api.mondoo.app/queries/mondoo-linux-security-permissions-on-etcsshsshd_config-are-configured",
+  ]
+
+  vulnerability_mrns = [
+    "//vadvisor.api.mondoo.app/cves/CVE-2024-6387",
+  ]
+}
+
+output "bastion_ssh_tickets" {
+  value = mondoo_case.bastion_ssh.ticket_urls
+}
@@ -0,0 +1,2 @@
+# Import using the case routing rule MRN.
+terraform import mondoo_case_routing_rule.platform "//policy.api.mondoo.app/spaces/hungry-poet-123456/case-routing-rules/2Abd08lk860"
@@ -0,0 +1,66 @@
+provider "mondoo" {
+  space = "hungry-poet-123456"
+}
+
+variable "jira_token" {
+  description = "The Jira API token"
+  type        = string
+  sensitive   = true
+}
+
+resource "mondoo_integration_jira" "jira" {
+  name            = "Jira Integration"
+  host            = "https://your-instance.atlassian.net"
+  email           = "jira.owner@email.com"
+  default_project = "SEC"
+  auto_create     = true
+  auto_close      = true
+
+  credentials = {
+    token = var.jira_token
+  }
+}
+
+# Send critical and high findings on assets of the platform team to their
+# project and assign them to the on-call engineer.
+resource "mondoo_case_routing_rule" "platform" {
+  integration_mrn = mondoo_integration_jira.jira.mrn
+  priority        = 10
+
+  target = {
+    project    = "PLAT"
+    issue_type = "Bug"
+    assignee   = "platform-oncall@example.com"
+    priority   = "Highest"
+  }
+
+  condition {
+    field    = "SEVERITY"
+    operator = "AT_LEAST"
+    values   = ["HIGH"]
+  }
+
+  condition {
+    field    = "ASSET_LABEL"
+    operator = "EQUAL"
+    key      = "team"
+    values   = ["platform"]
+  }
+}
+
+# Route findings of the CIS benchmark to the compliance team.
+resource "mondoo_case_routing_rule" "compliance" {
+  integration_mrn = mondoo_integration_jira.jira.mrn
+  priority        = 20
+
+  target = {
+    project    = "GRC"
+    issue_type = "Task"
+  }
+
+  condition {
+    field    = "POLICY"
+    operator = "EQUAL"
+    values   = ["//policy.api.mondoo.app/policies/cis-ubuntu-22.04-lts-level-1"]
+  }
+}
@@ -0,0 +1,2 @@
+# Import using the framework MRN.
+terraform import mondoo_framework.example "//policy.api.mondoo.app/spaces/hungry-poet-123456/frameworks/example-framework"
@@ -0,0 +1,41 @@
+provider "mondoo" {
+  space = "hungry-poet-123456"
+}
+
+resource "mondoo_framework" "example" {
+  uid         = "example-framework"
+  name        = "Example Framework"
+  version     = "1.0.0"
+  description = "Controls that every production workload must satisfy."
+
+  tags = {
+    "mondoo.com/category" = "compliance"
+  }
+
+  groups = {
+    "example-framework-asset-management" = {
+      title = "Asset Management"
+
+      controls = {
+        "example-framework-am-01" = {
+          title       = "Asset Inventory"
+          description = "Maintain an up-to-date inventory of all assets."
+          policies    = ["//policy.api.mondoo.app/policies/mondoo-asset-inventory"]
+        }
+
+        "example-framework-am-02" = {
+          title       = "Secure SSH Configuration"
+          description = "SSH must not permit root login."
+          checks      = ["//policy.api.mondoo.app/queries/mondoo-linux-security-permitrootlogin-is-set-to-no"]
+
+          evidence = {
+            "example-framework-am-02-review" = {
+              title       = "Quarterly access review"
+              description = "Record of the quarterly review of SSH access."
+            }
+          }
+        }
+      }
+    }
+  }
+}
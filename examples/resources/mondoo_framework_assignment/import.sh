@@ -0,0 +1,2 @@
+# Import the frameworks that are enabled or in preview using the space ID.
+terraform import mondoo_framework_assignment.framework_assignment "hungry-poet-123456"
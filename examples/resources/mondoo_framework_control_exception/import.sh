@@ -0,0 +1,5 @@
+# Import an exception in the provider space using its ID.
+terraform import mondoo_framework_control_exception.physical_security "0f3c2b8e-6f0a-4c1d-9b7e-2a5d8c4e1f90"
+
+# Import an exception of another scope using the scope MRN and the exception ID.
+terraform import mondoo_framework_control_exception.physical_security "//captain.api.mondoo.app/spaces/hungry-poet-123456:0f3c2b8e-6f0a-4c1d-9b7e-2a5d8c4e1f90"
@@ -0,0 +1,20 @@
+provider "mondoo" {
+  space = "hungry-poet-123456"
+}
+
+resource "mondoo_framework_control_exception" "physical_security" {
+  control_mrns = [
+    "//policy.api.mondoo.app/controls/mondoo-soc2-cc6.4",
+    "//policy.api.mondoo.app/controls/mondoo-soc2-cc6.5",
+  ]
+  justification = "Physical security of the data centers is covered by the cloud provider's SOC 2 report."
+}
+
+resource "mondoo_framework_control_exception" "encryption_rollout" {
+  control_mrns = [
+    "//policy.api.mondoo.app/controls/mondoo-soc2-cc6.7",
+  ]
+  action        = "SNOOZE"
+  justification = "Encryption in transit is rolled out with the next platform release."
+  valid_until   = "2026-12-31"
+}
@@ -0,0 +1,2 @@
+# Import using the framework evidence MRN.
+terraform import mondoo_framework_evidence.access_review "//policy.api.mondoo.app/spaces/hungry-poet-123456/evidences/2Abd08lk860"
@@ -0,0 +1,18 @@
+provider "mondoo" {
+  space = "hungry-poet-123456"
+}
+
+# Upload a document as evidence
+resource "mondoo_framework_evidence" "access_review" {
+  control_mrn = "//policy.api.mondoo.app/controls/mondoo-soc2-cc6.2"
+  title       = "Quarterly access review"
+  description = "Signed-off record of the latest access review."
+  document    = "access-review.pdf"
+}
+
+# Link to evidence that lives in another system
+resource "mondoo_framework_evidence" "incident_response_plan" {
+  control_mrn = "//policy.api.mondoo.app/controls/mondoo-soc2-cc7.3"
+  title       = "Incident response plan"
+  url         = "https://wiki.example.com/security/incident-response"
+}
@@ -8,3 +8,24 @@ resource "mondoo_iam_workload_identity_binding" "example" {
   subject    = "repo:mondoohq/server:ref:refs/heads/main"
   expiration = 3600
 }
+
+# Presets render the issuer, subject, audience and claim mappings
+resource "mondoo_iam_workload_identity_binding" "github" {
+  name  = "GitHub Actions deployments"
+  roles = ["editor"]
+
+  github_actions {
+    repository  = "mondoohq/server"
+    environment = "production"
+  }
+}
+
+resource "mondoo_iam_workload_identity_binding" "gitlab" {
+  name = "GitLab CI main branch"
+
+  gitlab_ci {
+    project_path = "my-group/my-project"
+    ref_type     = "branch"
+    ref          = "main"
+  }
+}
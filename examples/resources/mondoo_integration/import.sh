@@ -0,0 +1,2 @@
+# Import using integration MRN.
+terraform import mondoo_integration.okta "//captain.api.mondoo.app/spaces/hungry-poet-123456/integrations/2Abd08lk860"
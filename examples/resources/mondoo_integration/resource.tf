@@ -0,0 +1,28 @@
+variable "okta_token" {
+  description = "The Okta API token"
+  type        = string
+  sensitive   = true
+}
+
+provider "mondoo" {
+  space = "hungry-poet-123456"
+}
+
+# Setup an Okta integration without a dedicated resource
+resource "mondoo_integration" "okta" {
+  name = "Okta"
+  type = "OKTA"
+
+  configuration = jsonencode({
+    oktaConfigurationOptions = {
+      organization = "example.okta.com"
+    }
+  })
+
+  # Secrets are merged into the configuration and hidden in plans
+  sensitive_configuration = jsonencode({
+    oktaConfigurationOptions = {
+      token = var.okta_token
+    }
+  })
+}
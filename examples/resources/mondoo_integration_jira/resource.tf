@@ -18,6 +18,32 @@ resource "mondoo_integration_jira" "jira_integration" {
   auto_create = true
   auto_close  = true
 
+  # Map Mondoo findings onto your Jira project
+  issue_type = "Bug"
+  labels     = ["mondoo", "security"]
+  components = ["Infrastructure"]
+
+  priority_mapping = {
+    CRITICAL = "Highest"
+    HIGH     = "High"
+    MEDIUM   = "Medium"
+    LOW      = "Low"
+  }
+
+  custom_fields = [
+    {
+      id    = "customfield_10010"
+      value = jsonencode({ value = "Security" })
+    },
+  ]
+
+  summary_template     = "[{{ .Severity }}] {{ .Title }} on {{ .AssetName }}"
+  description_template = <<-EOT
+    {{ .Title }} was found on {{ .AssetName }} in {{ .SpaceName }}.
+
+    See {{ .Url }} for details.
+  EOT
+
   credentials = {
     token = var.jira_token
   }
@@ -0,0 +1,32 @@
+provider "mondoo" {
+  space = "hungry-poet-123456"
+}
+
+# Register the Kubernetes cluster
+resource "mondoo_integration_k8s" "production" {
+  name                = "Production cluster"
+  cluster_name        = "production"
+  scan_nodes          = true
+  scan_workloads      = true
+  namespace_deny_list = ["kube-system"]
+  schedule            = "0 */6 * * *"
+}
+
+# Store the registration token for the Mondoo operator
+resource "kubernetes_secret" "mondoo_token" {
+  metadata {
+    name      = "mondoo-token"
+    namespace = mondoo_integration_k8s.production.operator_namespace
+  }
+
+  data = {
+    token = mondoo_integration_k8s.production.registration_token
+  }
+}
+
+# Configure the Mondoo operator to scan the cluster
+resource "kubernetes_manifest" "mondoo_audit_config" {
+  manifest = yamldecode(mondoo_integration_k8s.production.operator_manifest)
+
+  depends_on = [kubernetes_secret.mondoo_token]
+}
@@ -0,0 +1,26 @@
+variable "msteams_webhook_url" {
+  description = "The Microsoft Teams incoming webhook URL"
+  type        = string
+  sensitive   = true
+}
+
+provider "mondoo" {
+  space = "hungry-poet-123456"
+}
+
+# Post notifications with an incoming webhook
+resource "mondoo_integration_msteams" "msteams_integration" {
+  name        = "Security Alerts"
+  webhook_url = var.msteams_webhook_url
+}
+
+# Post notifications with the Mondoo app for Microsoft Teams
+resource "mondoo_integration_msteams" "msteams_app_integration" {
+  name = "Platform Team"
+
+  app = {
+    tenant_id  = "ffffffff-ffff-ffff-ffff-ffffffffffff"
+    team_id    = "eeeeeeee-eeee-eeee-eeee-eeeeeeeeeeee"
+    channel_id = "19:abc123@thread.tacv2"
+  }
+}
@@ -0,0 +1,21 @@
+variable "pagerduty_routing_key" {
+  description = "The integration key of the PagerDuty Events API v2 integration"
+  type        = string
+  sensitive   = true
+}
+
+provider "mondoo" {
+  space = "hungry-poet-123456"
+}
+
+# Setup the PagerDuty integration
+resource "mondoo_integration_pagerduty" "pagerduty_integration" {
+  name        = "On-call Security"
+  routing_key = var.pagerduty_routing_key
+
+  severity_mapping = {
+    CRITICAL = "critical"
+    HIGH     = "error"
+    MEDIUM   = "warning"
+  }
+}
@@ -12,4 +12,18 @@ provider "mondoo" {
 resource "mondoo_integration_slack" "slack_integration" {
   name        = "My Slack Integration"
   slack_token = var.slack_token
+
+  # Critical findings and failed scans go to the security channel
+  channel {
+    channel_id         = "C0123456789"
+    severity_threshold = "CRITICAL"
+    event_types        = ["NEW_FINDING", "SCAN_FAILURE"]
+  }
+
+  # Score drops of the production workspace go to the platform channel
+  channel {
+    channel_id     = "C9876543210"
+    event_types    = ["SCORE_DROP"]
+    workspace_mrns = ["//captain.api.mondoo.app/spaces/hungry-poet-123456/workspaces/production"]
+  }
 }
@@ -0,0 +1,35 @@
+variable "event_bus_token" {
+  description = "The token to authenticate with the event bus"
+  type        = string
+  sensitive   = true
+}
+
+variable "webhook_secret" {
+  description = "The secret to sign the webhook requests with"
+  type        = string
+  sensitive   = true
+}
+
+provider "mondoo" {
+  space = "hungry-poet-123456"
+}
+
+# Send critical findings, scan failures and score drops to the internal event bus
+resource "mondoo_integration_webhook" "event_bus" {
+  name           = "Event Bus"
+  url            = "https://events.example.com/ingest/mondoo"
+  payload_format = "CLOUDEVENTS"
+
+  events = [
+    "NEW_CRITICAL_FINDING",
+    "SCAN_FAILURE",
+    "SCORE_DROP",
+  ]
+
+  headers = {
+    Authorization = "Bearer ${var.event_bus_token}"
+  }
+
+  secret          = var.webhook_secret
+  send_test_event = true
+}
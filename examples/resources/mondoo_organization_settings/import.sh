@@ -0,0 +1,2 @@
+# Import using the organization ID.
+terraform import mondoo_organization_settings.org "my-org-123456"
@@ -0,0 +1,28 @@
+variable "org_id" {
+  description = "The ID of the organization to configure"
+  type        = string
+}
+
+provider "mondoo" {}
+
+resource "mondoo_organization_settings" "org" {
+  org_id = var.org_id
+
+  # Only invite users with a company email address
+  allowed_email_domains = ["example.com", "example.org"]
+
+  require_mfa     = true
+  session_timeout = 720 # 12 hours
+  idle_timeout    = 60
+
+  # Settings that new spaces start with
+  default_space_settings = {
+    garbage_collect_assets_configuration = {
+      enabled    = true
+      after_days = 30
+    }
+    exceptions_configuration = {
+      require_approval = true
+    }
+  }
+}
@@ -0,0 +1,2 @@
+# Import using the organization ID.
+terraform import mondoo_organization_sso.okta "my-org-123456"
@@ -0,0 +1,46 @@
+variable "org_id" {
+  description = "The ID of the organization to connect to the identity provider"
+  type        = string
+}
+
+provider "mondoo" {}
+
+resource "mondoo_organization_sso" "okta" {
+  org_id = var.org_id
+
+  saml = {
+    metadata_url = "https://example.okta.com/app/exk123456789/sso/saml/metadata"
+  }
+
+  attribute_mapping = {
+    first_name = "firstName"
+    last_name  = "lastName"
+  }
+
+  # Provision users and groups, with a new token every 90 days
+  scim = {
+    token_rotation_days = 90
+  }
+}
+
+# Single sign-on with OpenID Connect instead of SAML
+# resource "mondoo_organization_sso" "entra_id" {
+#   org_id = var.org_id
+#
+#   oidc = {
+#     issuer        = "https://login.microsoftonline.com/00000000-0000-0000-0000-000000000000/v2.0"
+#     client_id     = "11111111-1111-1111-1111-111111111111"
+#     client_secret = var.client_secret
+#   }
+# }
+
+output "acs_url" {
+  description = "Assertion consumer service URL to configure in the identity provider"
+  value       = mondoo_organization_sso.okta.acs_url
+}
+
+output "scim_token" {
+  description = "SCIM token to configure in the identity provider"
+  value       = mondoo_organization_sso.okta.scim_token
+  sensitive   = true
+}
@@ -36,6 +36,27 @@ resource "mondoo_registration_token" "token" {
   ]
 }
 
+resource "mondoo_registration_token" "rotated" {
+  description = "Rotated registration token for the agents"
+  count       = length(var.space_names)
+  space_id    = mondoo_space.my_space[count.index].id
+  expires_in  = "720h"
+
+  # labels and default annotations of the assets that register with the token
+  labels = {
+    env = "production"
+  }
+  annotations = {
+    owner = "platform-team"
+  }
+
+  # replace the token a week before it expires, the previous token stays valid for 3 days
+  rotation {
+    rotate_before = "168h"
+    grace_period  = "72h"
+  }
+}
+
 output "space_registration_token" {
   description = "The list of space registration tokens for the specified spaces"
   value = [
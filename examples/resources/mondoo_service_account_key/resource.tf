@@ -0,0 +1,28 @@
+provider "mondoo" {
+  space = "hungry-poet-123456"
+}
+
+resource "mondoo_service_account" "ci" {
+  name        = "CI pipeline"
+  description = "Service account of the CI pipeline"
+  roles = [
+    "//iam.api.mondoo.app/roles/editor",
+  ]
+}
+
+# Issue a new key every 30 days, the service account and its MRN stay the same
+resource "mondoo_service_account_key" "ci" {
+  service_account_mrn = mondoo_service_account.ci.mrn
+  description         = "Key of the CI pipeline"
+  rotation_days       = 30
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+output "ci_credential" {
+  description = "Service account credential as Base64"
+  value       = mondoo_service_account_key.ci.credential
+  sensitive   = true
+}
@@ -14,6 +14,14 @@ resource "mondoo_space" "new_space" {
   # id = "your-space-id"
 }
 
+resource "mondoo_space" "protected_space" {
+  name   = "My Production Space"
+  org_id = var.org_id
+
+  # refuse `terraform destroy` until this is set to false and applied
+  deletion_protection = true
+}
+
 resource "mondoo_space" "custom_space" {
   name        = "My Custom Space"
   description = "A space used to secure my environment"
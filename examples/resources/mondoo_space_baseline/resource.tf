@@ -0,0 +1,60 @@
+variable "org_id" {
+  description = "The ID of the organization in which to create the spaces"
+  type        = string
+}
+
+provider "mondoo" {}
+
+resource "mondoo_space" "team_a" {
+  name   = "Team A"
+  org_id = var.org_id
+}
+
+resource "mondoo_space" "team_b" {
+  name   = "Team B"
+  org_id = var.org_id
+}
+
+# Reconcile a space against an inline baseline
+resource "mondoo_space_baseline" "team_a" {
+  space_id = mondoo_space.team_a.id
+
+  policies = [
+    {
+      mrn = "//policy.api.mondoo.app/policies/mondoo-linux-security"
+    },
+    {
+      mrn   = "//policy.api.mondoo.app/policies/mondoo-kubernetes-security"
+      state = "preview"
+    },
+  ]
+
+  frameworks = [
+    {
+      mrn = "//policy.api.mondoo.app/frameworks/cis-controls-8"
+    },
+  ]
+
+  space_settings = {
+    exceptions_configuration = {
+      require_approval = true
+    }
+    garbage_collect_assets_configuration = {
+      enabled    = true
+      after_days = 30
+    }
+  }
+}
+
+# Reconcile a space against a template space
+resource "mondoo_space_baseline" "team_b" {
+  space_id           = mondoo_space.team_b.id
+  template_space_mrn = mondoo_space.team_a.mrn
+
+  depends_on = [mondoo_space_baseline.team_a]
+}
+
+output "team_b_drift" {
+  description = "Items of the Team B space that differ from the template"
+  value       = mondoo_space_baseline.team_b.drift
+}
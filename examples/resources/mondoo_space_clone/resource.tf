@@ -0,0 +1,29 @@
+variable "org_id" {
+  description = "The ID of the organization in which to create the spaces"
+  type        = string
+}
+
+variable "template_space_id" {
+  description = "The ID of the space to copy"
+  type        = string
+}
+
+provider "mondoo" {}
+
+resource "mondoo_space" "team_c" {
+  name   = "Team C"
+  org_id = var.org_id
+}
+
+# Copy everything except the workspaces of the template space
+resource "mondoo_space_clone" "team_c" {
+  space_id        = mondoo_space.team_c.id
+  source_space_id = var.template_space_id
+
+  copy_workspaces = false
+}
+
+output "team_c_policies" {
+  description = "Custom policies copied into the Team C space"
+  value       = mondoo_space_clone.team_c.policy_mrns
+}
@@ -42,3 +42,51 @@ resource "mondoo_workspace" "my_workspace" {
   ]
 }
 
+# Assets that have not been scanned in the last 30 days, excluding
+# Kubernetes clusters and assets created this year.
+resource "mondoo_workspace" "stale_assets" {
+  name           = "Stale Assets"
+  preview_assets = true
+
+  asset_selections = [
+    {
+      conditions = [
+        {
+          operator = "AND"
+          date_condition = {
+            field         = "LAST_SCANNED"
+            operator      = "BEFORE"
+            relative_days = 30
+          }
+        },
+        {
+          operator = "AND"
+          group = {
+            operator = "NOT"
+            conditions = [
+              {
+                string_condition = {
+                  field    = "PLATFORM"
+                  operator = "EQUAL"
+                  values   = ["k8s-cluster"]
+                }
+              },
+              {
+                date_condition = {
+                  field    = "CREATED"
+                  operator = "AFTER"
+                  value    = "2026-01-01"
+                }
+              }
+            ]
+          }
+        }
+      ]
+    }
+  ]
+}
+
+output "stale_asset_count" {
+  value = mondoo_workspace.stale_assets.asset_count
+}
+
@@ -5,14 +5,20 @@ package main
 
 import (
 	"bytes"
+	"embed"
 	"fmt"
+	"go/ast"
 	"go/format"
+	"go/parser"
+	"go/token"
 	"log"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"reflect"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -32,6 +38,44 @@ type IntegrationResource struct {
 	ResourceClassName     string
 	TerraformResourceName string
 	Fields                map[string]Field
+	// Objects are the nested input objects of the integration, each of them is rendered
+	// as a model type with conversions from and to the API types.
+	Objects []*Object
+}
+
+// Object is a nested input object of an integration, like a list of projects or a scan configuration.
+type Object struct {
+	// ModelName is the Terraform model type, e.g. integrationGitlabProjectModel
+	ModelName string
+	// PayloadName is the type used to read the object from the API, e.g. GitlabProjectPayload
+	PayloadName string
+	// InputType is the mondoo-go input type, e.g. mondoov1.GitlabProjectInput
+	InputType string
+	// Description is the name used in the attribute descriptions, e.g. GitlabProject
+	Description string
+	Fields      map[string]Field
+}
+
+// NewModelFunc returns the name of the function that converts the payload into the model.
+func (o *Object) NewModelFunc() string {
+	return "new" + strings.ToUpper(o.ModelName[:1]) + o.ModelName[1:]
+}
+
+// HasEnumList returns true if the integration has a list of enums, which needs the list validators.
+func (r IntegrationResource) HasEnumList() bool {
+	for _, f := range r.Fields {
+		if f.Kind == ListKind && f.Elem.Kind == EnumKind {
+			return true
+		}
+	}
+	for _, o := range r.Objects {
+		for _, f := range o.Fields {
+			if f.Kind == ListKind && f.Elem.Kind == EnumKind {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 func NewField(base Field, raw any) Field {
@@ -39,8 +83,22 @@ func NewField(base Field, raw any) Field {
 	return base
 }
 
+// FieldKind is the kind of value an integration option holds.
+type FieldKind int
+
+const (
+	ScalarKind FieldKind = iota
+	EnumKind
+	ListKind
+	MapKind
+	ObjectKind
+	ObjectListKind
+)
+
 type Field struct {
 	RawStruct           any
+	Kind                FieldKind
+	Pointer             bool
 	GoType              string
 	MondooType          string
 	TerraformType       string
@@ -48,11 +106,18 @@ type Field struct {
 	TerraformSubType    string
 	HclType             string
 	GoFmtVerb           string
+
+	// EnumValues are the values of enum fields, lists of enums use the values of Elem
+	EnumValues []string
+	// Elem is the element of lists and maps
+	Elem *Field
+	// Object is the nested input object of object fields and lists of objects
+	Object *Object
 }
 
 func (f Field) GoTestValue(name string, testcase int) string {
 	switch f.MondooType {
-	case BooleanField.MondooType:
+	case BooleanField.MondooType, BooleanPtrField.MondooType:
 		if testcase%2 == 0 {
 			return "true"
 		}
@@ -61,24 +126,129 @@ func (f Field) GoTestValue(name string, testcase int) string {
 		return fmt.Sprintf("\"%s_%d\"", name, testcase)
 	case StringPtrField.MondooType:
 		return fmt.Sprintf("\"%s_%d\"", name, testcase)
-	case ArrayStringPtrField.MondooType:
+	case IntField.MondooType, IntPtrField.MondooType:
+		return fmt.Sprintf("%d", testcase)
+	case ArrayStringPtrField.MondooType, ArrayStringField.MondooType:
 		return fmt.Sprintf("[]string{\"%s_%d\"}", name, testcase)
+	case ArrayIntField.MondooType, ArrayIntPtrField.MondooType:
+		return fmt.Sprintf("[]int32{%d}", testcase)
+	}
+	switch f.Kind {
+	case EnumKind:
+		return fmt.Sprintf("%q", f.enumTestValue(testcase))
+	case ListKind:
+		if f.Elem.Kind == EnumKind {
+			return fmt.Sprintf("[]string{%q}", f.Elem.enumTestValue(testcase))
+		}
+	case MapKind, ObjectKind, ObjectListKind:
+		// complex values are passed to the test configuration as HCL
+		return strconv.Quote(f.HclTestValue(name, testcase))
+	}
+	return "\"unimplemented: check gen/gen.go\""
+}
+
+// IsScalar returns true if the field holds a single boolean, string or int value.
+func (f Field) IsScalar() bool {
+	return f.Kind == ScalarKind
+}
+
+// GoTestType returns the Go type used to pass a test value of the field to the test configuration.
+func (f Field) GoTestType() string {
+	switch f.Kind {
+	case ScalarKind:
+		return strings.TrimPrefix(f.GoType, "*")
+	case EnumKind:
+		return "string"
+	case ListKind:
+		return "[]" + f.Elem.GoTestType()
+	}
+	return "string"
+}
+
+// HclTestValue returns a test value of the field as HCL expression.
+func (f Field) HclTestValue(name string, testcase int) string {
+	switch f.Kind {
+	case ScalarKind, EnumKind:
+		return f.GoTestValue(name, testcase)
+	case ListKind:
+		return "[" + f.Elem.HclTestValue(name, testcase) + "]"
+	case MapKind:
+		return fmt.Sprintf("{ key_%d = %s }", testcase, f.Elem.HclTestValue(name, testcase))
+	case ObjectKind:
+		return f.Object.hclTestValue(testcase)
+	case ObjectListKind:
+		return "[" + f.Object.hclTestValue(testcase) + "]"
 	}
 	return "\"unimplemented: check gen/gen.go\""
 }
 
+func (o *Object) hclTestValue(testcase int) string {
+	keys := sortedKeys(o.Fields)
+	attrs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		attrs = append(attrs, fmt.Sprintf("%s = %s", toSnakeCase(key), o.Fields[key].HclTestValue(key, testcase)))
+	}
+	return "{ " + strings.Join(attrs, ", ") + " }"
+}
+
+func (f Field) enumTestValue(testcase int) string {
+	if len(f.EnumValues) == 0 {
+		return fmt.Sprintf("value_%d", testcase)
+	}
+	return f.EnumValues[(testcase-1)%len(f.EnumValues)]
+}
+
 func (f Field) ConfigurationOption(name string) string {
 	switch f.MondooType {
 	case BooleanField.MondooType:
 		return fmt.Sprintf("mondoov1.Boolean(m.%s.ValueBool())", name)
+	case BooleanPtrField.MondooType:
+		return fmt.Sprintf("(*mondoov1.Boolean)(m.%s.ValueBoolPointer())", name)
 	case StringField.MondooType:
 		return fmt.Sprintf("mondoov1.String(m.%s.ValueString())", name)
 	case StringPtrField.MondooType:
 		return fmt.Sprintf("mondoov1.NewStringPtr(mondoov1.String(m.%s.ValueString()))", name)
+	case IntField.MondooType:
+		return fmt.Sprintf("mondoov1.Int(m.%s.ValueInt32())", name)
+	case IntPtrField.MondooType:
+		return fmt.Sprintf("(*mondoov1.Int)(m.%s.ValueInt32Pointer())", name)
 	case ArrayStringPtrField.MondooType:
 		return fmt.Sprintf("ToPtr(ConvertSliceStrings(m.%s))", name)
+	case ArrayStringField.MondooType:
+		return fmt.Sprintf("ConvertSliceStrings(m.%s)", name)
+	case ArrayIntField.MondooType:
+		return fmt.Sprintf("ConvertSliceInt32(m.%s)", name)
+	case ArrayIntPtrField.MondooType:
+		return fmt.Sprintf("ToPtr(ConvertSliceInt32(m.%s))", name)
 	}
-	return "\"unimplemented: check gen/gen.go\""
+
+	var value string
+	switch f.Kind {
+	case EnumKind:
+		// unset optional enums are not sent, an empty string is not a valid value
+		if f.Pointer {
+			return fmt.Sprintf("(*%s)(m.%s.ValueStringPointer())", f.MondooType, name)
+		}
+		return fmt.Sprintf("%s(m.%s.ValueString())", f.MondooType, name)
+	case ListKind:
+		value = fmt.Sprintf("ConvertSlice[%s](m.%s)", f.Elem.MondooType, name)
+	case MapKind:
+		value = fmt.Sprintf("ConvertMapStrings[%s](m.%s)", f.Elem.MondooType, name)
+	case ObjectKind:
+		// objects are pointers in the model, an unset object is sent as nil
+		if f.Pointer {
+			return fmt.Sprintf("ConvertObjectPtr(m.%s, %s.Input)", name, f.Object.ModelName)
+		}
+		return fmt.Sprintf("FromPtr(ConvertObjectPtr(m.%s, %s.Input))", name, f.Object.ModelName)
+	case ObjectListKind:
+		value = fmt.Sprintf("ConvertObjects(m.%s, %s.Input)", name, f.Object.ModelName)
+	default:
+		return "\"unimplemented: check gen/gen.go\""
+	}
+	if f.Pointer {
+		return fmt.Sprintf("ToPtr(%s)", value)
+	}
+	return value
 }
 
 // Check if a field name indicates it contains sensitive data.
@@ -109,6 +279,12 @@ func isSensitiveField(fieldName string) bool {
 }
 
 func (f Field) ImportConversion(resourceClassName, fieldName string) string {
+	attr := fmt.Sprintf("integration.ConfigurationOptions.%sConfigurationOptions.%s", resourceClassName, fieldName)
+	return f.PayloadConversion(attr, fieldName)
+}
+
+// PayloadConversion converts the payload value attr of the field into the Terraform value.
+func (f Field) PayloadConversion(attr, fieldName string) string {
 	// Handle sensitive fields by setting them to nil during import
 	if isSensitiveField(fieldName) {
 		switch f.MondooType {
@@ -117,23 +293,65 @@ func (f Field) ImportConversion(resourceClassName, fieldName string) string {
 		case StringPtrField.MondooType:
 			return "types.StringPointerValue(nil)" // Nil for optional sensitive fields
 		}
+		// sensitive values are not part of the payload
+		return f.NullValue()
 	}
 
 	// Handle non-sensitive fields normally
-	attr := fmt.Sprintf("integration.ConfigurationOptions.%sConfigurationOptions.%s", resourceClassName, fieldName)
 	switch f.MondooType {
 	case BooleanField.MondooType:
 		return fmt.Sprintf("types.BoolValue(%s)", attr)
+	case BooleanPtrField.MondooType:
+		return fmt.Sprintf("types.BoolPointerValue(%s)", attr)
 	case StringField.MondooType:
 		return fmt.Sprintf("types.StringValue(%s)", attr)
 	case StringPtrField.MondooType:
 		return fmt.Sprintf("types.StringPointerValue(%s)", attr)
-	case ArrayStringPtrField.MondooType:
+	case IntField.MondooType:
+		return fmt.Sprintf("types.Int32Value(%s)", attr)
+	case IntPtrField.MondooType:
+		return fmt.Sprintf("types.Int32PointerValue(%s)", attr)
+	case ArrayStringPtrField.MondooType, ArrayStringField.MondooType:
 		return fmt.Sprintf("ConvertListValue(%s)", attr)
+	case ArrayIntField.MondooType, ArrayIntPtrField.MondooType:
+		return fmt.Sprintf("ConvertListValueInt32(%s)", attr)
+	}
+	switch f.Kind {
+	case EnumKind:
+		if f.Pointer {
+			return fmt.Sprintf("types.StringPointerValue(%s)", attr)
+		}
+		return fmt.Sprintf("types.StringValue(%s)", attr)
+	case ListKind:
+		return fmt.Sprintf("ConvertListValue(%s)", attr)
+	case MapKind:
+		return fmt.Sprintf("ConvertMapValue(%s)", attr)
+	case ObjectKind:
+		return fmt.Sprintf("ConvertObjectPtr(%s, %s)", attr, f.Object.NewModelFunc())
+	case ObjectListKind:
+		return fmt.Sprintf("ConvertObjects(%s, %s)", attr, f.Object.NewModelFunc())
 	}
 	return "\"unimplemented: check gen/gen.go\""
 }
 
+// NullValue returns the null Terraform value of the field.
+func (f Field) NullValue() string {
+	switch f.TerraformType {
+	case "types.Bool":
+		return "types.BoolNull()"
+	case "types.String":
+		return "types.StringNull()"
+	case "types.Int32":
+		return "types.Int32Null()"
+	case "types.List":
+		return fmt.Sprintf("types.ListNull(%s)", f.TerraformSubType)
+	case "types.Map":
+		return fmt.Sprintf("types.MapNull(%s)", f.TerraformSubType)
+	}
+	// objects and lists of objects
+	return "nil"
+}
+
 func (f Field) AttributeOptionalOrRequired(name string) string {
 	rawField, ok := findField(f.RawStruct, name)
 	if !ok {
@@ -166,10 +384,72 @@ func parseTFGenTag(tag string) tfgenTag {
 }
 
 func (f Field) AdditionalSchemaAttributes() string {
+	var attrs string
+	if f.TerraformSubType != "" {
+		attrs += fmt.Sprintf("\nElementType: %s,", f.TerraformSubType)
+	}
+	switch {
+	case f.Kind == EnumKind && len(f.EnumValues) > 0:
+		attrs += fmt.Sprintf("\nValidators: []validator.String{\nstringvalidator.OneOf(%s),\n},", quoteValues(f.EnumValues))
+	case f.Kind == ListKind && f.Elem.Kind == EnumKind && len(f.Elem.EnumValues) > 0:
+		attrs += fmt.Sprintf("\nValidators: []validator.List{\nlistvalidator.ValueStringsAre(stringvalidator.OneOf(%s)),\n},", quoteValues(f.Elem.EnumValues))
+	case f.Kind == ObjectKind:
+		attrs += fmt.Sprintf("\nAttributes: map[string]schema.Attribute{%s\n},", f.Object.schemaAttributes())
+	case f.Kind == ObjectListKind:
+		attrs += fmt.Sprintf("\nNestedObject: schema.NestedAttributeObject{\nAttributes: map[string]schema.Attribute{%s\n},\n},", f.Object.schemaAttributes())
+	}
+	return attrs
+}
+
+// DataSourceSchemaAttributes returns the additional attributes of the field in the data source schema,
+// where all attributes are computed and need no validation.
+func (f Field) DataSourceSchemaAttributes() string {
+	var attrs string
 	if f.TerraformSubType != "" {
-		return fmt.Sprintf("\nElementType: %s,", f.TerraformSubType)
+		attrs += fmt.Sprintf("\nElementType: %s,", f.TerraformSubType)
 	}
-	return ""
+	switch f.Kind {
+	case ObjectKind:
+		attrs += fmt.Sprintf("\nAttributes: map[string]schema.Attribute{%s\n},", f.Object.dataSourceSchemaAttributes())
+	case ObjectListKind:
+		attrs += fmt.Sprintf("\nNestedObject: schema.NestedAttributeObject{\nAttributes: map[string]schema.Attribute{%s\n},\n},", f.Object.dataSourceSchemaAttributes())
+	}
+	return attrs
+}
+
+// dataSourceSchemaAttributes renders the computed data source attributes of the nested object fields.
+// Sensitive fields are part of the model shared with the resource, they are never returned by the API.
+func (o *Object) dataSourceSchemaAttributes() string {
+	var attrs string
+	for _, key := range sortedKeys(o.Fields) {
+		f := o.Fields[key]
+		sensitive := ""
+		if isSensitiveField(key) {
+			sensitive = "\nSensitive: true,"
+		}
+		attrs += fmt.Sprintf("\n%q: %s{\nMarkdownDescription: \"The %s %s\",\nComputed: true,%s%s\n},",
+			toSnakeCase(key), f.TerraformSchemaType, o.Description, key, sensitive, f.DataSourceSchemaAttributes())
+	}
+	return attrs
+}
+
+// schemaAttributes renders the schema attributes of the nested object fields.
+func (o *Object) schemaAttributes() string {
+	var attrs string
+	for _, key := range sortedKeys(o.Fields) {
+		f := o.Fields[key]
+		attrs += fmt.Sprintf("\n%q: %s{\nMarkdownDescription: \"The %s %s\",\n%s,%s\n},",
+			toSnakeCase(key), f.TerraformSchemaType, o.Description, key, f.AttributeOptionalOrRequired(key), f.AdditionalSchemaAttributes())
+	}
+	return attrs
+}
+
+func quoteValues(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = strconv.Quote(v)
+	}
+	return strings.Join(quoted, ", ")
 }
 
 func findField(obj any, fieldName string) (reflect.StructField, bool) {
@@ -197,6 +477,15 @@ var (
 		HclType:             "bool",
 		GoFmtVerb:           "t",
 	}
+	BooleanPtrField = Field{
+		Pointer:             true,
+		GoType:              "*bool",
+		MondooType:          "*mondoov1.Boolean",
+		TerraformType:       "types.Bool",
+		TerraformSchemaType: "schema.BoolAttribute",
+		HclType:             "bool",
+		GoFmtVerb:           "t",
+	}
 	StringField = Field{
 		GoType:              "string",
 		MondooType:          "mondoov1.String",
@@ -206,6 +495,7 @@ var (
 		GoFmtVerb:           "q",
 	}
 	StringPtrField = Field{
+		Pointer:             true,
 		GoType:              "*string",
 		MondooType:          "*mondoov1.String",
 		TerraformType:       "types.String",
@@ -213,7 +503,26 @@ var (
 		HclType:             "string",
 		GoFmtVerb:           "q",
 	}
+	IntField = Field{
+		GoType:              "int32",
+		MondooType:          "mondoov1.Int",
+		TerraformType:       "types.Int32",
+		TerraformSchemaType: "schema.Int32Attribute",
+		HclType:             "number",
+		GoFmtVerb:           "d",
+	}
+	IntPtrField = Field{
+		Pointer:             true,
+		GoType:              "*int32",
+		MondooType:          "*mondoov1.Int",
+		TerraformType:       "types.Int32",
+		TerraformSchemaType: "schema.Int32Attribute",
+		HclType:             "number",
+		GoFmtVerb:           "d",
+	}
 	ArrayStringPtrField = Field{
+		Kind:                ListKind,
+		Pointer:             true,
 		GoType:              "[]string",
 		MondooType:          "*[]mondoov1.String",
 		TerraformType:       "types.List",
@@ -221,9 +530,275 @@ var (
 		TerraformSchemaType: "schema.ListAttribute",
 		HclType:             "list(string)",
 		GoFmtVerb:           "q",
+		Elem:                &StringField,
+	}
+	ArrayStringField = Field{
+		Kind:                ListKind,
+		GoType:              "[]string",
+		MondooType:          "[]mondoov1.String",
+		TerraformType:       "types.List",
+		TerraformSubType:    "types.StringType",
+		TerraformSchemaType: "schema.ListAttribute",
+		HclType:             "list(string)",
+		GoFmtVerb:           "q",
+		Elem:                &StringField,
+	}
+	ArrayIntField = Field{
+		Kind:                ListKind,
+		GoType:              "[]int32",
+		MondooType:          "[]mondoov1.Int",
+		TerraformType:       "types.List",
+		TerraformSubType:    "types.Int32Type",
+		TerraformSchemaType: "schema.ListAttribute",
+		HclType:             "list(number)",
+		GoFmtVerb:           "d",
+		Elem:                &IntField,
+	}
+	ArrayIntPtrField = Field{
+		Kind:                ListKind,
+		Pointer:             true,
+		GoType:              "[]int32",
+		MondooType:          "*[]mondoov1.Int",
+		TerraformType:       "types.List",
+		TerraformSubType:    "types.Int32Type",
+		TerraformSchemaType: "schema.ListAttribute",
+		HclType:             "list(number)",
+		GoFmtVerb:           "d",
+		Elem:                &IntField,
 	}
 )
 
+var (
+	mondooBoolean = reflect.TypeOf(mondoov1.Boolean(false))
+	mondooString  = reflect.TypeOf(mondoov1.String(""))
+	mondooInt     = reflect.TypeOf(mondoov1.Int(0))
+)
+
+// fieldGenerator maps the fields of the mondoo-go input types to the fields used in the templates.
+type fieldGenerator struct {
+	// enums are the values of the enum types of mondoo-go, by type name
+	enums map[string][]string
+	// objects collects the nested input objects of the integration that is generated
+	objects []*Object
+}
+
+// newField returns the field for a field of the given type. The path is the name of the
+// integration followed by the names of the parent objects, e.g. Gitlab or GitlabDiscovery.
+func (g *fieldGenerator) newField(path string, typ reflect.Type, raw any) Field {
+	pointer := typ.Kind() == reflect.Ptr
+	if pointer {
+		typ = typ.Elem()
+	}
+
+	switch {
+	case typ == mondooBoolean && pointer:
+		return NewField(BooleanPtrField, raw)
+	case typ == mondooBoolean:
+		return NewField(BooleanField, raw)
+	case typ == mondooString && pointer:
+		return NewField(StringPtrField, raw)
+	case typ == mondooString:
+		return NewField(StringField, raw)
+	case typ == mondooInt && pointer:
+		return NewField(IntPtrField, raw)
+	case typ == mondooInt:
+		return NewField(IntField, raw)
+	case typ.Kind() == reflect.Slice && typ.Elem() == mondooString && pointer:
+		return NewField(ArrayStringPtrField, raw)
+	case typ.Kind() == reflect.Slice && typ.Elem() == mondooString:
+		return NewField(ArrayStringField, raw)
+	case typ.Kind() == reflect.Slice && typ.Elem() == mondooInt && pointer:
+		return NewField(ArrayIntPtrField, raw)
+	case typ.Kind() == reflect.Slice && typ.Elem() == mondooInt:
+		return NewField(ArrayIntField, raw)
+	case typ.Kind() == reflect.String:
+		// every other string type of mondoo-go is an enum
+		f := Field{
+			Kind:                EnumKind,
+			Pointer:             pointer,
+			GoType:              "string",
+			MondooType:          "mondoov1." + typ.Name(),
+			TerraformType:       "types.String",
+			TerraformSchemaType: "schema.StringAttribute",
+			HclType:             "string",
+			GoFmtVerb:           "q",
+			EnumValues:          g.enums[typ.Name()],
+		}
+		if pointer {
+			f.GoType = "*string"
+		}
+		return NewField(f, raw)
+	case typ.Kind() == reflect.Slice && typ.Elem().Kind() == reflect.Struct:
+		object := g.newObject(path, typ.Elem())
+		return NewField(Field{
+			Kind:                ObjectListKind,
+			Pointer:             pointer,
+			GoType:              "[]" + object.PayloadName,
+			MondooType:          "[]" + object.InputType,
+			TerraformType:       "[]" + object.ModelName,
+			TerraformSchemaType: "schema.ListNestedAttribute",
+			HclType:             fmt.Sprintf("list(%s)", object.hclType()),
+			GoFmtVerb:           "s",
+			Object:              object,
+		}, raw)
+	case typ.Kind() == reflect.Slice:
+		elem := g.newField(path, typ.Elem(), raw)
+		return NewField(Field{
+			Kind:                ListKind,
+			Pointer:             pointer,
+			GoType:              "[]" + elem.GoType,
+			MondooType:          "[]" + elem.MondooType,
+			TerraformType:       "types.List",
+			TerraformSubType:    "types.StringType",
+			TerraformSchemaType: "schema.ListAttribute",
+			HclType:             fmt.Sprintf("list(%s)", elem.HclType),
+			GoFmtVerb:           "q",
+			Elem:                &elem,
+		}, raw)
+	case typ.Kind() == reflect.Map && typ.Key().Kind() == reflect.String:
+		elem := g.newField(path, typ.Elem(), raw)
+		if elem.TerraformType != "types.String" {
+			panic(fmt.Sprintf("unimplemented mondoo api map type: %v", typ))
+		}
+		return NewField(Field{
+			Kind:                MapKind,
+			Pointer:             pointer,
+			GoType:              "map[string]string",
+			MondooType:          "map[string]" + elem.MondooType,
+			TerraformType:       "types.Map",
+			TerraformSubType:    "types.StringType",
+			TerraformSchemaType: "schema.MapAttribute",
+			HclType:             "map(string)",
+			GoFmtVerb:           "s",
+			Elem:                &elem,
+		}, raw)
+	case typ.Kind() == reflect.Struct:
+		object := g.newObject(path, typ)
+		return NewField(Field{
+			Kind:                ObjectKind,
+			Pointer:             pointer,
+			GoType:              "*" + object.PayloadName,
+			MondooType:          object.InputType,
+			TerraformType:       "*" + object.ModelName,
+			TerraformSchemaType: "schema.SingleNestedAttribute",
+			HclType:             object.hclType(),
+			GoFmtVerb:           "s",
+			Object:              object,
+		}, raw)
+	}
+
+	// when adding new types, we might need to update all the templates
+	panic(fmt.Sprintf("unimplemented mondoo api type: %v", typ))
+}
+
+// newObject returns the nested object for the given input type.
+func (g *fieldGenerator) newObject(path string, typ reflect.Type) *Object {
+	name := path + strings.TrimSuffix(typ.Name(), "Input")
+	// input types are often named after the integration, e.g. GitlabProjectInput
+	if strings.HasPrefix(typ.Name(), path) {
+		name = strings.TrimSuffix(typ.Name(), "Input")
+	}
+	for _, o := range g.objects {
+		if o.InputType == "mondoov1."+typ.Name() {
+			return o
+		}
+	}
+
+	object := &Object{
+		ModelName:   "integration" + name + "Model",
+		PayloadName: name + "Payload",
+		InputType:   "mondoov1." + typ.Name(),
+		Description: name,
+		Fields:      map[string]Field{},
+	}
+	// register the object before its fields, objects used by several fields are only walked once
+	g.objects = append(g.objects, object)
+
+	raw := reflect.New(typ).Interface()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		object.Fields[field.Name] = g.newField(name, field.Type, raw)
+	}
+	return object
+}
+
+func (o *Object) hclType() string {
+	keys := sortedKeys(o.Fields)
+	attrs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		attrs = append(attrs, fmt.Sprintf("%s = %s", toSnakeCase(key), o.Fields[key].HclType))
+	}
+	return fmt.Sprintf("object({ %s })", strings.Join(attrs, ", "))
+}
+
+func sortedKeys(fields map[string]Field) []string {
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// loadEnums reads the enum values of the mondoo-go types from the source of the module.
+// The values are used for the OneOf validators of enum fields.
+func loadEnums() (map[string][]string, error) {
+	out, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", "go.mondoo.com/mondoo-go").Output()
+	if err != nil {
+		return nil, fmt.Errorf("unable to find mondoo-go module: %w", err)
+	}
+	return parseEnums(strings.TrimSpace(string(out)))
+}
+
+// parseEnums returns the values of the typed string constants declared in the Go files of dir, by type name.
+func parseEnums(dir string) (map[string][]string, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	enums := map[string][]string{}
+	fset := token.NewFileSet()
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, file, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		for _, decl := range f.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.CONST {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				value, ok := spec.(*ast.ValueSpec)
+				if !ok || len(value.Values) != 1 {
+					continue
+				}
+				typ, ok := value.Type.(*ast.Ident)
+				if !ok {
+					continue
+				}
+				lit, ok := value.Values[0].(*ast.BasicLit)
+				if !ok || lit.Kind != token.STRING {
+					continue
+				}
+				v, err := strconv.Unquote(lit.Value)
+				if err != nil {
+					return nil, err
+				}
+				enums[typ.Name] = append(enums[typ.Name], v)
+			}
+		}
+	}
+	return enums, nil
+}
+
 var funcMap = template.FuncMap{
 	"toSnakeCase":      toSnakeCase,
 	"formatEnum":       formatEnum,
@@ -231,15 +806,26 @@ var funcMap = template.FuncMap{
 	"isSensitiveField": isSensitiveField,
 }
 
+// templateFiles are the templates of the generated files, embedded to not depend on the working directory.
+//
+//go:embed templates/*.tmpl
+var templateFiles embed.FS
+
 var templates = map[string]*template.Template{
 	"integration_resource.go": template.Must(template.New("integration_resource.go.tmpl").Funcs(funcMap).
-		ParseFiles(filepath.Join("gen", "templates", "integration_resource.go.tmpl"))),
+		ParseFS(templateFiles, "templates/integration_resource.go.tmpl")),
 	"integration_resource_test.go": template.Must(template.New("integration_resource_test.go.tmpl").Funcs(funcMap).
-		ParseFiles(filepath.Join("gen", "templates", "integration_resource_test.go.tmpl"))),
+		ParseFS(templateFiles, "templates/integration_resource_test.go.tmpl")),
 	"resource.tf": template.Must(template.New("resource.tf.tmpl").Funcs(funcMap).
-		ParseFiles(filepath.Join("gen", "templates", "resource.tf.tmpl"))),
+		ParseFS(templateFiles, "templates/resource.tf.tmpl")),
+	"integration_data_source.go": template.Must(template.New("integration_data_source.go.tmpl").Funcs(funcMap).
+		ParseFS(templateFiles, "templates/integration_data_source.go.tmpl")),
+	"integration_data_source_test.go": template.Must(template.New("integration_data_source_test.go.tmpl").Funcs(funcMap).
+		ParseFS(templateFiles, "templates/integration_data_source_test.go.tmpl")),
+	"data-source.tf": template.Must(template.New("data-source.tf.tmpl").Funcs(funcMap).
+		ParseFS(templateFiles, "templates/data-source.tf.tmpl")),
 	"gql_generated.go": template.Must(template.New("gql_generated.go.tmpl").Funcs(funcMap).
-		ParseFiles(filepath.Join("gen", "templates", "gql_generated.go.tmpl"))),
+		ParseFS(templateFiles, "templates/gql_generated.go.tmpl")),
 	"provider_generated.go": template.Must(template.New("").Funcs(funcMap).
 		Parse(`// Copyright (c) Mondoo, Inc.
 // SPDX-License-Identifier: BUSL-1.1
@@ -248,7 +834,10 @@ var templates = map[string]*template.Template{
 
 package provider
 
-import "github.com/hashicorp/terraform-plugin-framework/resource"
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
 
 var autoGeneratedResources = []func() resource.Resource{
 	NewIntegrationAwsResource,
@@ -263,7 +852,9 @@ var autoGeneratedResources = []func() resource.Resource{
 	NewIntegrationJiraResource,
 	NewIntegrationMsDefenderResource,
 	NewIntegrationMs365Resource,
+	NewIntegrationMsTeamsResource,
 	NewIntegrationOciTenantResource,
+	NewIntegrationPagerDutyResource,
 	NewIntegrationSentinelOneResource,
 	NewIntegrationShodanResource,
 	NewIntegrationSlackResource,
@@ -273,6 +864,12 @@ var autoGeneratedResources = []func() resource.Resource{
 	NewIntegration{{.}}Resource,
 	{{- end}}
 }
+
+var autoGeneratedDataSources = []func() datasource.DataSource{
+	{{- range .}}
+	NewIntegration{{.}}DataSource,
+	{{- end}}
+}
 `)),
 	"import.sh": template.Must(template.New("").Funcs(funcMap).
 		Parse(`# Import using integration MRN.
@@ -291,33 +888,26 @@ terraform import mondoo_integration_{{.TerraformResourceName}}.example "//captai
 }
 
 func renderTemplate(filePath string, tmpl *template.Template, data any) error {
-	resourceFile, err := os.Create(filePath)
+	out, err := executeTemplate(filePath, tmpl, data)
 	if err != nil {
 		return err
 	}
-	defer resourceFile.Close() //nolint:errcheck
+	return os.WriteFile(filePath, out, 0644)
+}
 
+// executeTemplate returns the content of the file rendered by the template.
+func executeTemplate(filePath string, tmpl *template.Template, data any) ([]byte, error) {
 	var buf bytes.Buffer
 	if err := tmpl.Execute(&buf, data); err != nil {
-		return err
+		return nil, err
 	}
 
-	var out []byte
 	if strings.HasSuffix(filePath, ".go") {
 		// format go file with gofmt
-		out, err = format.Source(buf.Bytes())
-		if err != nil {
-			return err
-		}
-	} else if strings.HasSuffix(filePath, ".tf") {
-		// TODO format tf file with 'terraform fmt'
-		out = buf.Bytes()
-	} else {
-		out = buf.Bytes()
+		return format.Source(buf.Bytes())
 	}
-
-	_, err = resourceFile.Write(out)
-	return err
+	// TODO format tf file with 'terraform fmt'
+	return buf.Bytes(), nil
 }
 
 // generateIntegrationResources generates Terraform resources for Mondoo's integrations.
@@ -331,9 +921,16 @@ func generateIntegrationResources() error {
 	if err := os.MkdirAll(goCodeDirPath, 0755); err != nil {
 		return err
 	}
+	dataSourceExamplesPath := filepath.Join("examples", "data-sources")
 
 	// TODO: Flip this around, instead of adding new structs to auto-generate resources, have them all turned on and
 	// disable the ones we already generated (why? To avoid breaking changes)
+	//
+	// The generator supports booleans, strings, ints, enums, maps of strings, lists of those types and
+	// nested input objects (also as lists), which covers the configuration options of most integrations.
+	// New integrations are added here. The hand-written integrations are not regenerated: their schemas
+	// differ from the generated ones, e.g. Shodan nests its token in a `credentials` block and Slack
+	// manages `channel` blocks, so replacing them is a breaking change that has to wait for a major release.
 	i := mondoov1.ClientIntegrationConfigurationInput{
 		OktaConfigurationOptions:            &mondoov1.OktaConfigurationOptionsInput{},
 		GoogleWorkspaceConfigurationOptions: &mondoov1.GoogleWorkspaceConfigurationOptionsInput{},
@@ -344,6 +941,11 @@ func generateIntegrationResources() error {
 		return err
 	}
 
+	enums, err := loadEnums()
+	if err != nil {
+		return err
+	}
+
 	// Store the list of generated Terraform resources so that at the end of the loop we auto-generate
 	// other files that depend on the list of resources, like the `provider_generated.go`
 	resources := []string{}
@@ -351,16 +953,7 @@ func generateIntegrationResources() error {
 	// Iterate over the keys to generate resources in order
 	for _, k := range keys {
 		v := mapStruct[k]
-		var (
-			className, _          = strings.CutSuffix(k, "ConfigurationOptions")
-			terraformResourceName = strings.ToLower(toSnakeCase(className))
-			fullResourceName      = fmt.Sprintf("mondoo_integration_%s", terraformResourceName)
-			resource              = IntegrationResource{
-				ResourceClassName:     className,
-				TerraformResourceName: terraformResourceName,
-				Fields:                map[string]Field{},
-			}
-		)
+		className, _ := strings.CutSuffix(k, "ConfigurationOptions")
 		mm, mmKeys, err := structToMap(v)
 		if err != nil {
 			log.Fatalf("unable to conver struct %s to map", className)
@@ -369,26 +962,11 @@ func generateIntegrationResources() error {
 			fmt.Printf("❌ %s integration has no fields, skipping\n", className)
 			continue
 		}
+		resource := newIntegrationResource(className, v, mmKeys, enums)
+		terraformResourceName := resource.TerraformResourceName
+		fullResourceName := fmt.Sprintf("mondoo_integration_%s", terraformResourceName)
 		fmt.Printf(">>> ⭐ Generating code for '%s' integration (resource %s)\n", className, fullResourceName)
 
-		// TODO parse the config options and try to generate a struct that can be passed to the template
-		// so that we know the schema of each integration
-		for _, kk := range mmKeys {
-			vv := mm[kk]
-			switch t := vv.(type) {
-			case mondoov1.Boolean:
-				resource.Fields[kk] = NewField(BooleanField, v)
-			case mondoov1.String:
-				resource.Fields[kk] = NewField(StringField, v)
-			case *mondoov1.String:
-				resource.Fields[kk] = NewField(StringPtrField, v)
-			case *[]mondoov1.String:
-				resource.Fields[kk] = NewField(ArrayStringPtrField, v)
-			default:
-				// when adding new types, we might need to update all the templates
-				panic(fmt.Sprintf("unimplemented mondoo api type: %v", t))
-			}
-		}
 		// add the resource class name to the list of resources to use them in the gql_generated.go
 		resources = append(resources, className)
 
@@ -412,6 +990,26 @@ func generateIntegrationResources() error {
 			return err
 		}
 
+		// Create the data source file, exposing the non-sensitive options of existing integrations
+		err = renderTemplate(
+			filepath.Join(goCodeDirPath, fmt.Sprintf("integration_%s_data_source.go", terraformResourceName)),
+			templates["integration_data_source.go"],
+			resource,
+		)
+		if err != nil {
+			return err
+		}
+
+		// Create data source test file
+		err = renderTemplate(
+			filepath.Join(goCodeDirPath, fmt.Sprintf("integration_%s_data_source_test.go", terraformResourceName)),
+			templates["integration_data_source_test.go"],
+			resource,
+		)
+		if err != nil {
+			return err
+		}
+
 		// Create examples/ files
 		resourceExamplesDirPath := filepath.Join(examplesDirPath, fullResourceName)
 		// Ensure the output directory exists
@@ -433,6 +1031,20 @@ func generateIntegrationResources() error {
 		if err != nil {
 			return err
 		}
+
+		// Create data source examples/ files
+		dataSourceExamplesDirPath := filepath.Join(dataSourceExamplesPath, fullResourceName)
+		if err := os.MkdirAll(dataSourceExamplesDirPath, 0755); err != nil {
+			return err
+		}
+		err = renderTemplate(filepath.Join(dataSourceExamplesDirPath, "main.tf"), templates["main.tf"], resource)
+		if err != nil {
+			return err
+		}
+		err = renderTemplate(filepath.Join(dataSourceExamplesDirPath, "data-source.tf"), templates["data-source.tf"], resource)
+		if err != nil {
+			return err
+		}
 	}
 
 	// Create the gql_generated.go file
@@ -448,6 +1060,27 @@ func generateIntegrationResources() error {
 	return nil
 }
 
+// newIntegrationResource returns the integration resource for the configuration options input v of
+// the integration. It walks the fields of the input type to know the schema of the integration, nested
+// input objects are collected and rendered as their own model types.
+func newIntegrationResource(className string, v any, fieldNames []string, enums map[string][]string) IntegrationResource {
+	resource := IntegrationResource{
+		ResourceClassName:     className,
+		TerraformResourceName: strings.ToLower(toSnakeCase(className)),
+		Fields:                map[string]Field{},
+	}
+	g := &fieldGenerator{enums: enums}
+	for _, name := range fieldNames {
+		field, ok := findField(v, name)
+		if !ok {
+			panic(fmt.Sprintf("field %s not found in %s", name, className))
+		}
+		resource.Fields[name] = g.newField(className, field.Type, v)
+	}
+	resource.Objects = g.objects
+	return resource
+}
+
 var matchFirstCap = regexp.MustCompile("(.)([A-Z][a-z]+)")
 var matchAllCap = regexp.MustCompile("([a-z0-9])([A-Z])")
 
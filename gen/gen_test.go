@@ -0,0 +1,237 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	mondoov1 "go.mondoo.com/mondoo-go"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+// The types below mirror the shape of the mondoo-go inputs of integrations with
+// nested objects, lists and enums, like GitLab or Jira.
+
+type ExampleScanType string
+
+const (
+	ExampleScanTypeFull        ExampleScanType = "FULL"
+	ExampleScanTypeIncremental ExampleScanType = "INCREMENTAL"
+)
+
+type ExampleConfigurationOptionsInput struct {
+	BaseUrl   *mondoov1.String            `json:"baseUrl,omitempty"`
+	Token     mondoov1.String             `json:"token" tfgen:"required=1"`
+	ScanType  ExampleScanType             `json:"scanType" tfgen:"required=1"`
+	Projects  []ExampleProjectInput       `json:"projects"`
+	Discovery *ExampleDiscoveryInput      `json:"discovery,omitempty"`
+	Labels    map[string]mondoov1.String  `json:"labels"`
+	Retries   *mondoov1.Int               `json:"retries,omitempty"`
+	Fallback  *ExampleScanType            `json:"fallback,omitempty"`
+	Schedules *[]ExampleScanScheduleInput `json:"schedules,omitempty"`
+}
+
+type ExampleProjectInput struct {
+	Name      mondoov1.String   `json:"name" tfgen:"required=1"`
+	ScanTypes []ExampleScanType `json:"scanTypes"`
+	Topics    []mondoov1.String `json:"topics"`
+}
+
+type ExampleDiscoveryInput struct {
+	Groups    *mondoov1.Boolean `json:"groups,omitempty"`
+	Terraform *mondoov1.Boolean `json:"terraform,omitempty"`
+}
+
+type ExampleScanScheduleInput struct {
+	Cron mondoov1.String `json:"cron"`
+}
+
+type ExampleMirrorInput struct {
+	Url mondoov1.String `json:"url"`
+}
+
+var exampleEnums = map[string][]string{
+	"ExampleScanType": {"FULL", "INCREMENTAL"},
+}
+
+func exampleField(t *testing.T, g *fieldGenerator, name string) Field {
+	t.Helper()
+	field, ok := findField(ExampleConfigurationOptionsInput{}, name)
+	require.True(t, ok)
+	return g.newField("Example", field.Type, ExampleConfigurationOptionsInput{})
+}
+
+func TestNewFieldScalars(t *testing.T) {
+	g := &fieldGenerator{enums: exampleEnums}
+
+	token := exampleField(t, g, "Token")
+	assert.Equal(t, ScalarKind, token.Kind)
+	assert.Equal(t, StringField.MondooType, token.MondooType)
+	assert.Equal(t, "Required: true", token.AttributeOptionalOrRequired("Token"))
+
+	baseUrl := exampleField(t, g, "BaseUrl")
+	assert.Equal(t, StringPtrField.MondooType, baseUrl.MondooType)
+	assert.Equal(t, "Optional: true", baseUrl.AttributeOptionalOrRequired("BaseUrl"))
+	assert.Equal(t, "types.StringPointerValue(p.BaseUrl)", baseUrl.PayloadConversion("p.BaseUrl", "BaseUrl"))
+
+	retries := exampleField(t, g, "Retries")
+	assert.Equal(t, IntPtrField.MondooType, retries.MondooType)
+	assert.Equal(t, "(*mondoov1.Int)(m.Retries.ValueInt32Pointer())", retries.ConfigurationOption("Retries"))
+	assert.Empty(t, g.objects)
+}
+
+func TestNewFieldEnums(t *testing.T) {
+	g := &fieldGenerator{enums: exampleEnums}
+
+	scanType := exampleField(t, g, "ScanType")
+	assert.Equal(t, EnumKind, scanType.Kind)
+	assert.False(t, scanType.Pointer)
+	assert.Equal(t, "mondoov1.ExampleScanType", scanType.MondooType)
+	assert.Equal(t, "types.String", scanType.TerraformType)
+	assert.Equal(t, []string{"FULL", "INCREMENTAL"}, scanType.EnumValues)
+	assert.Equal(t, "mondoov1.ExampleScanType(m.ScanType.ValueString())", scanType.ConfigurationOption("ScanType"))
+	assert.Contains(t, scanType.AdditionalSchemaAttributes(), `stringvalidator.OneOf("FULL", "INCREMENTAL")`)
+	assert.Equal(t, `"INCREMENTAL"`, scanType.GoTestValue("scan_type", 2))
+
+	// unset optional enums are not sent to the API
+	fallback := exampleField(t, g, "Fallback")
+	assert.Equal(t, EnumKind, fallback.Kind)
+	assert.True(t, fallback.Pointer)
+	assert.Equal(t, "*string", fallback.GoType)
+	assert.Equal(t, "(*mondoov1.ExampleScanType)(m.Fallback.ValueStringPointer())", fallback.ConfigurationOption("Fallback"))
+	assert.Equal(t, "types.StringPointerValue(p.Fallback)", fallback.PayloadConversion("p.Fallback", "Fallback"))
+
+	// enums without known values are not validated
+	unknown := (&fieldGenerator{}).newField("Example", reflect.TypeOf(ExampleScanTypeFull), nil)
+	assert.Equal(t, EnumKind, unknown.Kind)
+	assert.Empty(t, unknown.EnumValues)
+	assert.NotContains(t, unknown.AdditionalSchemaAttributes(), "Validators")
+}
+
+func TestNewFieldMap(t *testing.T) {
+	g := &fieldGenerator{enums: exampleEnums}
+
+	labels := exampleField(t, g, "Labels")
+	assert.Equal(t, MapKind, labels.Kind)
+	assert.Equal(t, "map[string]mondoov1.String", labels.MondooType)
+	assert.Equal(t, "types.Map", labels.TerraformType)
+	assert.Equal(t, "ConvertMapStrings[mondoov1.String](m.Labels)", labels.ConfigurationOption("Labels"))
+	assert.Equal(t, "types.MapNull(types.StringType)", labels.NullValue())
+
+	// maps of objects are not supported
+	assert.Panics(t, func() { g.newField("Example", reflect.TypeOf(map[string]ExampleMirrorInput{}), nil) })
+}
+
+func TestNewFieldObjects(t *testing.T) {
+	g := &fieldGenerator{enums: exampleEnums}
+
+	projects := exampleField(t, g, "Projects")
+	assert.Equal(t, ObjectListKind, projects.Kind)
+	assert.Equal(t, "[]mondoov1.ExampleProjectInput", projects.MondooType)
+	assert.Equal(t, "[]integrationExampleProjectModel", projects.TerraformType)
+	assert.Equal(t, "schema.ListNestedAttribute", projects.TerraformSchemaType)
+	assert.Equal(t, "ConvertObjects(m.Projects, integrationExampleProjectModel.Input)", projects.ConfigurationOption("Projects"))
+	assert.Equal(t, "ConvertObjects(p.Projects, newIntegrationExampleProjectModel)", projects.PayloadConversion("p.Projects", "Projects"))
+	assert.Equal(t, "list(object({ name = string, scan_types = list(string), topics = list(string) }))", projects.HclType)
+
+	project := projects.Object
+	assert.Equal(t, "ExampleProjectPayload", project.PayloadName)
+	assert.Equal(t, "ExampleProject", project.Description)
+	require.Contains(t, project.Fields, "ScanTypes")
+	scanTypes := project.Fields["ScanTypes"]
+	assert.Equal(t, ListKind, scanTypes.Kind)
+	assert.Equal(t, EnumKind, scanTypes.Elem.Kind)
+	assert.Equal(t, "ConvertSlice[mondoov1.ExampleScanType](m.ScanTypes)", scanTypes.ConfigurationOption("ScanTypes"))
+	assert.Contains(t, scanTypes.AdditionalSchemaAttributes(), `listvalidator.ValueStringsAre(stringvalidator.OneOf("FULL", "INCREMENTAL"))`)
+
+	discovery := exampleField(t, g, "Discovery")
+	assert.Equal(t, ObjectKind, discovery.Kind)
+	assert.True(t, discovery.Pointer)
+	assert.Equal(t, "*integrationExampleDiscoveryModel", discovery.TerraformType)
+	assert.Equal(t, "ConvertObjectPtr(m.Discovery, integrationExampleDiscoveryModel.Input)", discovery.ConfigurationOption("Discovery"))
+	assert.Equal(t, "nil", discovery.NullValue())
+
+	// objects are collected once, also when they are used again
+	assert.Same(t, project, exampleField(t, g, "Projects").Object)
+	assert.Len(t, g.objects, 2)
+
+	schedules := exampleField(t, g, "Schedules")
+	assert.Equal(t, ObjectListKind, schedules.Kind)
+	assert.True(t, schedules.Pointer)
+	assert.Equal(t, "ToPtr(ConvertObjects(m.Schedules, integrationExampleScanScheduleModel.Input))", schedules.ConfigurationOption("Schedules"))
+	assert.Len(t, g.objects, 3)
+}
+
+func TestNewFieldSensitive(t *testing.T) {
+	g := &fieldGenerator{enums: exampleEnums}
+
+	// sensitive values are never returned by the API
+	token := exampleField(t, g, "Token")
+	assert.Equal(t, `types.StringValue("")`, token.PayloadConversion("p.Token", "Token"))
+	labels := exampleField(t, g, "Labels")
+	assert.Equal(t, "types.MapNull(types.StringType)", labels.PayloadConversion("p.Labels", "SecretLabels"))
+}
+
+func TestParseEnums(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "enum.go"), []byte(`package mondoogql
+
+type ScanType string
+
+const (
+	ScanTypeFull        ScanType = "FULL"
+	ScanTypeIncremental ScanType = "INCREMENTAL"
+	untyped                      = "UNTYPED"
+	Count               int      = 1
+)
+`), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "enum_test.go"), []byte(`package mondoogql
+
+const ScanTypeTest ScanType = "TEST"
+`), 0o600))
+
+	enums, err := parseEnums(dir)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{"ScanType": {"FULL", "INCREMENTAL"}}, enums)
+}
+
+// TestGenerateExampleIntegration renders the resource and data source of an integration with nested
+// objects and enums, run the tests with -update to regenerate the golden files after template changes.
+func TestGenerateExampleIntegration(t *testing.T) {
+	v := &ExampleConfigurationOptionsInput{}
+	_, keys, err := structToMap(v)
+	require.NoError(t, err)
+
+	resource := newIntegrationResource("Example", v, keys, exampleEnums)
+	assert.Equal(t, "example", resource.TerraformResourceName)
+	assert.True(t, resource.HasEnumList())
+
+	for name, golden := range map[string]string{
+		"integration_resource.go":         "integration_example_resource.go.golden",
+		"integration_resource_test.go":    "integration_example_resource_test.go.golden",
+		"integration_data_source.go":      "integration_example_data_source.go.golden",
+		"integration_data_source_test.go": "integration_example_data_source_test.go.golden",
+		"resource.tf":                     "resource.tf.golden",
+	} {
+		t.Run(name, func(t *testing.T) {
+			out, err := executeTemplate(name, templates[name], resource)
+			require.NoError(t, err)
+
+			goldenPath := filepath.Join("testdata", golden)
+			if *update {
+				require.NoError(t, os.WriteFile(goldenPath, out, 0o644))
+			}
+			want, err := os.ReadFile(goldenPath)
+			require.NoError(t, err)
+			assert.Equal(t, string(want), string(out))
+		})
+	}
+}
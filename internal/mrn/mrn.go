@@ -0,0 +1,165 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package mrn parses and builds Mondoo Resource Names (MRNs).
+//
+// An MRN has the form "//{service}/{collection}/{id}[/{collection}/{id}...]", for example
+// "//captain.api.mondoo.app/spaces/hungry-poet-123456" or
+// "//integration.api.mondoo.app/spaces/hungry-poet-123456/integrations/2Abd08lk860".
+package mrn
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	CaptainService     = "captain.api.mondoo.app"
+	PolicyService      = "policy.api.mondoo.app"
+	IntegrationService = "integration.api.mondoo.app"
+	PlatformService    = "platform.api.mondoo.app"
+
+	// PlatformMrn is the scope used for resources that live at the platform level.
+	PlatformMrn = "//" + PlatformService
+
+	SpacePrefix        = "//" + CaptainService + "/spaces/"
+	OrganizationPrefix = "//" + CaptainService + "/organizations/"
+
+	// PublicPolicyNamespace is the namespace of policies maintained by Mondoo.
+	PublicPolicyNamespace = "mondoo"
+)
+
+var (
+	validIntegrationMRN         = regexp.MustCompile(`^(//integration\.api\.mondoo\.app/(spaces|organizations)/[\w-]+/integrations/[\w-]+)$`)
+	validPlatformIntegrationMRN = regexp.MustCompile(`^(//integration\.api\.mondoo\.app/integrations/[\w-]+)$`)
+)
+
+// MRN is a parsed Mondoo Resource Name.
+type MRN struct {
+	// Service is the API domain that owns the resource, e.g. captain.api.mondoo.app.
+	Service string
+	// Type is the collection of the last path segment, e.g. spaces, integrations or policies.
+	// It is empty for service-level MRNs like //platform.api.mondoo.app.
+	Type string
+	// OrgID is set when the MRN contains an organizations/{id} segment.
+	OrgID string
+	// SpaceID is set when the MRN contains a spaces/{id} segment.
+	SpaceID string
+	// ID is the identifier of the resource itself, the last path segment.
+	ID string
+}
+
+// Parse splits an MRN into its service, type, organization, space and resource identifier.
+func Parse(s string) (MRN, error) {
+	rest, ok := strings.CutPrefix(s, "//")
+	if !ok {
+		return MRN{}, fmt.Errorf("invalid MRN %q: must start with //", s)
+	}
+
+	parts := strings.Split(rest, "/")
+	m := MRN{Service: parts[0]}
+	if m.Service == "" {
+		return MRN{}, fmt.Errorf("invalid MRN %q: missing service", s)
+	}
+
+	segments := parts[1:]
+	if len(segments)%2 != 0 {
+		return MRN{}, fmt.Errorf("invalid MRN %q: path must consist of {collection}/{id} pairs", s)
+	}
+	for i := 0; i < len(segments); i += 2 {
+		collection, id := segments[i], segments[i+1]
+		if collection == "" || id == "" {
+			return MRN{}, fmt.Errorf("invalid MRN %q: empty path segment", s)
+		}
+		switch collection {
+		case "organizations":
+			m.OrgID = id
+		case "spaces":
+			m.SpaceID = id
+		}
+		m.Type = collection
+		m.ID = id
+	}
+
+	return m, nil
+}
+
+// ScopeMrn returns the captain MRN of the space or organization the resource belongs to,
+// or the platform MRN for resources that are not scoped to either.
+//
+// Examples:
+//
+//	//integration.api.mondoo.app/spaces/{ID}/integrations/{ID} → //captain.api.mondoo.app/spaces/{ID}
+//	//integration.api.mondoo.app/organizations/{ID}/integrations/{ID} → //captain.api.mondoo.app/organizations/{ID}
+//	//integration.api.mondoo.app/integrations/{ID} → //platform.api.mondoo.app
+func (m MRN) ScopeMrn() string {
+	switch {
+	case m.SpaceID != "":
+		return Space(m.SpaceID)
+	case m.OrgID != "":
+		return Organization(m.OrgID)
+	default:
+		return PlatformMrn
+	}
+}
+
+// Space returns the MRN of the space with the provided ID.
+func Space(id string) string {
+	if id == "" {
+		return ""
+	}
+	return SpacePrefix + id
+}
+
+// Organization returns the MRN of the organization with the provided ID.
+func Organization(id string) string {
+	if id == "" {
+		return ""
+	}
+	return OrganizationPrefix + id
+}
+
+// Policy returns the MRN of a policy. The namespace is either PublicPolicyNamespace for
+// policies maintained by Mondoo, or the ID or MRN of the space that owns a custom policy.
+func Policy(namespace, uid string) (string, error) {
+	if uid == "" {
+		return "", errors.New("policy uid must not be empty")
+	}
+	if strings.Contains(uid, "/") {
+		return "", fmt.Errorf("invalid policy uid %q: must not contain '/'", uid)
+	}
+
+	if namespace == "" || namespace == PublicPolicyNamespace {
+		return fmt.Sprintf("//%s/policies/%s", PolicyService, uid), nil
+	}
+
+	spaceID := namespace
+	if strings.HasPrefix(namespace, "//") {
+		m, err := Parse(namespace)
+		if err != nil {
+			return "", err
+		}
+		if m.SpaceID == "" {
+			return "", fmt.Errorf("invalid policy namespace %q: must be %q or a space", namespace, PublicPolicyNamespace)
+		}
+		spaceID = m.SpaceID
+	}
+	return fmt.Sprintf("//%s/spaces/%s/policies/%s", PolicyService, spaceID, uid), nil
+}
+
+// Framework returns the MRN of a custom compliance framework uploaded to a space.
+func Framework(spaceID, uid string) string {
+	return fmt.Sprintf("//%s/spaces/%s/frameworks/%s", PolicyService, spaceID, uid)
+}
+
+// IsValidIntegration returns true if the MRN belongs to a space, organization or platform integration.
+func IsValidIntegration(s string) bool {
+	return validIntegrationMRN.MatchString(s) || validPlatformIntegrationMRN.MatchString(s)
+}
+
+// IsPlatformIntegration returns true if the MRN belongs to a platform-level integration.
+func IsPlatformIntegration(s string) bool {
+	return validPlatformIntegrationMRN.MatchString(s)
+}
@@ -0,0 +1,131 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package mrn
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected MRN
+	}{
+		{
+			name:     "space",
+			input:    "//captain.api.mondoo.app/spaces/hungry-poet-123456",
+			expected: MRN{Service: CaptainService, Type: "spaces", SpaceID: "hungry-poet-123456", ID: "hungry-poet-123456"},
+		},
+		{
+			name:     "organization",
+			input:    "//captain.api.mondoo.app/organizations/my-org",
+			expected: MRN{Service: CaptainService, Type: "organizations", OrgID: "my-org", ID: "my-org"},
+		},
+		{
+			name:     "space-scoped integration",
+			input:    "//integration.api.mondoo.app/spaces/my-space/integrations/abc",
+			expected: MRN{Service: IntegrationService, Type: "integrations", SpaceID: "my-space", ID: "abc"},
+		},
+		{
+			name:     "org-scoped routing rule",
+			input:    "//policy.api.mondoo.app/organizations/my-org/routing-rules/rule-1",
+			expected: MRN{Service: PolicyService, Type: "routing-rules", OrgID: "my-org", ID: "rule-1"},
+		},
+		{
+			name:     "public policy",
+			input:    "//policy.api.mondoo.app/policies/mondoo-aws-security",
+			expected: MRN{Service: PolicyService, Type: "policies", ID: "mondoo-aws-security"},
+		},
+		{
+			name:     "platform",
+			input:    "//platform.api.mondoo.app",
+			expected: MRN{Service: PlatformService},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, m)
+		})
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	for _, input := range []string{
+		"",
+		"not-an-mrn",
+		"//",
+		"//captain.api.mondoo.app/spaces",
+		"//captain.api.mondoo.app/spaces/",
+		"//captain.api.mondoo.app/spaces//integrations/abc",
+	} {
+		t.Run(input, func(t *testing.T) {
+			_, err := Parse(input)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestMRN_ScopeMrn(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"//integration.api.mondoo.app/spaces/my-space/integrations/abc", "//captain.api.mondoo.app/spaces/my-space"},
+		{"//integration.api.mondoo.app/organizations/my-org/integrations/abc", "//captain.api.mondoo.app/organizations/my-org"},
+		{"//integration.api.mondoo.app/integrations/abc", "//platform.api.mondoo.app"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			m, err := Parse(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, m.ScopeMrn())
+		})
+	}
+}
+
+func TestBuilders(t *testing.T) {
+	assert.Equal(t, "//captain.api.mondoo.app/spaces/1234", Space("1234"))
+	assert.Equal(t, "", Space(""))
+	assert.Equal(t, "//captain.api.mondoo.app/organizations/my-org", Organization("my-org"))
+	assert.Equal(t, "", Organization(""))
+	assert.Equal(t, "//policy.api.mondoo.app/spaces/1234/frameworks/my-framework", Framework("1234", "my-framework"))
+}
+
+func TestPolicy(t *testing.T) {
+	tests := []struct {
+		name      string
+		namespace string
+		uid       string
+		expected  string
+		wantErr   bool
+	}{
+		{name: "public policy", namespace: "mondoo", uid: "mondoo-aws-security", expected: "//policy.api.mondoo.app/policies/mondoo-aws-security"},
+		{name: "empty namespace", namespace: "", uid: "mondoo-aws-security", expected: "//policy.api.mondoo.app/policies/mondoo-aws-security"},
+		{name: "space id", namespace: "1234", uid: "custom", expected: "//policy.api.mondoo.app/spaces/1234/policies/custom"},
+		{name: "space mrn", namespace: "//captain.api.mondoo.app/spaces/1234", uid: "custom", expected: "//policy.api.mondoo.app/spaces/1234/policies/custom"},
+		{name: "org mrn", namespace: "//captain.api.mondoo.app/organizations/my-org", uid: "custom", wantErr: true},
+		{name: "empty uid", namespace: "mondoo", uid: "", wantErr: true},
+		{name: "uid with slash", namespace: "mondoo", uid: "a/b", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Policy(tt.namespace, tt.uid)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
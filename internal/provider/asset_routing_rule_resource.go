@@ -6,7 +6,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -15,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	mondoov1 "go.mondoo.com/mondoo-go"
+	"go.mondoo.com/terraform-provider-mondoo/internal/mrn"
 )
 
 var _ resource.Resource = (*AssetRoutingRuleResource)(nil)
@@ -229,18 +229,9 @@ func (r *AssetRoutingRuleResource) ImportState(ctx context.Context, req resource
 // Rule MRN format: //policy.api.mondoo.app/organizations/{orgId}/routing-rules/{ruleId}
 // Org MRN format: //captain.api.mondoo.app/organizations/{orgId}
 func orgMrnFromRuleMrn(ruleMrn string) (string, error) {
-	const prefix = "//policy.api.mondoo.app/organizations/"
-	if !strings.HasPrefix(ruleMrn, prefix) {
+	m, err := mrn.Parse(ruleMrn)
+	if err != nil || m.Service != mrn.PolicyService || m.OrgID == "" {
 		return "", fmt.Errorf("invalid rule MRN format: %s", ruleMrn)
 	}
-
-	rest := ruleMrn[len(prefix):]
-	// rest should be "{orgId}/routing-rules/{ruleId}"
-	for i, ch := range rest {
-		if ch == '/' {
-			orgID := rest[:i]
-			return orgPrefix + orgID, nil
-		}
-	}
-	return "", fmt.Errorf("invalid rule MRN format: %s", ruleMrn)
+	return mrn.Organization(m.OrgID), nil
 }
@@ -0,0 +1,384 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	mondoov1 "go.mondoo.com/mondoo-go"
+	"go.mondoo.com/terraform-provider-mondoo/internal/mrn"
+)
+
+var (
+	_ resource.Resource                   = (*CaseResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*CaseResource)(nil)
+	_ resource.ResourceWithImportState    = (*CaseResource)(nil)
+)
+
+func NewCaseResource() resource.Resource {
+	return &CaseResource{}
+}
+
+type CaseResource struct {
+	client *ExtendedGqlClient
+}
+
+type CaseResourceModel struct {
+	SpaceID           types.String `tfsdk:"space_id"`
+	Mrn               types.String `tfsdk:"mrn"`
+	IntegrationMrn    types.String `tfsdk:"integration_mrn"`
+	Title             types.String `tfsdk:"title"`
+	Notes             types.String `tfsdk:"notes"`
+	CheckMrns         types.Set    `tfsdk:"check_mrns"`
+	VulnerabilityMrns types.Set    `tfsdk:"vulnerability_mrns"`
+	Status            types.String `tfsdk:"status"`
+	TicketUrls        types.List   `tfsdk:"ticket_urls"`
+}
+
+func (r *CaseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_case"
+}
+
+func (r *CaseResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Opens a case for a set of checks or vulnerabilities and creates a ticket for it in a ticketing integration. Use cases to track remediation work that is tied to infrastructure changes.
+
+Cases are closed when the resource is destroyed. Changing the integration or the findings of a case opens a new case.`,
+
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "Mondoo space identifier. If there is no space ID, the provider space is used.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"mrn": schema.StringAttribute{
+				MarkdownDescription: "The Mondoo Resource Name (MRN) of the case.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"integration_mrn": schema.StringAttribute{
+				MarkdownDescription: "The Mondoo Resource Name (MRN) of the ticketing integration to create the ticket in.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Title of the case, used as the ticket summary.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"notes": schema.StringAttribute{
+				MarkdownDescription: "Notes added to the ticket description, such as a link to the change that remediates the findings.",
+				Optional:            true,
+			},
+			"check_mrns": schema.SetAttribute{
+				MarkdownDescription: "MRNs of the checks to track in the case.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"vulnerability_mrns": schema.SetAttribute{
+				MarkdownDescription: "MRNs of the vulnerabilities (CVEs or advisories) to track in the case.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the case, either `OPEN`, `CLOSED` or `ERROR`.",
+				Computed:            true,
+			},
+			"ticket_urls": schema.ListAttribute{
+				MarkdownDescription: "URLs of the tickets created for the case.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *CaseResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ExtendedGqlClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ExtendedGqlClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *CaseResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CaseResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateCaseResourceModel(&data)...)
+}
+
+func validateCaseResourceModel(data *CaseResourceModel) (diagnostics diag.Diagnostics) {
+	if data.CheckMrns.IsUnknown() || data.VulnerabilityMrns.IsUnknown() {
+		return
+	}
+
+	if len(data.CheckMrns.Elements()) == 0 && len(data.VulnerabilityMrns.Elements()) == 0 {
+		diagnostics.AddError(
+			"MissingAttributeError",
+			"At least one of check_mrns or vulnerability_mrns must be set.",
+		)
+		return
+	}
+
+	checkTypes := []string{"queries"}
+	vulnerabilityTypes := []string{"cves", "advisories"}
+	validate := func(attribute string, set types.Set, validTypes []string) {
+		for _, elem := range set.Elements() {
+			value, ok := elem.(types.String)
+			if !ok || value.IsUnknown() {
+				continue
+			}
+			m, err := mrn.Parse(value.ValueString())
+			if err != nil || !slices.Contains(validTypes, m.Type) {
+				diagnostics.AddError(
+					"InvalidAttributeError",
+					fmt.Sprintf("Attribute %s contains %q, which is not a valid MRN for this attribute.", attribute, value.ValueString()),
+				)
+			}
+		}
+	}
+	validate("check_mrns", data.CheckMrns, checkTypes)
+	validate("vulnerability_mrns", data.VulnerabilityMrns, vulnerabilityTypes)
+	return
+}
+
+func caseFindingsFromModel(ctx context.Context, data *CaseResourceModel) (findings []CaseFindingInput, diagnostics diag.Diagnostics) {
+	findings = []CaseFindingInput{}
+	for _, set := range []struct {
+		mrns        types.Set
+		findingType CaseFindingType
+	}{
+		{data.CheckMrns, CaseFindingTypeCheck},
+		{data.VulnerabilityMrns, CaseFindingTypeVulnerability},
+	} {
+		mrns := []string{}
+		diagnostics.Append(set.mrns.ElementsAs(ctx, &mrns, true)...)
+		for _, findingMrn := range mrns {
+			findings = append(findings, CaseFindingInput{
+				Mrn:  mondoov1.String(findingMrn),
+				Type: set.findingType,
+			})
+		}
+	}
+	return
+}
+
+func (data *CaseResourceModel) fromPayload(result CasePayload) {
+	data.Mrn = types.StringValue(result.Mrn)
+	data.IntegrationMrn = types.StringValue(result.IntegrationMrn)
+	data.Title = types.StringValue(result.Title)
+	data.Status = types.StringValue(result.Status)
+
+	if result.Notes != "" {
+		data.Notes = types.StringValue(result.Notes)
+	} else {
+		data.Notes = types.StringNull()
+	}
+
+	checks := []attr.Value{}
+	vulnerabilities := []attr.Value{}
+	for _, finding := range result.Findings {
+		switch CaseFindingType(finding.Type) {
+		case CaseFindingTypeCheck:
+			checks = append(checks, types.StringValue(finding.Mrn))
+		case CaseFindingTypeVulnerability:
+			vulnerabilities = append(vulnerabilities, types.StringValue(finding.Mrn))
+		}
+	}
+	// keep unset attributes null to avoid a diff against the configuration
+	if len(checks) > 0 || !data.CheckMrns.IsNull() {
+		data.CheckMrns = types.SetValueMust(types.StringType, checks)
+	}
+	if len(vulnerabilities) > 0 || !data.VulnerabilityMrns.IsNull() {
+		data.VulnerabilityMrns = types.SetValueMust(types.StringType, vulnerabilities)
+	}
+
+	urls := make([]string, len(result.Tickets))
+	for i, ticket := range result.Tickets {
+		urls[i] = ticket.Url
+	}
+	data.TicketUrls = ConvertListValue(urls)
+}
+
+func (r *CaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CaseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	space, err := r.client.ComputeSpace(data.SpaceID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+		return
+	}
+
+	findings, diags := caseFindingsFromModel(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := CreateCaseInput{
+		ScopeMrn:       mondoov1.String(space.MRN()),
+		IntegrationMrn: mondoov1.String(data.IntegrationMrn.ValueString()),
+		Title:          mondoov1.String(data.Title.ValueString()),
+		Findings:       findings,
+	}
+	if !data.Notes.IsNull() {
+		input.Notes = mondoov1.NewStringPtr(mondoov1.String(data.Notes.ValueString()))
+	}
+
+	tflog.Debug(ctx, "creating case", map[string]interface{}{
+		"spaceMrn":       space.MRN(),
+		"integrationMrn": data.IntegrationMrn.ValueString(),
+		"findings":       len(findings),
+	})
+
+	result, err := r.client.CreateCase(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create case", err.Error())
+		return
+	}
+
+	data.SpaceID = types.StringValue(space.ID())
+	data.fromPayload(result)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CaseResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.GetCase(ctx, data.Mrn.ValueString())
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read case", err.Error())
+		return
+	}
+
+	// remove cases closed outside of Terraform from the state, so a new case is opened
+	if CaseStatus(result.Status) == CaseStatusClosed {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.fromPayload(result)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CaseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := UpdateCaseInput{
+		Mrn:   mondoov1.String(data.Mrn.ValueString()),
+		Title: mondoov1.String(data.Title.ValueString()),
+		Notes: mondoov1.NewStringPtr(mondoov1.String(data.Notes.ValueString())),
+	}
+
+	tflog.Debug(ctx, "updating case", map[string]interface{}{
+		"caseMrn": data.Mrn.ValueString(),
+	})
+
+	result, err := r.client.UpdateCase(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update case", err.Error())
+		return
+	}
+
+	data.fromPayload(result)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CaseResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	caseMrn := data.Mrn.ValueString()
+	tflog.Debug(ctx, "closing case", map[string]interface{}{
+		"caseMrn": caseMrn,
+	})
+
+	err := r.client.CloseCase(ctx, caseMrn)
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("Failed to close case", err.Error())
+	}
+}
+
+func (r *CaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	result, err := r.client.GetCase(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import case", err.Error())
+		return
+	}
+
+	m, err := mrn.Parse(result.Mrn)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import case", err.Error())
+		return
+	}
+
+	data := CaseResourceModel{
+		SpaceID:           types.StringValue(m.SpaceID),
+		Notes:             types.StringNull(),
+		CheckMrns:         types.SetNull(types.StringType),
+		VulnerabilityMrns: types.SetNull(types.StringType),
+	}
+	data.fromPayload(result)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,179 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccCaseResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCaseResourceConfig(accSpace.ID(), "Harden SSH configuration"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("mondoo_case.test", "integration_mrn", "mondoo_integration_jira.test", "mrn"),
+					resource.TestCheckResourceAttrSet("mondoo_case.test", "mrn"),
+					resource.TestCheckResourceAttr("mondoo_case.test", "space_id", accSpace.ID()),
+					resource.TestCheckResourceAttr("mondoo_case.test", "title", "Harden SSH configuration"),
+					resource.TestCheckResourceAttr("mondoo_case.test", "status", "OPEN"),
+					resource.TestCheckResourceAttr("mondoo_case.test", "check_mrns.#", "1"),
+					resource.TestCheckResourceAttr("mondoo_case.test", "vulnerability_mrns.#", "1"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccCaseResourceConfig(accSpace.ID(), "Harden SSH configuration on bastion hosts"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mondoo_case.test", "title", "Harden SSH configuration on bastion hosts"),
+					resource.TestCheckResourceAttr("mondoo_case.test", "status", "OPEN"),
+				),
+			},
+			// Data source testing
+			{
+				Config: testAccCaseResourceConfig(accSpace.ID(), "Harden SSH configuration on bastion hosts") + testAccCasesDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.mondoo_cases.open", "statuses.0", "OPEN"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.mondoo_cases.open", "cases.*", map[string]string{
+						"title":  "Harden SSH configuration on bastion hosts",
+						"status": "OPEN",
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: "mondoo_case.test",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["mondoo_case.test"].Primary.Attributes["mrn"], nil
+				},
+				ImportStateVerifyIdentifierAttribute: "mrn",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				// tickets are created asynchronously
+				ImportStateVerifyIgnore: []string{"ticket_urls"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCaseResourceConfig(spaceID, title string) string {
+	return fmt.Sprintf(`
+resource "mondoo_integration_jira" "test" {
+  space_id        = %[1]q
+  name            = "Cases"
+  host            = "https://your-instance.atlassian.net"
+  email           = "jira.owner@email.com"
+  default_project = "MONDOO"
+
+  credentials = {
+    token = "abcd1234567890"
+  }
+}
+
+resource "mondoo_case" "test" {
+  space_id        = %[1]q
+  integration_mrn = mondoo_integration_jira.test.mrn
+  title           = %[2]q
+  notes           = "Tracked in the infrastructure change for the bastion hosts."
+
+  check_mrns         = ["//policy.api.mondoo.app/queries/mondoo-linux-security-permissions-on-etcsshsshd_config-are-configured"]
+  vulnerability_mrns = ["//vadvisor.api.mondoo.app/cves/CVE-2024-6387"]
+}
+`, spaceID, title)
+}
+
+func testAccCasesDataSourceConfig() string {
+	return `
+data "mondoo_cases" "open" {
+  space_id = mondoo_case.test.space_id
+}
+`
+}
+
+func TestValidateCaseResourceModel(t *testing.T) {
+	stringSet := func(values ...string) types.Set {
+		elems := make([]attr.Value, len(values))
+		for i, v := range values {
+			elems[i] = types.StringValue(v)
+		}
+		return types.SetValueMust(types.StringType, elems)
+	}
+	checkMrn := "//policy.api.mondoo.app/queries/mondoo-linux-security-permissions-on-etcsshsshd_config-are-configured"
+	cveMrn := "//vadvisor.api.mondoo.app/cves/CVE-2024-6387"
+	advisoryMrn := "//vadvisor.api.mondoo.app/advisories/DSA-5724-1"
+
+	tests := []struct {
+		name            string
+		checks          types.Set
+		vulnerabilities types.Set
+		summary         string
+	}{
+		{
+			name:            "checks only",
+			checks:          stringSet(checkMrn),
+			vulnerabilities: types.SetNull(types.StringType),
+		},
+		{
+			name:            "vulnerabilities only",
+			checks:          types.SetNull(types.StringType),
+			vulnerabilities: stringSet(cveMrn, advisoryMrn),
+		},
+		{
+			name:            "unknown findings",
+			checks:          types.SetUnknown(types.StringType),
+			vulnerabilities: types.SetNull(types.StringType),
+		},
+		{
+			name:            "no findings",
+			checks:          types.SetNull(types.StringType),
+			vulnerabilities: stringSet(),
+			summary:         "MissingAttributeError",
+		},
+		{
+			name:            "vulnerability as check",
+			checks:          stringSet(cveMrn),
+			vulnerabilities: types.SetNull(types.StringType),
+			summary:         "InvalidAttributeError",
+		},
+		{
+			name:            "check as vulnerability",
+			checks:          types.SetNull(types.StringType),
+			vulnerabilities: stringSet(checkMrn),
+			summary:         "InvalidAttributeError",
+		},
+		{
+			name:            "not an mrn",
+			checks:          stringSet("CVE-2024-6387"),
+			vulnerabilities: types.SetNull(types.StringType),
+			summary:         "InvalidAttributeError",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diagnostics := validateCaseResourceModel(&CaseResourceModel{
+				CheckMrns:         tt.checks,
+				VulnerabilityMrns: tt.vulnerabilities,
+			})
+			if tt.summary == "" {
+				assert.False(t, diagnostics.HasError())
+				return
+			}
+			require.Len(t, diagnostics, 1)
+			assert.Equal(t, tt.summary, diagnostics[0].Summary())
+		})
+	}
+}
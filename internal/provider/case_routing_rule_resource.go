@@ -0,0 +1,454 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	mondoov1 "go.mondoo.com/mondoo-go"
+)
+
+var (
+	_ resource.Resource                   = (*CaseRoutingRuleResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*CaseRoutingRuleResource)(nil)
+	_ resource.ResourceWithImportState    = (*CaseRoutingRuleResource)(nil)
+)
+
+// caseSeverities are the severities a case can be routed by, from highest to lowest.
+var caseSeverities = []string{"CRITICAL", "HIGH", "MEDIUM", "LOW", "NONE"}
+
+func NewCaseRoutingRuleResource() resource.Resource {
+	return &CaseRoutingRuleResource{}
+}
+
+type CaseRoutingRuleResource struct {
+	client *ExtendedGqlClient
+}
+
+type CaseRoutingRuleResourceModel struct {
+	IntegrationMrn types.String                `tfsdk:"integration_mrn"`
+	Mrn            types.String                `tfsdk:"mrn"`
+	Priority       types.Int64                 `tfsdk:"priority"`
+	Target         *CaseRoutingTargetModel     `tfsdk:"target"`
+	Conditions     []CaseRoutingConditionModel `tfsdk:"condition"`
+}
+
+// CaseRoutingTargetModel describes where the ticket of a matching case is created.
+type CaseRoutingTargetModel struct {
+	Project    types.String `tfsdk:"project"`
+	IssueType  types.String `tfsdk:"issue_type"`
+	Assignee   types.String `tfsdk:"assignee"`
+	Priority   types.String `tfsdk:"priority"`
+	Recipients types.List   `tfsdk:"recipients"`
+}
+
+// CaseRoutingConditionModel is the Terraform model for a single case routing condition.
+type CaseRoutingConditionModel struct {
+	Field    types.String `tfsdk:"field"`
+	Operator types.String `tfsdk:"operator"`
+	Values   types.List   `tfsdk:"values"`
+	Key      types.String `tfsdk:"key"`
+}
+
+func (r *CaseRoutingRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_case_routing_rule"
+}
+
+func (r *CaseRoutingRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manages a case routing rule for a ticketing integration (Jira, Zendesk, Azure DevOps or email). Routing rules send cases to different projects, issue types, assignees and priorities based on the severity, policy, asset labels or workspace of the findings, so findings reach the owning team.
+
+Rules are evaluated by priority. The first matching rule determines where the ticket is created. Cases that match no rule use the default settings of the integration.`,
+
+		Attributes: map[string]schema.Attribute{
+			"integration_mrn": schema.StringAttribute{
+				MarkdownDescription: "The Mondoo Resource Name (MRN) of the ticketing integration.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mrn": schema.StringAttribute{
+				MarkdownDescription: "The Mondoo Resource Name (MRN) of the routing rule.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"priority": schema.Int64Attribute{
+				MarkdownDescription: "The priority of this rule. Lower values are evaluated first.",
+				Required:            true,
+			},
+			"target": schema.SingleNestedAttribute{
+				MarkdownDescription: "Where tickets for matching cases are created. Set at least one attribute.",
+				Required:            true,
+				Attributes: map[string]schema.Attribute{
+					"project": schema.StringAttribute{
+						MarkdownDescription: "The project for the ticket, such as the Jira project key or the Azure DevOps project name. For Zendesk, the group the ticket is assigned to.",
+						Optional:            true,
+					},
+					"issue_type": schema.StringAttribute{
+						MarkdownDescription: "The issue type (Jira) or work item type (Azure DevOps) of the ticket.",
+						Optional:            true,
+					},
+					"assignee": schema.StringAttribute{
+						MarkdownDescription: "The user the ticket is assigned to, such as an email address or account ID.",
+						Optional:            true,
+					},
+					"priority": schema.StringAttribute{
+						MarkdownDescription: "The priority of the ticket in the ticket system, such as `Highest` in Jira or `urgent` in Zendesk.",
+						Optional:            true,
+					},
+					"recipients": schema.ListAttribute{
+						MarkdownDescription: "Email addresses that receive the case. Only used by email integrations.",
+						Optional:            true,
+						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"condition": schema.ListNestedBlock{
+				MarkdownDescription: "Conditions that must all match for this rule to apply (AND logic). If empty, the rule matches all cases (catch-all).",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"field": schema.StringAttribute{
+							MarkdownDescription: "The field to match on. Valid values: `SEVERITY`, `POLICY`, `ASSET_LABEL`, `WORKSPACE`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(ConvertStrings([]CaseRoutingConditionField{
+									CaseRoutingConditionFieldSeverity,
+									CaseRoutingConditionFieldPolicy,
+									CaseRoutingConditionFieldAssetLabel,
+									CaseRoutingConditionFieldWorkspace,
+								})...),
+							},
+						},
+						"operator": schema.StringAttribute{
+							MarkdownDescription: "The comparison operator. Valid values: `EQUAL`, `NOT_EQUAL`, `AT_LEAST`. `AT_LEAST` is only valid for `SEVERITY`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(ConvertStrings([]CaseRoutingConditionOperator{
+									CaseRoutingConditionOperatorEqual,
+									CaseRoutingConditionOperatorNotEqual,
+									CaseRoutingConditionOperatorAtLeast,
+								})...),
+							},
+						},
+						"values": schema.ListAttribute{
+							MarkdownDescription: "List of values to match against. A condition matches if the field matches any of the listed values (OR logic). " +
+								"Use severities (`CRITICAL`, `HIGH`, `MEDIUM`, `LOW`, `NONE`) for `SEVERITY`, policy MRNs for `POLICY`, label values for `ASSET_LABEL` and workspace MRNs for `WORKSPACE`.",
+							Required:    true,
+							ElementType: types.StringType,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+						"key": schema.StringAttribute{
+							MarkdownDescription: "The label key to match on. Required when `field` is `ASSET_LABEL`.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *CaseRoutingRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ExtendedGqlClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ExtendedGqlClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *CaseRoutingRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CaseRoutingRuleResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateCaseRoutingRuleResourceModel(&data)...)
+}
+
+func validateCaseRoutingRuleResourceModel(data *CaseRoutingRuleResourceModel) (diagnostics diag.Diagnostics) {
+	if target := data.Target; target != nil &&
+		target.Project.IsNull() && target.IssueType.IsNull() && target.Assignee.IsNull() &&
+		target.Priority.IsNull() && target.Recipients.IsNull() {
+		diagnostics.AddError(
+			"MissingAttributeError",
+			"Attribute target must set at least one of project, issue_type, assignee, priority or recipients.",
+		)
+	}
+
+	for i, c := range data.Conditions {
+		field := CaseRoutingConditionField(c.Field.ValueString())
+		operator := CaseRoutingConditionOperator(c.Operator.ValueString())
+
+		if field == CaseRoutingConditionFieldAssetLabel && c.Key.IsNull() {
+			diagnostics.AddError(
+				"MissingAttributeError",
+				fmt.Sprintf("Attribute condition[%d].key must be set when field is %s.", i, field),
+			)
+		}
+		if field != CaseRoutingConditionFieldAssetLabel && !c.Field.IsUnknown() && !c.Key.IsNull() {
+			diagnostics.AddError(
+				"ConflictingAttributesError",
+				fmt.Sprintf("Attribute condition[%d].key can only be set when field is %s.", i, CaseRoutingConditionFieldAssetLabel),
+			)
+		}
+		if operator == CaseRoutingConditionOperatorAtLeast && field != CaseRoutingConditionFieldSeverity && !c.Field.IsUnknown() {
+			diagnostics.AddError(
+				"InvalidAttributeError",
+				fmt.Sprintf("Attribute condition[%d].operator %s can only be used when field is %s.", i, operator, CaseRoutingConditionFieldSeverity),
+			)
+		}
+
+		if field != CaseRoutingConditionFieldSeverity || c.Values.IsUnknown() {
+			continue
+		}
+		for _, v := range c.Values.Elements() {
+			severity, ok := v.(types.String)
+			if !ok || severity.IsUnknown() {
+				continue
+			}
+			if !slices.Contains(caseSeverities, severity.ValueString()) {
+				diagnostics.AddError(
+					"InvalidAttributeError",
+					fmt.Sprintf("Attribute condition[%d].values contains unknown severity %q. Valid values: %q", i, severity.ValueString(), caseSeverities),
+				)
+			}
+		}
+		if operator == CaseRoutingConditionOperatorAtLeast && len(c.Values.Elements()) != 1 {
+			diagnostics.AddError(
+				"InvalidAttributeError",
+				fmt.Sprintf("Attribute condition[%d].values must contain exactly one severity when operator is %s.", i, operator),
+			)
+		}
+	}
+	return
+}
+
+// caseRoutingConditionsFromModel converts Terraform condition models to GraphQL input types.
+func caseRoutingConditionsFromModel(conditions []CaseRoutingConditionModel) []CaseRoutingConditionInput {
+	result := make([]CaseRoutingConditionInput, len(conditions))
+	for i, c := range conditions {
+		input := CaseRoutingConditionInput{
+			Field:    CaseRoutingConditionField(c.Field.ValueString()),
+			Operator: CaseRoutingConditionOperator(c.Operator.ValueString()),
+			Values:   ConvertSliceStrings(c.Values),
+		}
+		if input.Values == nil {
+			input.Values = []mondoov1.String{}
+		}
+		if !c.Key.IsNull() && !c.Key.IsUnknown() && c.Key.ValueString() != "" {
+			input.Key = mondoov1.NewStringPtr(mondoov1.String(c.Key.ValueString()))
+		}
+		result[i] = input
+	}
+	return result
+}
+
+// caseRoutingConditionsToModel converts GraphQL condition payloads to Terraform models.
+func caseRoutingConditionsToModel(conditions []CaseRoutingConditionPayload) []CaseRoutingConditionModel {
+	result := make([]CaseRoutingConditionModel, len(conditions))
+	for i, c := range conditions {
+		model := CaseRoutingConditionModel{
+			Field:    types.StringValue(c.Field),
+			Operator: types.StringValue(c.Operator),
+			Values:   ConvertListValue(c.Values),
+			Key:      types.StringNull(),
+		}
+		if c.Key != "" {
+			model.Key = types.StringValue(c.Key)
+		}
+		result[i] = model
+	}
+	return result
+}
+
+// caseRoutingTargetFromModel converts the Terraform target model to the GraphQL input type.
+func caseRoutingTargetFromModel(target *CaseRoutingTargetModel) CaseRoutingTargetInput {
+	input := CaseRoutingTargetInput{}
+	if target == nil {
+		return input
+	}
+	optional := func(v types.String) *mondoov1.String {
+		if v.IsNull() || v.IsUnknown() {
+			return nil
+		}
+		return mondoov1.NewStringPtr(mondoov1.String(v.ValueString()))
+	}
+	input.Project = optional(target.Project)
+	input.IssueType = optional(target.IssueType)
+	input.Assignee = optional(target.Assignee)
+	input.Priority = optional(target.Priority)
+	if !target.Recipients.IsNull() {
+		input.Recipients = ConvertSliceStrings(target.Recipients)
+	}
+	return input
+}
+
+// caseRoutingTargetToModel converts the GraphQL target payload to the Terraform model.
+func caseRoutingTargetToModel(target CaseRoutingTargetPayload) *CaseRoutingTargetModel {
+	optional := func(v string) types.String {
+		if v == "" {
+			return types.StringNull()
+		}
+		return types.StringValue(v)
+	}
+	model := &CaseRoutingTargetModel{
+		Project:    optional(target.Project),
+		IssueType:  optional(target.IssueType),
+		Assignee:   optional(target.Assignee),
+		Priority:   optional(target.Priority),
+		Recipients: types.ListNull(types.StringType),
+	}
+	if len(target.Recipients) > 0 {
+		model.Recipients = ConvertListValue(target.Recipients)
+	}
+	return model
+}
+
+func (data *CaseRoutingRuleResourceModel) fromPayload(result CaseRoutingRulePayload) {
+	data.Mrn = types.StringValue(result.Mrn)
+	data.IntegrationMrn = types.StringValue(result.IntegrationMrn)
+	data.Priority = types.Int64Value(int64(result.Priority))
+	data.Target = caseRoutingTargetToModel(result.Target)
+	data.Conditions = caseRoutingConditionsToModel(result.Conditions)
+}
+
+func (r *CaseRoutingRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CaseRoutingRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := CreateCaseRoutingRuleInput{
+		IntegrationMrn: mondoov1.String(data.IntegrationMrn.ValueString()),
+		Priority:       mondoov1.Int(data.Priority.ValueInt64()),
+		Conditions:     caseRoutingConditionsFromModel(data.Conditions),
+		Target:         caseRoutingTargetFromModel(data.Target),
+	}
+
+	tflog.Debug(ctx, "creating case routing rule", map[string]interface{}{
+		"integrationMrn": data.IntegrationMrn.ValueString(),
+		"priority":       data.Priority.ValueInt64(),
+	})
+
+	result, err := r.client.CreateCaseRoutingRule(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create case routing rule", err.Error())
+		return
+	}
+
+	data.fromPayload(result)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CaseRoutingRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CaseRoutingRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.GetCaseRoutingRule(ctx, data.Mrn.ValueString())
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read case routing rule", err.Error())
+		return
+	}
+
+	data.fromPayload(result)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CaseRoutingRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CaseRoutingRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := UpdateCaseRoutingRuleInput{
+		RuleMrn:    mondoov1.String(data.Mrn.ValueString()),
+		Priority:   mondoov1.Int(data.Priority.ValueInt64()),
+		Conditions: caseRoutingConditionsFromModel(data.Conditions),
+		Target:     caseRoutingTargetFromModel(data.Target),
+	}
+
+	tflog.Debug(ctx, "updating case routing rule", map[string]interface{}{
+		"ruleMrn":  data.Mrn.ValueString(),
+		"priority": data.Priority.ValueInt64(),
+	})
+
+	result, err := r.client.UpdateCaseRoutingRule(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update case routing rule", err.Error())
+		return
+	}
+
+	data.fromPayload(result)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CaseRoutingRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CaseRoutingRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleMrn := data.Mrn.ValueString()
+	tflog.Debug(ctx, "deleting case routing rule", map[string]interface{}{
+		"ruleMrn": ruleMrn,
+	})
+
+	err := r.client.DeleteCaseRoutingRule(ctx, ruleMrn)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to delete case routing rule", err.Error())
+	}
+}
+
+func (r *CaseRoutingRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	result, err := r.client.GetCaseRoutingRule(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import case routing rule", err.Error())
+		return
+	}
+
+	var data CaseRoutingRuleResourceModel
+	data.fromPayload(result)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
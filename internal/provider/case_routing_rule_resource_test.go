@@ -0,0 +1,204 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccCaseRoutingRuleResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCaseRoutingRuleResourceConfig(accSpace.ID(), 10, "HIGH", "SEC"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("mondoo_case_routing_rule.test", "integration_mrn", "mondoo_integration_jira.test", "mrn"),
+					resource.TestCheckResourceAttrSet("mondoo_case_routing_rule.test", "mrn"),
+					resource.TestCheckResourceAttr("mondoo_case_routing_rule.test", "priority", "10"),
+					resource.TestCheckResourceAttr("mondoo_case_routing_rule.test", "target.project", "SEC"),
+					resource.TestCheckResourceAttr("mondoo_case_routing_rule.test", "target.issue_type", "Bug"),
+					resource.TestCheckResourceAttr("mondoo_case_routing_rule.test", "condition.#", "2"),
+					resource.TestCheckResourceAttr("mondoo_case_routing_rule.test", "condition.0.values.0", "HIGH"),
+					resource.TestCheckResourceAttr("mondoo_case_routing_rule.test", "condition.1.key", "team"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccCaseRoutingRuleResourceConfig(accSpace.ID(), 20, "CRITICAL", "OPS"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mondoo_case_routing_rule.test", "priority", "20"),
+					resource.TestCheckResourceAttr("mondoo_case_routing_rule.test", "target.project", "OPS"),
+					resource.TestCheckResourceAttr("mondoo_case_routing_rule.test", "condition.0.values.0", "CRITICAL"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: "mondoo_case_routing_rule.test",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["mondoo_case_routing_rule.test"].Primary.Attributes["mrn"], nil
+				},
+				ImportStateVerifyIdentifierAttribute: "mrn",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCaseRoutingRuleResourceConfig(spaceID string, priority int, severity, project string) string {
+	return fmt.Sprintf(`
+resource "mondoo_integration_jira" "test" {
+  space_id        = %[1]q
+  name            = "Case routing"
+  host            = "https://your-instance.atlassian.net"
+  email           = "jira.owner@email.com"
+  default_project = "MONDOO"
+
+  credentials = {
+    token = "abcd1234567890"
+  }
+}
+
+resource "mondoo_case_routing_rule" "test" {
+  integration_mrn = mondoo_integration_jira.test.mrn
+  priority        = %[2]d
+
+  target = {
+    project    = %[4]q
+    issue_type = "Bug"
+  }
+
+  condition {
+    field    = "SEVERITY"
+    operator = "AT_LEAST"
+    values   = [%[3]q]
+  }
+
+  condition {
+    field    = "ASSET_LABEL"
+    operator = "EQUAL"
+    key      = "team"
+    values   = ["platform"]
+  }
+}
+`, spaceID, priority, severity, project)
+}
+
+func TestValidateCaseRoutingRuleResourceModel(t *testing.T) {
+	stringList := func(values ...string) types.List {
+		elems := make([]attr.Value, len(values))
+		for i, v := range values {
+			elems[i] = types.StringValue(v)
+		}
+		return types.ListValueMust(types.StringType, elems)
+	}
+	target := &CaseRoutingTargetModel{
+		Project:    types.StringValue("SEC"),
+		IssueType:  types.StringNull(),
+		Assignee:   types.StringNull(),
+		Priority:   types.StringNull(),
+		Recipients: types.ListNull(types.StringType),
+	}
+	condition := func(field, operator, key string, values ...string) CaseRoutingConditionModel {
+		c := CaseRoutingConditionModel{
+			Field:    types.StringValue(field),
+			Operator: types.StringValue(operator),
+			Values:   stringList(values...),
+			Key:      types.StringNull(),
+		}
+		if key != "" {
+			c.Key = types.StringValue(key)
+		}
+		return c
+	}
+
+	tests := []struct {
+		name       string
+		target     *CaseRoutingTargetModel
+		conditions []CaseRoutingConditionModel
+		summary    string
+	}{
+		{
+			name:   "catch-all",
+			target: target,
+		},
+		{
+			name:   "valid conditions",
+			target: target,
+			conditions: []CaseRoutingConditionModel{
+				condition("SEVERITY", "AT_LEAST", "", "HIGH"),
+				condition("ASSET_LABEL", "EQUAL", "team", "platform"),
+				condition("WORKSPACE", "NOT_EQUAL", "", "//captain.api.mondoo.app/spaces/test/workspaces/prod"),
+			},
+		},
+		{
+			name: "empty target",
+			target: &CaseRoutingTargetModel{
+				Project:    types.StringNull(),
+				IssueType:  types.StringNull(),
+				Assignee:   types.StringNull(),
+				Priority:   types.StringNull(),
+				Recipients: types.ListNull(types.StringType),
+			},
+			summary: "MissingAttributeError",
+		},
+		{
+			name:       "label without key",
+			target:     target,
+			conditions: []CaseRoutingConditionModel{condition("ASSET_LABEL", "EQUAL", "", "platform")},
+			summary:    "MissingAttributeError",
+		},
+		{
+			name:       "key without label",
+			target:     target,
+			conditions: []CaseRoutingConditionModel{condition("POLICY", "EQUAL", "team", "//policy.api.mondoo.app/policies/mondoo-linux-security")},
+			summary:    "ConflictingAttributesError",
+		},
+		{
+			name:       "at least without severity",
+			target:     target,
+			conditions: []CaseRoutingConditionModel{condition("WORKSPACE", "AT_LEAST", "", "//captain.api.mondoo.app/spaces/test/workspaces/prod")},
+			summary:    "InvalidAttributeError",
+		},
+		{
+			name:       "unknown severity",
+			target:     target,
+			conditions: []CaseRoutingConditionModel{condition("SEVERITY", "EQUAL", "", "SEVERE")},
+			summary:    "InvalidAttributeError",
+		},
+		{
+			name:       "at least with multiple severities",
+			target:     target,
+			conditions: []CaseRoutingConditionModel{condition("SEVERITY", "AT_LEAST", "", "HIGH", "LOW")},
+			summary:    "InvalidAttributeError",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diagnostics := validateCaseRoutingRuleResourceModel(&CaseRoutingRuleResourceModel{
+				Target:     tt.target,
+				Conditions: tt.conditions,
+			})
+			if tt.summary == "" {
+				assert.False(t, diagnostics.HasError())
+				return
+			}
+			require.Len(t, diagnostics, 1)
+			assert.Equal(t, tt.summary, diagnostics[0].Summary())
+		})
+	}
+}
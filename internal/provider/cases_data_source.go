@@ -0,0 +1,253 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*casesDataSource)(nil)
+
+func NewCasesDataSource() datasource.DataSource {
+	return &casesDataSource{}
+}
+
+type casesDataSource struct {
+	client *ExtendedGqlClient
+}
+
+type casesDataSourceModel struct {
+	SpaceID  types.String `tfsdk:"space_id"`
+	SpaceMrn types.String `tfsdk:"space_mrn"`
+	Statuses types.List   `tfsdk:"statuses"`
+	Cases    []caseModel  `tfsdk:"cases"`
+}
+
+type caseModel struct {
+	Mrn            types.String       `tfsdk:"mrn"`
+	Title          types.String       `tfsdk:"title"`
+	Status         types.String       `tfsdk:"status"`
+	IntegrationMrn types.String       `tfsdk:"integration_mrn"`
+	CreatedAt      types.String       `tfsdk:"created_at"`
+	TicketUrls     types.List         `tfsdk:"ticket_urls"`
+	Findings       []caseFindingModel `tfsdk:"findings"`
+}
+
+type caseFindingModel struct {
+	Mrn   types.String `tfsdk:"mrn"`
+	Title types.String `tfsdk:"title"`
+	Type  types.String `tfsdk:"type"`
+}
+
+func (d *casesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cases"
+}
+
+func (d *casesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source to return the cases in a space, with their linked findings and tickets.",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				Computed:            true,
+				Optional:            true,
+				MarkdownDescription: "Space ID",
+				Validators: []validator.String{
+					// Validate only this attribute or space_mrn is configured.
+					stringvalidator.ExactlyOneOf(path.Expressions{
+						path.MatchRoot("space_mrn"),
+					}...),
+				},
+			},
+			"space_mrn": schema.StringAttribute{
+				Computed:            true,
+				Optional:            true,
+				MarkdownDescription: "Space MRN",
+				Validators: []validator.String{
+					// Validate only this attribute or space_id is configured.
+					stringvalidator.ExactlyOneOf(path.Expressions{
+						path.MatchRoot("space_id"),
+					}...),
+				},
+			},
+			"statuses": schema.ListAttribute{
+				Computed:            true,
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Statuses of the cases to return, any of `OPEN`, `CLOSED` or `ERROR`. Defaults to `[\"OPEN\"]`.",
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(
+						string(CaseStatusOpen),
+						string(CaseStatusClosed),
+						string(CaseStatusError),
+					)),
+				},
+			},
+			"cases": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of cases",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"mrn": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Case MRN",
+						},
+						"title": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Case title",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Case status is either `OPEN`, `CLOSED` or `ERROR`",
+						},
+						"integration_mrn": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "MRN of the ticketing integration of the case",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Timestamp of when the case was opened",
+						},
+						"ticket_urls": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "URLs of the tickets created for the case",
+						},
+						"findings": schema.ListNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "List of findings linked to the case",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"mrn": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "MRN of the check or vulnerability",
+									},
+									"title": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "Finding title",
+									},
+									"type": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "Finding type is either `CHECK` or `VULNERABILITY`",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *casesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ExtendedGqlClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *mondoov1.Client. Got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *casesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data casesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// generate space mrn
+	var space Space
+	var err error
+	if data.SpaceMrn.ValueString() != "" {
+		space, err = SpaceFrom(data.SpaceMrn.ValueString())
+	} else if data.SpaceID.ValueString() != "" {
+		space, err = SpaceFrom(data.SpaceID.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+		return
+	}
+
+	if space == "" {
+		resp.Diagnostics.AddError("Invalid Configuration", "Either `space_id` or `space_mrn` must be set")
+		return
+	}
+
+	// only return open cases by default
+	statuses := []string{string(CaseStatusOpen)}
+	if !data.Statuses.IsNull() && !data.Statuses.IsUnknown() {
+		statuses = []string{}
+		resp.Diagnostics.Append(data.Statuses.ElementsAs(ctx, &statuses, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	caseStatuses := make([]CaseStatus, len(statuses))
+	for i, status := range statuses {
+		caseStatuses[i] = CaseStatus(status)
+	}
+
+	cases, err := d.client.ListCases(ctx, space.MRN(), caseStatuses)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to fetch cases", err.Error())
+		return
+	}
+
+	data.SpaceID = types.StringValue(space.ID())
+	data.SpaceMrn = types.StringValue(space.MRN())
+	data.Statuses = ConvertListValue(statuses)
+	data.Cases = make([]caseModel, len(cases))
+	for i, c := range cases {
+		findings := make([]caseFindingModel, len(c.Findings))
+		for j, f := range c.Findings {
+			findings[j] = caseFindingModel{
+				Mrn:   types.StringValue(f.Mrn),
+				Title: types.StringValue(f.Title),
+				Type:  types.StringValue(f.Type),
+			}
+		}
+
+		urls := make([]string, len(c.Tickets))
+		for j, ticket := range c.Tickets {
+			urls[j] = ticket.Url
+		}
+
+		data.Cases[i] = caseModel{
+			Mrn:            types.StringValue(c.Mrn),
+			Title:          types.StringValue(c.Title),
+			Status:         types.StringValue(c.Status),
+			IntegrationMrn: types.StringValue(c.IntegrationMrn),
+			CreatedAt:      types.StringValue(c.CreatedAt),
+			TicketUrls:     ConvertListValue(urls),
+			Findings:       findings,
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
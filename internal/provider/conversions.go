@@ -59,3 +59,67 @@ func ConvertSlice[T any](list types.List) (slice []T) {
 func ToPtr[T any](v T) *T {
 	return &v
 }
+
+// ConvertStrings converts a slice of string based values, like enums, to a slice of strings.
+func ConvertStrings[S ~string](list []S) []string {
+	slice := make([]string, len(list))
+	for i, v := range list {
+		slice[i] = string(v)
+	}
+	return slice
+}
+
+// ConvertMapValue converts a map of strings to a types.Map.
+func ConvertMapValue[S ~string](m map[string]S) types.Map {
+	if m == nil {
+		return types.MapNull(types.StringType)
+	}
+	valueMap := map[string]attr.Value{}
+	for k, v := range m {
+		valueMap[k] = types.StringValue(string(v))
+	}
+	return types.MapValueMust(types.StringType, valueMap)
+}
+
+// ConvertMapStrings converts a types.Map to a map of string based values.
+func ConvertMapStrings[S ~string](m types.Map) map[string]S {
+	ctx := context.Background()
+	values := map[string]string{}
+	m.ElementsAs(ctx, &values, true)
+
+	out := make(map[string]S, len(values))
+	for k, v := range values {
+		out[k] = S(v)
+	}
+	return out
+}
+
+// ConvertObjects converts a slice of objects with the given conversion, like models to API inputs.
+// A nil slice stays nil so that unset lists are not changed into empty ones.
+func ConvertObjects[S, T any](list []S, convert func(S) T) []T {
+	if list == nil {
+		return nil
+	}
+	slice := make([]T, len(list))
+	for i, v := range list {
+		slice[i] = convert(v)
+	}
+	return slice
+}
+
+// ConvertObjectPtr converts an optional object with the given conversion, nil stays nil.
+func ConvertObjectPtr[S, T any](v *S, convert func(S) T) *T {
+	if v == nil {
+		return nil
+	}
+	return ToPtr(convert(*v))
+}
+
+// FromPtr returns the value of the given pointer or the zero value if the pointer is nil.
+func FromPtr[T any](v *T) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+	return *v
+}
@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -16,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.mondoo.com/terraform-provider-mondoo/internal/mrn"
 	"gopkg.in/yaml.v2"
 )
 
@@ -262,11 +262,18 @@ func (r *customFrameworkResource) Delete(ctx context.Context, req resource.Delet
 
 func (r *customFrameworkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// resource.ImportStatePassthroughID(ctx, path.Root("mrn"), req, resp)
-	mrn := req.ID
-	splitMrn := strings.Split(mrn, "/")
-	spaceMrn := spacePrefix + splitMrn[len(splitMrn)-3]
-	spaceID := splitMrn[len(splitMrn)-3]
-	uid := splitMrn[len(splitMrn)-1]
+	frameworkMrn := req.ID
+	parsed, err := mrn.Parse(frameworkMrn)
+	if err != nil || parsed.SpaceID == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Unable to import compliance framework, expected a space framework MRN. Got: %s", frameworkMrn),
+		)
+		return
+	}
+	spaceID := parsed.SpaceID
+	spaceMrn := mrn.Space(spaceID)
+	uid := parsed.ID
 
 	if r.client.Space().ID() != "" && r.client.Space().ID() != spaceID {
 		// The provider is configured to manage resources in a different space than the one the
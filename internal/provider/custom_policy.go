@@ -8,7 +8,6 @@ import (
 	"fmt"
 	"hash/crc32"
 	"os"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -20,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.mondoo.com/terraform-provider-mondoo/internal/mrn"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -166,7 +166,7 @@ func (r *customPolicyResource) getContent(data customPolicyResourceModel) ([]byt
 }
 
 func (r *customPolicyResource) getScope(data *customPolicyResourceModel) string {
-	scopeMrn := "//platform.api.mondoo.app"
+	scopeMrn := mrn.PlatformMrn
 	if !data.ScopeMrn.IsNull() {
 		scopeMrn = data.ScopeMrn.ValueString()
 	} else if space, err := r.client.ComputeSpace(data.SpaceID); err == nil {
@@ -332,8 +332,16 @@ func (r *customPolicyResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *customPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	mrn := req.ID
-	spaceID := strings.Split(mrn, "/")[len(strings.Split(mrn, "/"))-3]
+	policyMrn := req.ID
+	parsed, err := mrn.Parse(policyMrn)
+	if err != nil || parsed.SpaceID == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Unable to import policy, expected a space policy MRN. Got: %s", policyMrn),
+		)
+		return
+	}
+	spaceID := parsed.SpaceID
 	if r.client.Space().ID() != "" && r.client.Space().ID() != spaceID {
 		// The provider is configured to manage resources in a different space than the one the resource is
 		// currently configured, we won't allow that
@@ -346,7 +354,7 @@ func (r *customPolicyResource) ImportState(ctx context.Context, req resource.Imp
 		return
 	}
 
-	policy, err := r.client.GetPolicy(ctx, mrn, SpaceFrom(spaceID).MRN())
+	policy, err := r.client.GetPolicy(ctx, policyMrn, Space(spaceID).MRN())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get policy. Got error: %s", err))
 		return
@@ -358,7 +366,7 @@ func (r *customPolicyResource) ImportState(ctx context.Context, req resource.Imp
 		return
 	}
 
-	mrns, _ := types.ListValueFrom(ctx, types.StringType, []string{mrn})
+	mrns, _ := types.ListValueFrom(ctx, types.StringType, []string{policyMrn})
 
 	model := customPolicyResourceModel{
 		SpaceID:       types.StringValue(spaceID),
@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -19,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.mondoo.com/terraform-provider-mondoo/internal/mrn"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -278,7 +278,7 @@ func (r *customQueryPackResource) Update(ctx context.Context, req resource.Updat
 
 		// Do GraphQL request to API to update the resource.
 		setCustomPolicyPayload, err := r.client.SetCustomQueryPack(ctx,
-			SpaceFrom(planSpaceID).MRN(),
+			space.MRN(),
 			data.Overwrite.ValueBoolPointer(),
 			policyBundleData,
 		)
@@ -326,10 +326,17 @@ func (r *customQueryPackResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *customQueryPackResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	mrn := req.ID
-	splitMrn := strings.Split(mrn, "/")
-	spaceMrn := spacePrefix + splitMrn[len(splitMrn)-3]
-	spaceID := splitMrn[len(splitMrn)-3]
+	queryPackMrn := req.ID
+	parsed, err := mrn.Parse(queryPackMrn)
+	if err != nil || parsed.SpaceID == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Unable to import query pack, expected a space query pack MRN. Got: %s", queryPackMrn),
+		)
+		return
+	}
+	spaceID := parsed.SpaceID
+	spaceMrn := mrn.Space(spaceID)
 
 	if r.client.Space().ID() != "" && r.client.Space().ID() != spaceID {
 		// The provider is configured to manage resources in a different space than the one the
@@ -343,7 +350,7 @@ func (r *customQueryPackResource) ImportState(ctx context.Context, req resource.
 		return
 	}
 
-	queryPack, err := r.client.GetPolicy(ctx, mrn, spaceMrn)
+	queryPack, err := r.client.GetPolicy(ctx, queryPackMrn, spaceMrn)
 	if err != nil {
 		resp.Diagnostics.
 			AddError("Client Error",
@@ -361,7 +368,7 @@ func (r *customQueryPackResource) ImportState(ctx context.Context, req resource.
 		return
 	}
 
-	mrns, _ := types.ListValueFrom(ctx, types.StringType, []string{mrn})
+	mrns, _ := types.ListValueFrom(ctx, types.StringType, []string{queryPackMrn})
 
 	model := customQueryPackResourceModel{
 		SpaceID:       types.StringValue(spaceID),
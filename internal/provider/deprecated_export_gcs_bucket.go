@@ -264,7 +264,7 @@ func (r *GcsBucketExportResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *GcsBucketExportResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	integration, ok := r.client.ImportIntegration(ctx, req, resp)
+	integration, spaceID, ok := r.client.ImportSpaceIntegration(ctx, req, resp)
 	if !ok {
 		return
 	}
@@ -272,7 +272,7 @@ func (r *GcsBucketExportResource) ImportState(ctx context.Context, req resource.
 	model := GcsBucketExportResourceModel{
 		Mrn:          types.StringValue(integration.Mrn),
 		Name:         types.StringValue(integration.Name),
-		SpaceID:      types.StringValue(integration.SpaceID()),
+		SpaceID:      types.StringValue(spaceID),
 		BucketName:   types.StringValue(integration.ConfigurationOptions.GcsBucketConfigurationOptions.Bucket),
 		ExportFormat: types.StringValue(integration.ConfigurationOptions.GcsBucketConfigurationOptions.Output),
 
@@ -75,47 +75,72 @@ func (r *exceptionResource) GetConfigurationOptions(ctx context.Context, data *e
 	data.VulnerabilityMrns.ElementsAs(ctx, &vulnerabilities, false)
 
 	// Format ValidUntil to RFC3339 if provided
-	validUntil := data.ValidUntil.ValueString()
-	if validUntil != "" {
-		year, month, day, parseErr := parseDate(validUntil)
-		if parseErr != nil {
-			return "", nil, nil, "", parseErr
-		}
-		now := time.Now().UTC() // Use UTC directly
-		validUntilStr = time.Date(
-			year,
-			month,
-			day,
-			now.Hour(),
-			now.Minute(),
-			now.Second(),
-			now.Nanosecond(),
-			time.UTC,
-		).Format(time.RFC3339Nano) // Use RFC3339Nano to include nanoseconds
+	validUntilStr, err = validUntilTimestamp(data.ValidUntil.ValueString())
+	if err != nil {
+		return "", nil, nil, "", err
 	}
 
 	return scopeMrn, checks, vulnerabilities, validUntilStr, nil
 }
 
+// validUntilTimestamp converts a "YYYY-MM-DD" date into the RFC3339 timestamp expected by the
+// exceptions API. An empty date results in an empty timestamp.
+func validUntilTimestamp(validUntil string) (string, error) {
+	if validUntil == "" {
+		return "", nil
+	}
+	year, month, day, err := parseDate(validUntil)
+	if err != nil {
+		return "", err
+	}
+	now := time.Now().UTC() // Use UTC directly
+	return time.Date(
+		year,
+		month,
+		day,
+		now.Hour(),
+		now.Minute(),
+		now.Second(),
+		now.Nanosecond(),
+		time.UTC,
+	).Format(time.RFC3339Nano), nil // Use RFC3339Nano to include nanoseconds
+}
+
 // ValidUntilActionValidator ensures the "valid_until" attribute is only set when "action" is "SNOOZE", "RISK_ACCEPTED", "WORKAROUND" or "FALSE_POSITIVE".
-type ValidUntilActionValidator struct{}
+type ValidUntilActionValidator struct {
+	// defaultAction is validated when "action" is not set. If it is empty, an unset "action" is not validated.
+	defaultAction string
+}
 
 // NewValidUntilActionValidator is a convenience function for creating an instance of the validator.
 func NewValidUntilActionValidator() validator.String {
 	return &ValidUntilActionValidator{}
 }
 
+// NewValidUntilActionValidatorWithDefault creates an instance of the validator for resources whose "action"
+// defaults to the given action.
+func NewValidUntilActionValidatorWithDefault(defaultAction string) validator.String {
+	return &ValidUntilActionValidator{defaultAction: defaultAction}
+}
+
 // ValidateString performs the validation for the "valid_until" attribute.
 func (v ValidUntilActionValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
 	// Retrieve the "action" attribute value from the attribute path
 	var actionAttr types.String
 	err := req.Config.GetAttribute(ctx, path.Root("action"), &actionAttr)
-	if err != nil || actionAttr.IsNull() {
-		return // If "action" is not set or there's an error, nothing to validate
+	if err != nil || actionAttr.IsUnknown() {
+		return // If there's an error or "action" is not known yet, nothing to validate
+	}
+	action := actionAttr.ValueString()
+	if actionAttr.IsNull() {
+		if v.defaultAction == "" {
+			return // If "action" is not set, nothing to validate
+		}
+		action = v.defaultAction
 	}
 
 	validUntilActions := []string{"RISK_ACCEPTED", "WORKAROUND", "FALSE_POSITIVE", "SNOOZE"}
-	if !slices.Contains(validUntilActions, actionAttr.ValueString()) && !req.ConfigValue.IsNull() {
+	if !slices.Contains(validUntilActions, action) && !req.ConfigValue.IsNull() {
 		resp.Diagnostics.AddAttributeError(
 			req.Path,
 			"'valid_until' Can Only Be Set with 'action' as `SNOOZE`, 'RISK_ACCEPTED', 'WORKAROUND' or 'FALSE_POSITIVE'",
@@ -397,8 +397,8 @@ func (r *ExportGcsBucketResource) ImportState(ctx context.Context, req resource.
 		}
 	}
 
-	if integration.IsSpaceScoped() {
-		model.SpaceID = types.StringValue(integration.SpaceID())
+	if spaceID, err := integration.SpaceID(); err == nil {
+		model.SpaceID = types.StringValue(spaceID)
 	}
 
 	resp.State.Set(ctx, &model)
@@ -363,8 +363,8 @@ func (r *S3BucketExportResource) ImportState(ctx context.Context, req resource.I
 		},
 	}
 
-	if integration.IsSpaceScoped() {
-		model.SpaceID = types.StringValue(integration.SpaceID())
+	if spaceID, err := integration.SpaceID(); err == nil {
+		model.SpaceID = types.StringValue(spaceID)
 	}
 
 	resp.State.Set(ctx, &model)
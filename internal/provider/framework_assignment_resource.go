@@ -6,17 +6,34 @@ package provider
 import (
 	"context"
 	"fmt"
+	"sort"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	mondoov1 "go.mondoo.com/mondoo-go"
 )
 
-var _ resource.Resource = (*frameworkAssignmentResource)(nil)
+var (
+	_ resource.Resource                   = (*frameworkAssignmentResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*frameworkAssignmentResource)(nil)
+	_ resource.ResourceWithImportState    = (*frameworkAssignmentResource)(nil)
+)
+
+// Framework states that can be configured in a framework block.
+const (
+	frameworkStateEnabled  = "ENABLED"
+	frameworkStatePreview  = "PREVIEW"
+	frameworkStateDisabled = "DISABLED"
+)
 
 func NewFrameworkAssignmentResource() resource.Resource {
 	return &frameworkAssignmentResource{}
@@ -31,8 +48,14 @@ type frameworkAssignmentResourceModel struct {
 	SpaceID types.String `tfsdk:"space_id"`
 
 	// resource details
-	FrameworkMrn types.List `tfsdk:"framework_mrn"`
-	Enabled      types.Bool `tfsdk:"enabled"`
+	FrameworkMrn types.List                 `tfsdk:"framework_mrn"`
+	Enabled      types.Bool                 `tfsdk:"enabled"`
+	Frameworks   []frameworkAssignmentModel `tfsdk:"framework"`
+}
+
+type frameworkAssignmentModel struct {
+	Mrn   types.String `tfsdk:"mrn"`
+	State types.String `tfsdk:"state"`
 }
 
 func (r *frameworkAssignmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -53,15 +76,189 @@ func (r *frameworkAssignmentResource) Schema(_ context.Context, _ resource.Schem
 			},
 			"framework_mrn": schema.ListAttribute{
 				MarkdownDescription: "Compliance framework MRN.",
-				Required:            true,
+				Optional:            true,
 				ElementType:         types.StringType,
+				DeprecationMessage:  "Use `framework` blocks instead. This attribute will be removed in a future version.",
 			},
 			"enabled": schema.BoolAttribute{
 				MarkdownDescription: "Enable or disable the compliance framework.",
-				Required:            true,
+				Optional:            true,
+				DeprecationMessage:  "Use the `state` of `framework` blocks instead. This attribute will be removed in a future version.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"framework": schema.ListNestedBlock{
+				MarkdownDescription: "Compliance framework and its state in the space. Frameworks that are removed from the configuration are disabled.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"mrn": schema.StringAttribute{
+							MarkdownDescription: "Compliance framework MRN.",
+							Required:            true,
+						},
+						"state": schema.StringAttribute{
+							MarkdownDescription: "State of the compliance framework. Default is `ENABLED`. Other valid values are `PREVIEW` and `DISABLED`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(frameworkStateEnabled),
+							Validators: []validator.String{
+								stringvalidator.OneOf(frameworkStateEnabled, frameworkStatePreview, frameworkStateDisabled),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *frameworkAssignmentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data frameworkAssignmentResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateFrameworkAssignmentResourceModel(&data)...)
+}
+
+func validateFrameworkAssignmentResourceModel(data *frameworkAssignmentResourceModel) (diagnostics diag.Diagnostics) {
+	// values may be unknown during validation, they will be checked once they are known
+	if data.FrameworkMrn.IsUnknown() || data.Enabled.IsUnknown() {
+		return
+	}
+
+	legacy := !data.FrameworkMrn.IsNull()
+	switch {
+	case legacy && len(data.Frameworks) > 0:
+		diagnostics.AddError(
+			"ConflictingAttributesError",
+			"Attribute framework_mrn cannot be used together with framework blocks.",
+		)
+	case legacy && data.Enabled.IsNull():
+		diagnostics.AddError(
+			"MissingAttributeError",
+			"Attribute enabled must be set when framework_mrn is used.",
+		)
+	case !legacy && !data.Enabled.IsNull():
+		diagnostics.AddError(
+			"MissingAttributeError",
+			"Attribute framework_mrn must be set when enabled is used.",
+		)
+	case !legacy && len(data.Frameworks) == 0:
+		diagnostics.AddError(
+			"MissingAttributeError",
+			"At least one framework block must be configured.",
+		)
+	}
+
+	seen := map[string]bool{}
+	for _, f := range data.Frameworks {
+		if f.Mrn.IsUnknown() {
+			continue
+		}
+		if seen[f.Mrn.ValueString()] {
+			diagnostics.AddError(
+				"DuplicateFrameworkError",
+				fmt.Sprintf("Framework %s is configured more than once.", f.Mrn.ValueString()),
+			)
+		}
+		seen[f.Mrn.ValueString()] = true
+	}
+	return
+}
+
+// frameworkMutationAction returns the API action that puts a framework into the provided state.
+func frameworkMutationAction(state string) mondoov1.ComplianceFrameworkMutationAction {
+	switch state {
+	case frameworkStatePreview:
+		return mondoov1.ComplianceFrameworkMutationActionPreview
+	case frameworkStateDisabled:
+		return mondoov1.ComplianceFrameworkMutationActionDisable
+	default:
+		return mondoov1.ComplianceFrameworkMutationActionEnable
+	}
+}
+
+// frameworkStateFromAPI converts the framework state reported by the API into the state used
+// in framework blocks. Frameworks that are neither active nor in preview are disabled.
+func frameworkStateFromAPI(state string) string {
+	switch state {
+	case "ACTIVE":
+		return frameworkStateEnabled
+	case "PREVIEW":
+		return frameworkStatePreview
+	default:
+		return frameworkStateDisabled
+	}
+}
+
+// listFrameworkStates returns the state of every framework in the space, keyed by framework MRN.
+func (r *frameworkAssignmentResource) listFrameworkStates(ctx context.Context, spaceMrn string) (map[string]string, error) {
+	frameworks, err := r.client.ListFrameworks(ctx, spaceMrn)
+	if err != nil {
+		return nil, err
+	}
+
+	states := make(map[string]string, len(frameworks))
+	for _, framework := range frameworks {
+		states[string(framework.Mrn)] = frameworkStateFromAPI(string(framework.State))
+	}
+	return states, nil
+}
+
+// legacyFrameworkAssignments converts the deprecated framework_mrn and enabled attributes into
+// framework blocks, so that a migration to framework blocks can be diffed against them. Frameworks
+// with enabled set to false are in preview, since that is what BulkUpdateFramework applies for them.
+func legacyFrameworkAssignments(ctx context.Context, data frameworkAssignmentResourceModel) ([]frameworkAssignmentModel, diag.Diagnostics) {
+	frameworkMrns := []string{}
+	diags := data.FrameworkMrn.ElementsAs(ctx, &frameworkMrns, false)
+
+	state := frameworkStatePreview
+	if data.Enabled.ValueBool() {
+		state = frameworkStateEnabled
+	}
+
+	frameworks := make([]frameworkAssignmentModel, 0, len(frameworkMrns))
+	for _, frameworkMrn := range frameworkMrns {
+		frameworks = append(frameworks, frameworkAssignmentModel{
+			Mrn:   types.StringValue(frameworkMrn),
+			State: types.StringValue(state),
+		})
 	}
+	return frameworks, diags
+}
+
+// applyFrameworks moves every framework in the plan whose state differs from the prior state.
+// Frameworks that are only part of the prior state are disabled.
+func (r *frameworkAssignmentResource) applyFrameworks(ctx context.Context, spaceMrn string, prior, planned []frameworkAssignmentModel) error {
+	priorStates := map[string]string{}
+	for _, f := range prior {
+		priorStates[f.Mrn.ValueString()] = f.State.ValueString()
+	}
+
+	for _, f := range planned {
+		frameworkMrn := f.Mrn.ValueString()
+		state := f.State.ValueString()
+		if priorState, ok := priorStates[frameworkMrn]; !ok || priorState != state {
+			tflog.Debug(ctx, "Updating framework state", map[string]interface{}{"framework_mrn": frameworkMrn, "state": state})
+			if err := r.client.ApplyFrameworkAction(ctx, frameworkMrn, spaceMrn, frameworkMutationAction(state)); err != nil {
+				return fmt.Errorf("unable to set framework %s to %s: %w", frameworkMrn, state, err)
+			}
+		}
+		delete(priorStates, frameworkMrn)
+	}
+
+	for frameworkMrn, state := range priorStates {
+		if state == frameworkStateDisabled {
+			continue
+		}
+		tflog.Debug(ctx, "Disabling removed framework", map[string]interface{}{"framework_mrn": frameworkMrn})
+		if err := r.client.ApplyFrameworkAction(ctx, frameworkMrn, spaceMrn, mondoov1.ComplianceFrameworkMutationActionDisable); err != nil {
+			return fmt.Errorf("unable to disable framework %s: %w", frameworkMrn, err)
+		}
+	}
+	return nil
 }
 
 func (r *frameworkAssignmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -104,19 +301,25 @@ func (r *frameworkAssignmentResource) Create(ctx context.Context, req resource.C
 
 	// Do GraphQL request to API to create the resource.
 	tflog.Debug(ctx, "Creating framework assignment")
-	err = r.client.BulkUpdateFramework(ctx,
-		data.FrameworkMrn,
-		space.ID(),
-		data.Enabled.ValueBool(),
-	)
+	if data.FrameworkMrn.IsNull() {
+		err = r.applyFrameworks(ctx, space.MRN(), nil, data.Frameworks)
+	} else {
+		err = r.client.BulkUpdateFramework(ctx,
+			data.FrameworkMrn,
+			space.ID(),
+			data.Enabled.ValueBool(),
+		)
+	}
 	if err != nil {
 		resp.Diagnostics.
 			AddError("Client Error",
-				fmt.Sprintf("Unable to create compliance framework. Got error: %s", err),
+				fmt.Sprintf("Unable to create compliance framework assignment. Got error: %s", err),
 			)
 		return
 	}
 
+	data.SpaceID = types.StringValue(space.ID())
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -131,17 +334,52 @@ func (r *frameworkAssignmentResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
-	// Read API call logic
+	space, err := r.client.ComputeSpace(data.SpaceID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+		return
+	}
+
+	states, err := r.listFrameworkStates(ctx, space.MRN())
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to list compliance frameworks. Got error: %s", err),
+			)
+		return
+	}
+
+	if data.FrameworkMrn.IsNull() {
+		// compare each framework's actual state against the prior state
+		for i, f := range data.Frameworks {
+			data.Frameworks[i].State = types.StringValue(states[f.Mrn.ValueString()])
+			if data.Frameworks[i].State.ValueString() == "" {
+				data.Frameworks[i].State = types.StringValue(frameworkStateDisabled)
+			}
+		}
+	} else {
+		// the deprecated attributes can only express whether all frameworks are enabled
+		frameworkMrns := []string{}
+		data.FrameworkMrn.ElementsAs(ctx, &frameworkMrns, false)
+		enabled := true
+		for _, frameworkMrn := range frameworkMrns {
+			if states[frameworkMrn] != frameworkStateEnabled {
+				enabled = false
+			}
+		}
+		data.Enabled = types.BoolValue(enabled)
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *frameworkAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data frameworkAssignmentResourceModel
+	var data, state frameworkAssignmentResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and prior state data into the models
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -168,14 +406,29 @@ func (r *frameworkAssignmentResource) Update(ctx context.Context, req resource.U
 	}
 
 	tflog.Debug(ctx, "Updating framework assignment")
-	err = r.client.BulkUpdateFramework(ctx,
-		data.FrameworkMrn,
-		planSpaceID,
-		data.Enabled.ValueBool())
+	if data.FrameworkMrn.IsNull() {
+		prior := state.Frameworks
+		if !state.FrameworkMrn.IsNull() {
+			// frameworks that were only part of the deprecated list are disabled
+			var diags diag.Diagnostics
+			prior, diags = legacyFrameworkAssignments(ctx, state)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+		// only frameworks whose state changed are updated
+		err = r.applyFrameworks(ctx, space.MRN(), prior, data.Frameworks)
+	} else {
+		err = r.client.BulkUpdateFramework(ctx,
+			data.FrameworkMrn,
+			planSpaceID,
+			data.Enabled.ValueBool())
+	}
 	if err != nil {
 		resp.Diagnostics.
 			AddError("Client Error",
-				fmt.Sprintf("Unable to create compliance framework. Got error: %s", err),
+				fmt.Sprintf("Unable to update compliance framework assignment. Got error: %s", err),
 			)
 		return
 	}
@@ -194,19 +447,79 @@ func (r *frameworkAssignmentResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 
-	err := r.client.BulkUpdateFramework(ctx,
-		data.FrameworkMrn,
-		data.SpaceID.ValueString(),
-		false)
+	// frameworks are disabled on removal, no matter if they were assigned with the deprecated attributes
+	prior := data.Frameworks
+	if !data.FrameworkMrn.IsNull() {
+		var diags diag.Diagnostics
+		prior, diags = legacyFrameworkAssignments(ctx, data)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	err := r.applyFrameworks(ctx, spacePrefix+data.SpaceID.ValueString(), prior, nil)
 	if err != nil {
 		resp.Diagnostics.
 			AddError("Client Error",
-				fmt.Sprintf("Unable to create compliance framework. Got error: %s", err),
+				fmt.Sprintf("Unable to remove compliance framework assignment. Got error: %s", err),
 			)
 		return
 	}
 }
 
+// ImportState imports all frameworks that are enabled or in preview in a space. The import ID
+// is the space ID or MRN.
 func (r *frameworkAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("mrn"), req, resp)
+	space, err := SpaceFrom(req.ID)
+	spaceID := space.ID()
+	if err != nil || spaceID == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Unable to import framework assignment, expected a space ID or MRN. Got: %s", req.ID),
+		)
+		return
+	}
+
+	if r.client.Space().ID() != "" && r.client.Space().ID() != spaceID {
+		// The provider is configured to manage resources in a different space than the one the
+		// resource is currently configured, we won't allow that
+		resp.Diagnostics.AddError(
+			"Conflict Error",
+			fmt.Sprintf(
+				"Unable to import framework assignment. The provider is configured in a different space than the resource. (%s != %s)",
+				r.client.Space().ID(), spaceID),
+		)
+		return
+	}
+
+	states, err := r.listFrameworkStates(ctx, spacePrefix+spaceID)
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to list compliance frameworks. Got error: %s", err),
+			)
+		return
+	}
+
+	model := frameworkAssignmentResourceModel{
+		SpaceID:      types.StringValue(spaceID),
+		FrameworkMrn: types.ListNull(types.StringType),
+		Enabled:      types.BoolNull(),
+		Frameworks:   []frameworkAssignmentModel{},
+	}
+	for frameworkMrn, state := range states {
+		if state == frameworkStateDisabled {
+			continue
+		}
+		model.Frameworks = append(model.Frameworks, frameworkAssignmentModel{
+			Mrn:   types.StringValue(frameworkMrn),
+			State: types.StringValue(state),
+		})
+	}
+	sort.Slice(model.Frameworks, func(i, j int) bool {
+		return model.Frameworks[i].Mrn.ValueString() < model.Frameworks[j].Mrn.ValueString()
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 }
@@ -0,0 +1,194 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testFrameworkCisMrn = "//policy.api.mondoo.app/frameworks/cis-controls-8"
+	testFrameworkIsoMrn = "//policy.api.mondoo.app/frameworks/iso-27001-2022"
+)
+
+func TestAccFrameworkAssignmentResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFrameworkAssignmentResourceConfig(accSpace.ID(), "ENABLED", "PREVIEW"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mondoo_framework_assignment.test", "space_id", accSpace.ID()),
+					resource.TestCheckResourceAttr("mondoo_framework_assignment.test", "framework.0.state", "ENABLED"),
+					resource.TestCheckResourceAttr("mondoo_framework_assignment.test", "framework.1.state", "PREVIEW"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:  "mondoo_framework_assignment.test",
+				ImportState:   true,
+				ImportStateId: accSpace.ID(),
+				// the space may contain other active frameworks, so only check the ones we manage
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					imported := map[string]string{}
+					for key, value := range states[0].Attributes {
+						if strings.HasPrefix(key, "framework.") && strings.HasSuffix(key, ".mrn") {
+							imported[value] = states[0].Attributes[strings.TrimSuffix(key, ".mrn")+".state"]
+						}
+					}
+					if imported[testFrameworkCisMrn] != "ENABLED" || imported[testFrameworkIsoMrn] != "PREVIEW" {
+						return fmt.Errorf("unexpected imported frameworks: %v", imported)
+					}
+					return nil
+				},
+			},
+			// Update and Read testing
+			{
+				Config: testAccFrameworkAssignmentResourceConfig(accSpace.ID(), "PREVIEW", "PREVIEW"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mondoo_framework_assignment.test", "framework.0.state", "PREVIEW"),
+					resource.TestCheckResourceAttr("mondoo_framework_assignment.test", "framework.1.state", "PREVIEW"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccFrameworkAssignmentResourceConfig(spaceID, cisState, isoState string) string {
+	return fmt.Sprintf(`
+resource "mondoo_framework_assignment" "test" {
+  space_id = %[1]q
+
+  framework {
+    mrn   = %[2]q
+    state = %[3]q
+  }
+
+  framework {
+    mrn   = %[4]q
+    state = %[5]q
+  }
+}
+`, spaceID, testFrameworkCisMrn, cisState, testFrameworkIsoMrn, isoState)
+}
+
+func TestFrameworkAssignmentResourceValidateConfig(t *testing.T) {
+	framework := func(mrn string) frameworkAssignmentModel {
+		return frameworkAssignmentModel{Mrn: types.StringValue(mrn), State: types.StringValue(frameworkStateEnabled)}
+	}
+	frameworkMrns := types.ListValueMust(types.StringType, []attr.Value{types.StringValue(testFrameworkCisMrn)})
+
+	tests := []struct {
+		name    string
+		data    frameworkAssignmentResourceModel
+		summary string
+	}{
+		{
+			name: "framework blocks",
+			data: frameworkAssignmentResourceModel{
+				FrameworkMrn: types.ListNull(types.StringType),
+				Enabled:      types.BoolNull(),
+				Frameworks:   []frameworkAssignmentModel{framework(testFrameworkCisMrn), framework(testFrameworkIsoMrn)},
+			},
+		},
+		{
+			name: "deprecated attributes",
+			data: frameworkAssignmentResourceModel{
+				FrameworkMrn: frameworkMrns,
+				Enabled:      types.BoolValue(true),
+			},
+		},
+		{
+			name: "nothing configured",
+			data: frameworkAssignmentResourceModel{
+				FrameworkMrn: types.ListNull(types.StringType),
+				Enabled:      types.BoolNull(),
+			},
+			summary: "MissingAttributeError",
+		},
+		{
+			name: "framework_mrn without enabled",
+			data: frameworkAssignmentResourceModel{
+				FrameworkMrn: frameworkMrns,
+				Enabled:      types.BoolNull(),
+			},
+			summary: "MissingAttributeError",
+		},
+		{
+			name: "framework_mrn and framework blocks",
+			data: frameworkAssignmentResourceModel{
+				FrameworkMrn: frameworkMrns,
+				Enabled:      types.BoolValue(true),
+				Frameworks:   []frameworkAssignmentModel{framework(testFrameworkIsoMrn)},
+			},
+			summary: "ConflictingAttributesError",
+		},
+		{
+			name: "duplicate framework",
+			data: frameworkAssignmentResourceModel{
+				FrameworkMrn: types.ListNull(types.StringType),
+				Enabled:      types.BoolNull(),
+				Frameworks:   []frameworkAssignmentModel{framework(testFrameworkCisMrn), framework(testFrameworkCisMrn)},
+			},
+			summary: "DuplicateFrameworkError",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diagnostics := validateFrameworkAssignmentResourceModel(&tt.data)
+			if tt.summary == "" {
+				assert.False(t, diagnostics.HasError(), "expected NO errors")
+				return
+			}
+			if assert.True(t, diagnostics.HasError(), "expected errors") {
+				assert.Equal(t, tt.summary, diagnostics[0].Summary())
+			}
+		})
+	}
+}
+
+func TestFrameworkStateFromAPI(t *testing.T) {
+	assert.Equal(t, frameworkStateEnabled, frameworkStateFromAPI("ACTIVE"))
+	assert.Equal(t, frameworkStatePreview, frameworkStateFromAPI("PREVIEW"))
+	assert.Equal(t, frameworkStateDisabled, frameworkStateFromAPI("DISABLED"))
+	assert.Equal(t, frameworkStateDisabled, frameworkStateFromAPI(""))
+}
+
+func TestLegacyFrameworkAssignments(t *testing.T) {
+	frameworkMrns := types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue(testFrameworkCisMrn),
+		types.StringValue(testFrameworkIsoMrn),
+	})
+
+	frameworks, diags := legacyFrameworkAssignments(context.Background(), frameworkAssignmentResourceModel{
+		FrameworkMrn: frameworkMrns,
+		Enabled:      types.BoolValue(true),
+	})
+	require.False(t, diags.HasError())
+	assert.Equal(t, []frameworkAssignmentModel{
+		{Mrn: types.StringValue(testFrameworkCisMrn), State: types.StringValue(frameworkStateEnabled)},
+		{Mrn: types.StringValue(testFrameworkIsoMrn), State: types.StringValue(frameworkStateEnabled)},
+	}, frameworks)
+
+	frameworks, diags = legacyFrameworkAssignments(context.Background(), frameworkAssignmentResourceModel{
+		FrameworkMrn: frameworkMrns,
+		Enabled:      types.BoolValue(false),
+	})
+	require.False(t, diags.HasError())
+	assert.Equal(t, types.StringValue(frameworkStatePreview), frameworks[0].State)
+}
@@ -0,0 +1,311 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	mondoov1 "go.mondoo.com/mondoo-go"
+)
+
+var (
+	_ resource.Resource                = (*frameworkControlExceptionResource)(nil)
+	_ resource.ResourceWithImportState = (*frameworkControlExceptionResource)(nil)
+)
+
+func NewFrameworkControlExceptionResource() resource.Resource {
+	return &frameworkControlExceptionResource{}
+}
+
+type frameworkControlExceptionResource struct {
+	client *ExtendedGqlClient
+}
+
+type frameworkControlExceptionResourceModel struct {
+	ScopeMrn      types.String `tfsdk:"scope_mrn"`
+	ControlMrns   types.List   `tfsdk:"control_mrns"`
+	Action        types.String `tfsdk:"action"`
+	Justification types.String `tfsdk:"justification"`
+	ValidUntil    types.String `tfsdk:"valid_until"`
+	ExceptionId   types.String `tfsdk:"exception_id"`
+}
+
+func (r *frameworkControlExceptionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_framework_control_exception"
+}
+
+func (r *frameworkControlExceptionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Mark compliance framework controls as out of scope for a space or asset, or snooze them until an expiry date, with a justification.`,
+		Attributes: map[string]schema.Attribute{
+			"scope_mrn": schema.StringAttribute{
+				MarkdownDescription: "The MRN of the scope (either asset mrn or space mrn). If not set, the provider space is used.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"control_mrns": schema.ListAttribute{
+				MarkdownDescription: "List of framework control MRNs to set the exception for.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"action": schema.StringAttribute{
+				MarkdownDescription: "The action to perform. Default is `OUT_OF_SCOPE`. Other valid values are `DISABLE` and `SNOOZE`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("OUT_OF_SCOPE"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("OUT_OF_SCOPE", "DISABLE", "SNOOZE"),
+				},
+			},
+			"justification": schema.StringAttribute{
+				MarkdownDescription: "Description why the controls are excluded.",
+				Required:            true,
+			},
+			"valid_until": schema.StringAttribute{
+				MarkdownDescription: "The date (`YYYY-MM-DD`) when the exception expires. Required if `action` is `SNOOZE`, and can only be set for snoozed controls.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[1-9][0-9][0-9]{2}-([0][1-9]|[1][0-2])-([1-2][0-9]|[0][1-9]|[3][0-1])$`), "Date must be in the format 'YYYY-MM-DD'"),
+					NewValidUntilActionValidatorWithDefault("OUT_OF_SCOPE"),
+					NewValidUntilPresentValidator(),
+				},
+			},
+			"exception_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the exception.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *frameworkControlExceptionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ExtendedGqlClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client. Got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// createException creates the exception group for the controls in the model and stores its ID.
+func (r *frameworkControlExceptionResource) createException(ctx context.Context, data *frameworkControlExceptionResourceModel, controls []string) error {
+	scopeMrn := data.ScopeMrn.ValueString()
+	if scopeMrn == "" {
+		scopeMrn = r.client.space.MRN()
+	}
+
+	validUntil, err := validUntilTimestamp(data.ValidUntil.ValueString())
+	if err != nil {
+		return err
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating framework control exception for scope %s", scopeMrn))
+	id, err := r.client.CreateException(ctx, scopeMrn,
+		mondoov1.ExceptionMutationAction(data.Action.ValueString()),
+		[]string{}, controls, []string{}, []string{},
+		data.Justification.ValueStringPointer(), &validUntil, (*bool)(mondoov1.NewBooleanPtr(false)))
+	if err != nil {
+		return err
+	}
+
+	data.ScopeMrn = types.StringValue(scopeMrn)
+	data.ExceptionId = types.StringValue(id)
+	return nil
+}
+
+func (r *frameworkControlExceptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data frameworkControlExceptionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	controls := []string{}
+	resp.Diagnostics.Append(data.ControlMrns.ElementsAs(ctx, &controls, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.createException(ctx, &data, controls); err != nil {
+		resp.Diagnostics.AddError("Failed to create framework control exception", err.Error())
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *frameworkControlExceptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data frameworkControlExceptionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	exception, err := r.client.GetControlException(ctx, data.ScopeMrn.ValueString(), data.ExceptionId.ValueString())
+	if err != nil {
+		if errors.Is(err, errExceptionNotFound) || isNotFoundError(err) {
+			// The exception was deleted or has expired.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read framework control exception", err.Error())
+		return
+	}
+
+	data.Action = types.StringValue(exception.Action)
+	if exception.Justification != nil {
+		data.Justification = types.StringValue(*exception.Justification)
+	}
+	if exception.ValidUntil != nil && *exception.ValidUntil != "" {
+		t, err := time.Parse(time.RFC3339, *exception.ValidUntil)
+		if err == nil {
+			data.ValidUntil = types.StringValue(t.UTC().Format(time.DateOnly))
+		}
+	} else {
+		data.ValidUntil = types.StringNull()
+	}
+
+	controls := make([]string, 0, len(exception.Exceptions))
+	for _, e := range exception.Exceptions {
+		if e.Control.Mrn != "" {
+			controls = append(controls, e.Control.Mrn)
+		}
+	}
+	data.ControlMrns = controlMrnsFromPayload(ctx, data.ControlMrns, controls)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// controlMrnsFromPayload keeps the configured order of the control MRNs when the exception still
+// covers the same controls.
+func controlMrnsFromPayload(ctx context.Context, prior types.List, controls []string) types.List {
+	priorControls := []string{}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		prior.ElementsAs(ctx, &priorControls, false)
+	}
+
+	sorted := slices.Clone(controls)
+	slices.Sort(sorted)
+	slices.Sort(priorControls)
+	if slices.Equal(sorted, priorControls) {
+		return prior
+	}
+	return ConvertListValue(sorted)
+}
+
+func (r *frameworkControlExceptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state frameworkControlExceptionResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	controls := []string{}
+	resp.Diagnostics.Append(data.ControlMrns.ElementsAs(ctx, &controls, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Exceptions cannot be modified, replace the existing exception group with a new one. The new
+	// exception is created first, so the controls stay excepted if the creation fails.
+	if err := r.createException(ctx, &data, controls); err != nil {
+		resp.Diagnostics.AddError("Failed to update framework control exception", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting framework control exception %s", state.ExceptionId.ValueString()))
+	err := r.client.DeleteExceptions(ctx, []string{state.ExceptionId.ValueString()}, state.ScopeMrn.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to delete previous framework control exception",
+			fmt.Sprintf("The exception was replaced by %s, but the previous exception %s could not be deleted: %s",
+				data.ExceptionId.ValueString(), state.ExceptionId.ValueString(), err))
+		return
+	}
+}
+
+func (r *frameworkControlExceptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data frameworkControlExceptionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting framework control exception %s for scope %s", data.ExceptionId.ValueString(), data.ScopeMrn.ValueString()))
+	err := r.client.DeleteExceptions(ctx, []string{data.ExceptionId.ValueString()}, data.ScopeMrn.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to delete framework control exception", err.Error())
+		return
+	}
+}
+
+func (r *frameworkControlExceptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import ID format: <exception_id> for exceptions in the provider space, or <scope_mrn>:<exception_id>
+	scopeMrn, exceptionID, ok := strings.Cut(req.ID, ":")
+	if !ok {
+		scopeMrn, exceptionID = r.client.space.MRN(), req.ID
+	}
+	if scopeMrn == "" || exceptionID == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID format: <exception_id> or <scope_mrn>:<exception_id>, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("scope_mrn"), scopeMrn)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("exception_id"), exceptionID)...)
+}
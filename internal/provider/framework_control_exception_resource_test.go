@@ -0,0 +1,121 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccFrameworkControlExceptionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Dates with surrounding characters are rejected
+			{
+				Config:      testAccFrameworkControlExceptionResourceConfig(accSpace.ID(), "SNOOZE", "Not applicable to this space.", "xx2030-01-01yy"),
+				ExpectError: regexp.MustCompile("Date must be in the format 'YYYY-MM-DD'"),
+			},
+			// Controls that are out of scope do not expire
+			{
+				Config:      testAccFrameworkControlExceptionResourceConfig(accSpace.ID(), "OUT_OF_SCOPE", "Not applicable to this space.", "2030-01-01"),
+				ExpectError: regexp.MustCompile("'valid_until' Can Only Be Set with 'action'"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccFrameworkControlExceptionResourceConfig(accSpace.ID(), "SNOOZE", "Not applicable to this space.", "2030-01-01"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mondoo_framework_control_exception.test", "scope_mrn", accSpace.MRN()),
+					resource.TestCheckResourceAttr("mondoo_framework_control_exception.test", "action", "SNOOZE"),
+					resource.TestCheckResourceAttr("mondoo_framework_control_exception.test", "control_mrns.#", "1"),
+					resource.TestCheckResourceAttr("mondoo_framework_control_exception.test", "valid_until", "2030-01-01"),
+					resource.TestCheckResourceAttrSet("mondoo_framework_control_exception.test", "exception_id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: "mondoo_framework_control_exception.test",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["mondoo_framework_control_exception.test"]
+					return rs.Primary.Attributes["scope_mrn"] + ":" + rs.Primary.Attributes["exception_id"], nil
+				},
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "exception_id",
+			},
+			// Update and Read testing
+			{
+				Config: testAccFrameworkControlExceptionResourceConfig(accSpace.ID(), "OUT_OF_SCOPE", "Handled by the hosting provider.", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mondoo_framework_control_exception.test", "action", "OUT_OF_SCOPE"),
+					resource.TestCheckResourceAttr("mondoo_framework_control_exception.test", "justification", "Handled by the hosting provider."),
+					resource.TestCheckNoResourceAttr("mondoo_framework_control_exception.test", "valid_until"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccFrameworkControlExceptionResourceConfig(spaceID, action, justification, validUntil string) string {
+	if validUntil != "" {
+		validUntil = fmt.Sprintf("%q", validUntil)
+	} else {
+		validUntil = "null"
+	}
+
+	return fmt.Sprintf(`
+resource "mondoo_framework" "test" {
+  space_id = %[1]q
+  uid      = "tf-acc-exception-framework"
+  name     = "Terraform Acceptance Exception Framework"
+
+  groups = {
+    "tf-acc-exception-framework-physical" = {
+      title = "Physical Security"
+
+      controls = {
+        "tf-acc-exception-framework-ps-01" = {
+          title = "Data Center Access"
+        }
+      }
+    }
+  }
+}
+
+resource "mondoo_framework_control_exception" "test" {
+  scope_mrn     = "//captain.api.mondoo.app/spaces/%[1]s"
+  control_mrns  = ["//policy.api.mondoo.app/spaces/%[1]s/controls/tf-acc-exception-framework-ps-01"]
+  action        = %[2]q
+  justification = %[3]q
+  valid_until   = %[4]s
+
+  depends_on = [mondoo_framework.test]
+}
+`, spaceID, action, justification, validUntil)
+}
+
+func TestControlMrnsFromPayload(t *testing.T) {
+	ctx := context.Background()
+	first := "//policy.api.mondoo.app/controls/mondoo-soc2-cc6.5"
+	second := "//policy.api.mondoo.app/controls/mondoo-soc2-cc6.4"
+	prior := ConvertListValue([]string{first, second})
+
+	// the configured order is kept
+	assert.Equal(t, prior, controlMrnsFromPayload(ctx, prior, []string{second, first}))
+
+	// controls that changed outside of Terraform are refreshed
+	assert.Equal(t, ConvertListValue([]string{second}), controlMrnsFromPayload(ctx, prior, []string{second}))
+
+	// imported exceptions have no prior state
+	assert.Equal(t, ConvertListValue([]string{second, first}), controlMrnsFromPayload(ctx, types.ListNull(types.StringType), []string{first, second}))
+}
@@ -0,0 +1,396 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	mondoov1 "go.mondoo.com/mondoo-go"
+	"go.mondoo.com/terraform-provider-mondoo/internal/mrn"
+)
+
+var (
+	_ resource.Resource                = (*frameworkEvidenceResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*frameworkEvidenceResource)(nil)
+	_ resource.ResourceWithImportState = (*frameworkEvidenceResource)(nil)
+)
+
+func NewFrameworkEvidenceResource() resource.Resource {
+	return &frameworkEvidenceResource{}
+}
+
+type frameworkEvidenceResource struct {
+	client *ExtendedGqlClient
+}
+
+type frameworkEvidenceResourceModel struct {
+	// scope
+	SpaceID types.String `tfsdk:"space_id"`
+
+	// resource details
+	Mrn           types.String `tfsdk:"mrn"`
+	ControlMrn    types.String `tfsdk:"control_mrn"`
+	Title         types.String `tfsdk:"title"`
+	Description   types.String `tfsdk:"description"`
+	Url           types.String `tfsdk:"url"`
+	Document      types.String `tfsdk:"document"`
+	Crc32Checksum types.String `tfsdk:"crc32c"`
+}
+
+func (r *frameworkEvidenceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_framework_evidence"
+}
+
+func (r *frameworkEvidenceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Attach manual evidence, either a document or a URL, to a compliance framework control that can't be checked automatically.`,
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "Mondoo space identifier. If there's no space ID, the provider space is used.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mrn": schema.StringAttribute{
+				MarkdownDescription: "Mondoo resource name of the evidence.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"control_mrn": schema.StringAttribute{
+				MarkdownDescription: "MRN of the framework control the evidence is attached to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Title of the evidence.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the evidence.",
+				Optional:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "URL of the evidence, for example a link to a ticket or a wiki page. Must be defined if document is not.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.Expressions{
+						path.MatchRoot("document"),
+					}...),
+				},
+			},
+			"document": schema.StringAttribute{
+				MarkdownDescription: "A path to the document you want to upload as evidence. Must be defined if url is not.",
+				Optional:            true,
+			},
+			"crc32c": schema.StringAttribute{
+				MarkdownDescription: "Base64 CRC32 hash of the uploaded document.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *frameworkEvidenceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ExtendedGqlClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client. Got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// getDocument reads the evidence document from disk and returns it as data URL together with
+// its checksum. It returns nil if the evidence is a URL.
+func (r *frameworkEvidenceResource) getDocument(data frameworkEvidenceResourceModel) (*mondoov1.String, string, error) {
+	if data.Document.IsNull() || data.Document.IsUnknown() {
+		return nil, "", nil
+	}
+
+	content, err := os.ReadFile(data.Document.ValueString())
+	if err != nil {
+		return nil, "", err
+	}
+
+	mimeType := mime.TypeByExtension(filepath.Ext(data.Document.ValueString()))
+	if mimeType == "" {
+		mimeType = http.DetectContentType(content)
+	}
+	dataUrl := mondoov1.String("data:" + mimeType + ";base64," + base64.StdEncoding.EncodeToString(content))
+	return &dataUrl, newCrc32Checksum(content), nil
+}
+
+// ModifyPlan plans an update when the content of the local document changed since it was uploaded.
+func (r *frameworkEvidenceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan frameworkEvidenceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case plan.Document.IsUnknown():
+		plan.Crc32Checksum = types.StringUnknown()
+	case plan.Document.IsNull():
+		plan.Crc32Checksum = types.StringNull()
+	default:
+		_, checksum, err := r.getDocument(plan)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("document"),
+				"Unable to read content from file "+plan.Document.ValueString(), err.Error())
+			return
+		}
+		plan.Crc32Checksum = types.StringValue(checksum)
+	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *frameworkEvidenceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data frameworkEvidenceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	space, err := r.client.ComputeSpace(data.SpaceID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+		return
+	}
+	ctx = tflog.SetField(ctx, "space_mrn", space.MRN())
+
+	document, checksum, err := r.getDocument(data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read content from file "+data.Document.ValueString(),
+			err.Error(),
+		)
+		return
+	}
+
+	// Do GraphQL request to API to create the resource.
+	tflog.Debug(ctx, "Creating framework evidence")
+	evidence, err := r.client.CreateComplianceEvidence(ctx, CreateComplianceEvidenceInput{
+		ScopeMrn:    mondoov1.String(space.MRN()),
+		ControlMrn:  mondoov1.String(data.ControlMrn.ValueString()),
+		Title:       mondoov1.String(data.Title.ValueString()),
+		Description: (*mondoov1.String)(data.Description.ValueStringPointer()),
+		Url:         (*mondoov1.String)(data.Url.ValueStringPointer()),
+		Document:    document,
+	})
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to create framework evidence. Got error: %s", err),
+			)
+		return
+	}
+
+	data.SpaceID = types.StringValue(space.ID())
+	data.Mrn = types.StringValue(evidence.Mrn)
+	if document != nil {
+		data.Crc32Checksum = types.StringValue(checksum)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *frameworkEvidenceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data frameworkEvidenceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Do GraphQL request to API to read the resource.
+	evidence, err := r.client.GetComplianceEvidence(ctx, data.Mrn.ValueString())
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to get framework evidence. Got error: %s", err),
+			)
+		return
+	}
+
+	data.ControlMrn = types.StringValue(evidence.ControlMrn)
+	data.Title = types.StringValue(evidence.Title)
+	if evidence.Description != "" || !data.Description.IsNull() {
+		data.Description = types.StringValue(evidence.Description)
+	}
+	if evidence.Url != "" || !data.Url.IsNull() {
+		data.Url = types.StringValue(evidence.Url)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *frameworkEvidenceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state frameworkEvidenceResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// description and url are always sent, so removing them from the configuration clears them
+	input := UpdateComplianceEvidenceInput{
+		Mrn:         mondoov1.String(state.Mrn.ValueString()),
+		Title:       mondoov1.String(data.Title.ValueString()),
+		Description: mondoov1.NewStringPtr(mondoov1.String(data.Description.ValueString())),
+		Url:         mondoov1.NewStringPtr(mondoov1.String(data.Url.ValueString())),
+	}
+
+	// only upload the document again if its content changed
+	if data.Crc32Checksum.ValueString() != state.Crc32Checksum.ValueString() {
+		document, checksum, err := r.getDocument(data)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to read content from file "+data.Document.ValueString(),
+				err.Error(),
+			)
+			return
+		}
+		input.Document = document
+		if document != nil {
+			data.Crc32Checksum = types.StringValue(checksum)
+		}
+	}
+
+	// Do GraphQL request to API to update the resource.
+	tflog.Debug(ctx, "Updating framework evidence", map[string]interface{}{"mrn": state.Mrn.ValueString()})
+	_, err := r.client.UpdateComplianceEvidence(ctx, input)
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to update framework evidence. Got error: %s", err),
+			)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *frameworkEvidenceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data frameworkEvidenceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Do GraphQL request to API to delete the resource.
+	err := r.client.DeleteComplianceEvidence(ctx, data.Mrn.ValueString())
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to delete framework evidence. Got error: %s", err),
+			)
+		return
+	}
+}
+
+func (r *frameworkEvidenceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	evidence, err := r.client.GetComplianceEvidence(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to get framework evidence. Got error: %s", err),
+			)
+		return
+	}
+
+	scope, err := mrn.Parse(evidence.ScopeMrn)
+	if err != nil || scope.SpaceID == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Unable to import framework evidence, expected evidence in a space. Got scope: %s", evidence.ScopeMrn),
+		)
+		return
+	}
+
+	if r.client.Space().ID() != "" && r.client.Space().ID() != scope.SpaceID {
+		// The provider is configured to manage resources in a different space than the one the
+		// resource is currently configured, we won't allow that
+		resp.Diagnostics.AddError(
+			"Conflict Error",
+			fmt.Sprintf(
+				"Unable to import framework evidence. The provider is configured in a different space than the resource. (%s != %s)",
+				r.client.Space().ID(), scope.SpaceID),
+		)
+		return
+	}
+
+	model := frameworkEvidenceResourceModel{
+		SpaceID:       types.StringValue(scope.SpaceID),
+		Mrn:           types.StringValue(evidence.Mrn),
+		ControlMrn:    types.StringValue(evidence.ControlMrn),
+		Title:         types.StringValue(evidence.Title),
+		Description:   types.StringNull(),
+		Url:           types.StringNull(),
+		Document:      types.StringNull(),
+		Crc32Checksum: types.StringNull(),
+	}
+	if evidence.Description != "" {
+		model.Description = types.StringValue(evidence.Description)
+	}
+	if evidence.Url != "" {
+		model.Url = types.StringValue(evidence.Url)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
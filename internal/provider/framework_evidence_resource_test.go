@@ -0,0 +1,91 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccFrameworkEvidenceResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFrameworkEvidenceResourceConfig(accSpace.ID(), "./testdata/evidence_1.md"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mondoo_framework_evidence.document", "space_id", accSpace.ID()),
+					resource.TestCheckResourceAttrSet("mondoo_framework_evidence.document", "mrn"),
+					resource.TestCheckResourceAttrSet("mondoo_framework_evidence.document", "crc32c"),
+					resource.TestCheckResourceAttr("mondoo_framework_evidence.link", "url", "https://wiki.example.com/access-reviews"),
+					resource.TestCheckNoResourceAttr("mondoo_framework_evidence.link", "crc32c"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "mondoo_framework_evidence.link",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "mrn",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["mondoo_framework_evidence.link"].Primary.Attributes["mrn"], nil
+				},
+			},
+			// Update and Read testing
+			{
+				Config: testAccFrameworkEvidenceResourceConfig(accSpace.ID(), "./testdata/evidence_2.md"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mondoo_framework_evidence.document", "document", "./testdata/evidence_2.md"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccFrameworkEvidenceResourceConfig(spaceID, document string) string {
+	return fmt.Sprintf(`
+resource "mondoo_framework" "test" {
+  space_id = %[1]q
+  uid      = "tf-acc-evidence-framework"
+  name     = "Terraform Acceptance Evidence Framework"
+
+  groups = {
+    "tf-acc-evidence-framework-ac" = {
+      title = "Access Control"
+
+      controls = {
+        "tf-acc-evidence-framework-ac-01" = {
+          title = "Access Reviews"
+        }
+      }
+    }
+  }
+}
+
+resource "mondoo_framework_evidence" "document" {
+  space_id    = %[1]q
+  control_mrn = "//policy.api.mondoo.app/spaces/%[1]s/controls/tf-acc-evidence-framework-ac-01"
+  title       = "Access review"
+  document    = %[2]q
+
+  depends_on = [mondoo_framework.test]
+}
+
+resource "mondoo_framework_evidence" "link" {
+  space_id    = %[1]q
+  control_mrn = "//policy.api.mondoo.app/spaces/%[1]s/controls/tf-acc-evidence-framework-ac-01"
+  title       = "Access review process"
+  description = "Documentation of the quarterly access review process."
+  url         = "https://wiki.example.com/access-reviews"
+
+  depends_on = [mondoo_framework.test]
+}
+`, spaceID, document)
+}
@@ -0,0 +1,723 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.mondoo.com/terraform-provider-mondoo/internal/mrn"
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	_ resource.Resource                = (*frameworkResource)(nil)
+	_ resource.ResourceWithImportState = (*frameworkResource)(nil)
+)
+
+func NewFrameworkResource() resource.Resource {
+	return &frameworkResource{}
+}
+
+// frameworkResource manages a custom compliance framework whose groups and controls are
+// defined in HCL. Groups, controls and evidence are maps keyed by their uid, so that adding or
+// reordering one doesn't change the others. They are rendered to the framework YAML format and
+// uploaded with UploadFramework, the same way mondoo_custom_framework uploads a file.
+type frameworkResource struct {
+	client *ExtendedGqlClient
+}
+
+type frameworkResourceModel struct {
+	// scope
+	SpaceID types.String `tfsdk:"space_id"`
+
+	// resource details
+	Mrn         types.String                   `tfsdk:"mrn"`
+	Uid         types.String                   `tfsdk:"uid"`
+	Name        types.String                   `tfsdk:"name"`
+	Version     types.String                   `tfsdk:"version"`
+	Description types.String                   `tfsdk:"description"`
+	Tags        types.Map                      `tfsdk:"tags"`
+	Groups      map[string]frameworkGroupModel `tfsdk:"groups"`
+}
+
+type frameworkGroupModel struct {
+	Title       types.String                     `tfsdk:"title"`
+	Description types.String                     `tfsdk:"description"`
+	Controls    map[string]frameworkControlModel `tfsdk:"controls"`
+}
+
+type frameworkControlModel struct {
+	Title       types.String                      `tfsdk:"title"`
+	Description types.String                      `tfsdk:"description"`
+	Checks      types.List                        `tfsdk:"checks"`
+	Policies    types.List                        `tfsdk:"policies"`
+	Evidence    map[string]frameworkEvidenceModel `tfsdk:"evidence"`
+}
+
+type frameworkEvidenceModel struct {
+	Title       types.String `tfsdk:"title"`
+	Description types.String `tfsdk:"description"`
+	Checks      types.List   `tfsdk:"checks"`
+}
+
+// The following types mirror the framework YAML format accepted by UploadFramework.
+
+type frameworkBundle struct {
+	Frameworks    []frameworkDocument    `yaml:"frameworks"`
+	FrameworkMaps []frameworkMapDocument `yaml:"framework_maps,omitempty"`
+}
+
+type frameworkDocs struct {
+	Desc string `yaml:"desc"`
+}
+
+type frameworkRef struct {
+	Uid string `yaml:"uid,omitempty"`
+	Mrn string `yaml:"mrn,omitempty"`
+}
+
+type frameworkDocument struct {
+	Uid     string                   `yaml:"uid"`
+	Name    string                   `yaml:"name"`
+	Version string                   `yaml:"version,omitempty"`
+	Tags    map[string]string        `yaml:"tags,omitempty"`
+	Docs    *frameworkDocs           `yaml:"docs,omitempty"`
+	Groups  []frameworkGroupDocument `yaml:"groups"`
+}
+
+type frameworkGroupDocument struct {
+	Uid      string                     `yaml:"uid"`
+	Title    string                     `yaml:"title"`
+	Docs     *frameworkDocs             `yaml:"docs,omitempty"`
+	Controls []frameworkControlDocument `yaml:"controls"`
+}
+
+type frameworkControlDocument struct {
+	Uid      string                      `yaml:"uid"`
+	Title    string                      `yaml:"title"`
+	Docs     *frameworkDocs              `yaml:"docs,omitempty"`
+	Evidence []frameworkEvidenceDocument `yaml:"evidence,omitempty"`
+}
+
+type frameworkEvidenceDocument struct {
+	Uid    string         `yaml:"uid"`
+	Title  string         `yaml:"title,omitempty"`
+	Docs   *frameworkDocs `yaml:"docs,omitempty"`
+	Checks []frameworkRef `yaml:"checks,omitempty"`
+}
+
+type frameworkMapDocument struct {
+	Uid                string                        `yaml:"uid"`
+	FrameworkOwner     frameworkRef                  `yaml:"framework_owner"`
+	PolicyDependencies []frameworkRef                `yaml:"policy_dependencies,omitempty"`
+	Controls           []frameworkControlMapDocument `yaml:"controls"`
+}
+
+type frameworkControlMapDocument struct {
+	Uid      string         `yaml:"uid"`
+	Checks   []frameworkRef `yaml:"checks,omitempty"`
+	Policies []frameworkRef `yaml:"policies,omitempty"`
+}
+
+func frameworkDocsFrom(desc types.String) *frameworkDocs {
+	if desc.ValueString() == "" {
+		return nil
+	}
+	return &frameworkDocs{Desc: desc.ValueString()}
+}
+
+func frameworkRefsFrom(ctx context.Context, list types.List) ([]frameworkRef, diag.Diagnostics) {
+	mrns := []string{}
+	diags := list.ElementsAs(ctx, &mrns, false)
+
+	refs := make([]frameworkRef, 0, len(mrns))
+	for _, m := range mrns {
+		refs = append(refs, frameworkRef{Mrn: m})
+	}
+	return refs, diags
+}
+
+// frameworkContent renders the Terraform model into the framework YAML document. Groups, controls
+// and evidence are sorted by uid. Controls that reference checks or policies are added to a
+// framework map owned by the framework.
+func frameworkContent(ctx context.Context, data frameworkResourceModel) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	uid := data.Uid.ValueString()
+
+	tags := map[string]string{}
+	diags.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
+
+	framework := frameworkDocument{
+		Uid:     uid,
+		Name:    data.Name.ValueString(),
+		Version: data.Version.ValueString(),
+		Tags:    tags,
+		Docs:    frameworkDocsFrom(data.Description),
+		Groups:  make([]frameworkGroupDocument, 0, len(data.Groups)),
+	}
+	frameworkMap := frameworkMapDocument{
+		Uid:            uid + "-mapping",
+		FrameworkOwner: frameworkRef{Uid: uid},
+	}
+	policyDependencies := map[string]bool{}
+
+	for _, groupUid := range slices.Sorted(maps.Keys(data.Groups)) {
+		g := data.Groups[groupUid]
+		group := frameworkGroupDocument{
+			Uid:      groupUid,
+			Title:    g.Title.ValueString(),
+			Docs:     frameworkDocsFrom(g.Description),
+			Controls: make([]frameworkControlDocument, 0, len(g.Controls)),
+		}
+
+		for _, controlUid := range slices.Sorted(maps.Keys(g.Controls)) {
+			c := g.Controls[controlUid]
+			control := frameworkControlDocument{
+				Uid:   controlUid,
+				Title: c.Title.ValueString(),
+				Docs:  frameworkDocsFrom(c.Description),
+			}
+			for _, evidenceUid := range slices.Sorted(maps.Keys(c.Evidence)) {
+				e := c.Evidence[evidenceUid]
+				evidenceChecks, d := frameworkRefsFrom(ctx, e.Checks)
+				diags.Append(d...)
+				control.Evidence = append(control.Evidence, frameworkEvidenceDocument{
+					Uid:    evidenceUid,
+					Title:  e.Title.ValueString(),
+					Docs:   frameworkDocsFrom(e.Description),
+					Checks: evidenceChecks,
+				})
+			}
+			group.Controls = append(group.Controls, control)
+
+			checks, d := frameworkRefsFrom(ctx, c.Checks)
+			diags.Append(d...)
+			policies, d := frameworkRefsFrom(ctx, c.Policies)
+			diags.Append(d...)
+			if len(checks) == 0 && len(policies) == 0 {
+				continue
+			}
+			for _, p := range policies {
+				if !policyDependencies[p.Mrn] {
+					policyDependencies[p.Mrn] = true
+					frameworkMap.PolicyDependencies = append(frameworkMap.PolicyDependencies, p)
+				}
+			}
+			frameworkMap.Controls = append(frameworkMap.Controls, frameworkControlMapDocument{
+				Uid:      control.Uid,
+				Checks:   checks,
+				Policies: policies,
+			})
+		}
+
+		framework.Groups = append(framework.Groups, group)
+	}
+
+	bundle := frameworkBundle{Frameworks: []frameworkDocument{framework}}
+	if len(frameworkMap.Controls) > 0 {
+		bundle.FrameworkMaps = []frameworkMapDocument{frameworkMap}
+	}
+
+	content, err := yaml.Marshal(bundle)
+	if err != nil {
+		diags.AddError("Unable to render compliance framework", fmt.Sprintf("unable to marshal framework YAML: %s", err))
+		return nil, diags
+	}
+	return content, diags
+}
+
+// frameworkString returns the value read from the API, but keeps an unset optional attribute
+// null when the API returns it empty.
+func frameworkString(prior types.String, value string) types.String {
+	if value == "" && prior.IsNull() {
+		return prior
+	}
+	return types.StringValue(value)
+}
+
+func frameworkDescription(prior types.String, docs *frameworkDocs) types.String {
+	if docs == nil {
+		return frameworkString(prior, "")
+	}
+	return frameworkString(prior, docs.Desc)
+}
+
+func frameworkRefList(ctx context.Context, prior types.List, refs []frameworkRef) (types.List, diag.Diagnostics) {
+	if len(refs) == 0 && prior.IsNull() {
+		return types.ListNull(types.StringType), nil
+	}
+	mrns := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		if ref.Mrn != "" {
+			mrns = append(mrns, ref.Mrn)
+		} else {
+			mrns = append(mrns, ref.Uid)
+		}
+	}
+	return types.ListValueFrom(ctx, types.StringType, mrns)
+}
+
+// flattenFramework updates the model with the framework YAML downloaded from Mondoo, so that
+// changes made outside of Terraform, e.g. to controls in the console, show up as drift.
+func flattenFramework(ctx context.Context, content []byte, data *frameworkResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var bundle frameworkBundle
+	if err := yaml.Unmarshal(content, &bundle); err != nil {
+		diags.AddError("Unable to parse compliance framework", err.Error())
+		return diags
+	}
+
+	uid := data.Uid.ValueString()
+	var framework *frameworkDocument
+	for i := range bundle.Frameworks {
+		if bundle.Frameworks[i].Uid == uid {
+			framework = &bundle.Frameworks[i]
+		}
+	}
+	if framework == nil {
+		diags.AddError("Unable to parse compliance framework", fmt.Sprintf("framework %q is missing in the downloaded bundle", uid))
+		return diags
+	}
+
+	// checks and policies of the controls are kept in the framework maps owned by the framework
+	mappings := map[string]frameworkControlMapDocument{}
+	for _, frameworkMap := range bundle.FrameworkMaps {
+		if frameworkMap.FrameworkOwner.Uid != uid && frameworkMap.FrameworkOwner.Mrn != data.Mrn.ValueString() {
+			continue
+		}
+		for _, c := range frameworkMap.Controls {
+			mapping := mappings[c.Uid]
+			mapping.Checks = append(mapping.Checks, c.Checks...)
+			mapping.Policies = append(mapping.Policies, c.Policies...)
+			mappings[c.Uid] = mapping
+		}
+	}
+
+	data.Name = types.StringValue(framework.Name)
+	data.Version = frameworkString(data.Version, framework.Version)
+	data.Description = frameworkDescription(data.Description, framework.Docs)
+	if len(framework.Tags) == 0 && data.Tags.IsNull() {
+		data.Tags = types.MapNull(types.StringType)
+	} else {
+		tags, d := types.MapValueFrom(ctx, types.StringType, framework.Tags)
+		diags.Append(d...)
+		data.Tags = tags
+	}
+
+	// groups, controls and evidence are matched to the prior state by uid
+	groups := make(map[string]frameworkGroupModel, len(framework.Groups))
+	for _, g := range framework.Groups {
+		priorGroup := data.Groups[g.Uid]
+
+		group := frameworkGroupModel{
+			Title:       types.StringValue(g.Title),
+			Description: frameworkDescription(priorGroup.Description, g.Docs),
+		}
+		if len(g.Controls) > 0 || priorGroup.Controls != nil {
+			group.Controls = make(map[string]frameworkControlModel, len(g.Controls))
+		}
+		for _, c := range g.Controls {
+			priorControl, ok := priorGroup.Controls[c.Uid]
+			if !ok {
+				priorControl = frameworkControlModel{
+					Checks:   types.ListNull(types.StringType),
+					Policies: types.ListNull(types.StringType),
+				}
+			}
+
+			control := frameworkControlModel{
+				Title:       types.StringValue(c.Title),
+				Description: frameworkDescription(priorControl.Description, c.Docs),
+			}
+			var d diag.Diagnostics
+			control.Checks, d = frameworkRefList(ctx, priorControl.Checks, mappings[c.Uid].Checks)
+			diags.Append(d...)
+			control.Policies, d = frameworkRefList(ctx, priorControl.Policies, mappings[c.Uid].Policies)
+			diags.Append(d...)
+
+			if len(c.Evidence) > 0 || priorControl.Evidence != nil {
+				control.Evidence = make(map[string]frameworkEvidenceModel, len(c.Evidence))
+			}
+			for _, e := range c.Evidence {
+				priorEvidence, ok := priorControl.Evidence[e.Uid]
+				if !ok {
+					priorEvidence = frameworkEvidenceModel{Checks: types.ListNull(types.StringType)}
+				}
+
+				evidence := frameworkEvidenceModel{
+					Title:       frameworkString(priorEvidence.Title, e.Title),
+					Description: frameworkDescription(priorEvidence.Description, e.Docs),
+				}
+				evidence.Checks, d = frameworkRefList(ctx, priorEvidence.Checks, e.Checks)
+				diags.Append(d...)
+				control.Evidence[e.Uid] = evidence
+			}
+			group.Controls[c.Uid] = control
+		}
+		groups[g.Uid] = group
+	}
+	data.Groups = groups
+
+	return diags
+}
+
+func (r *frameworkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_framework"
+}
+
+func (r *frameworkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Define a custom compliance framework for a Mondoo space in HCL.
+
+Groups, controls, and evidence are declared as maps keyed by their unique identifier, so changes to individual controls show up in the plan. To upload an existing framework YAML file instead, use ` + "`mondoo_custom_framework`" + `.`,
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "Mondoo space identifier. If there's no space ID, the provider space is used.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mrn": schema.StringAttribute{
+				MarkdownDescription: "Mondoo resource name.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"uid": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the framework within the space.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the framework.",
+				Required:            true,
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "Version of the framework.",
+				Optional:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the framework.",
+				Optional:            true,
+			},
+			"tags": schema.MapAttribute{
+				MarkdownDescription: "Tags to attach to the framework.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"groups": schema.MapNestedAttribute{
+				MarkdownDescription: "Groups of related controls, keyed by the unique identifier of the group.",
+				Required:            true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Title of the group.",
+							Required:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the group.",
+							Optional:            true,
+						},
+						"controls": schema.MapNestedAttribute{
+							MarkdownDescription: "Controls of the group, keyed by the unique identifier of the control.",
+							Optional:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"title": schema.StringAttribute{
+										MarkdownDescription: "Title of the control.",
+										Required:            true,
+									},
+									"description": schema.StringAttribute{
+										MarkdownDescription: "Description of the control.",
+										Optional:            true,
+									},
+									"checks": schema.ListAttribute{
+										MarkdownDescription: "MRNs of the checks that satisfy this control.",
+										Optional:            true,
+										ElementType:         types.StringType,
+									},
+									"policies": schema.ListAttribute{
+										MarkdownDescription: "MRNs of the policies that satisfy this control.",
+										Optional:            true,
+										ElementType:         types.StringType,
+									},
+									"evidence": schema.MapNestedAttribute{
+										MarkdownDescription: "Evidence required to satisfy the control, keyed by the unique identifier of the evidence.",
+										Optional:            true,
+										NestedObject: schema.NestedAttributeObject{
+											Attributes: map[string]schema.Attribute{
+												"title": schema.StringAttribute{
+													MarkdownDescription: "Title of the evidence.",
+													Optional:            true,
+												},
+												"description": schema.StringAttribute{
+													MarkdownDescription: "Description of the evidence.",
+													Optional:            true,
+												},
+												"checks": schema.ListAttribute{
+													MarkdownDescription: "MRNs of the checks that collect this evidence.",
+													Optional:            true,
+													ElementType:         types.StringType,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *frameworkResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ExtendedGqlClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client. Got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *frameworkResource) upload(ctx context.Context, data *frameworkResourceModel, content []byte) error {
+	space, err := r.client.ComputeSpace(data.SpaceID)
+	if err != nil {
+		return err
+	}
+	ctx = tflog.SetField(ctx, "space_mrn", space.MRN())
+
+	tflog.Debug(ctx, "Uploading framework", map[string]interface{}{"uid": data.Uid.ValueString()})
+	err = r.client.UploadFramework(ctx, space.MRN(), content)
+	if err != nil {
+		return fmt.Errorf("unable to upload compliance framework: %w", err)
+	}
+
+	framework, err := r.client.GetFramework(ctx, space.MRN(), space.ID(), data.Uid.ValueString())
+	if err != nil {
+		return fmt.Errorf("unable to get compliance framework: %w", err)
+	}
+
+	data.SpaceID = types.StringValue(space.ID())
+	data.Mrn = types.StringValue(string(framework.Mrn))
+	return nil
+}
+
+func (r *frameworkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data frameworkResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Do GraphQL request to API to create the resource.
+	content, diags := frameworkContent(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.upload(ctx, &data, content); err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to create compliance framework. Got error: %s", err),
+			)
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *frameworkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data frameworkResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	space, err := r.client.ComputeSpace(data.SpaceID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+		return
+	}
+
+	// Do GraphQL request to API to read the resource.
+	framework, err := r.client.GetFramework(ctx, space.MRN(), space.ID(), data.Uid.ValueString())
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to get compliance framework. Got error: %s", err),
+			)
+		return
+	}
+
+	data.Mrn = types.StringValue(string(framework.Mrn))
+
+	content, err := r.client.DownloadFramework(ctx, string(framework.Mrn))
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to download compliance framework. Got error: %s", err),
+			)
+		return
+	}
+	resp.Diagnostics.Append(flattenFramework(ctx, []byte(content), &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *frameworkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data frameworkResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Uploading a framework with an existing uid replaces it in place.
+	content, diags := frameworkContent(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.upload(ctx, &data, content); err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to update compliance framework. Got error: %s", err),
+			)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *frameworkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data frameworkResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Do GraphQL request to API to delete the resource.
+	err := r.client.DeleteFramework(ctx, data.Mrn.ValueString())
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to delete compliance framework. Got error: %s", err),
+			)
+		return
+	}
+}
+
+func (r *frameworkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	frameworkMrn := req.ID
+	parsed, err := mrn.Parse(frameworkMrn)
+	if err != nil || parsed.SpaceID == "" || parsed.Type != "frameworks" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Unable to import compliance framework, expected a space framework MRN. Got: %s", frameworkMrn),
+		)
+		return
+	}
+	spaceID := parsed.SpaceID
+
+	if r.client.Space().ID() != "" && r.client.Space().ID() != spaceID {
+		// The provider is configured to manage resources in a different space than the one the
+		// resource is currently configured, we won't allow that
+		resp.Diagnostics.AddError(
+			"Conflict Error",
+			fmt.Sprintf(
+				"Unable to import compliance framework. The provider is configured in a different space than the resource. (%s != %s)",
+				r.client.Space().ID(), spaceID),
+		)
+		return
+	}
+
+	framework, err := r.client.GetFramework(ctx, mrn.Space(spaceID), spaceID, parsed.ID)
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to get compliance framework. Got error: %s", err),
+			)
+		return
+	}
+
+	content, err := r.client.DownloadFramework(ctx, string(framework.Mrn))
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to download compliance framework. Got error: %s", err),
+			)
+		return
+	}
+
+	data := frameworkResourceModel{
+		SpaceID: types.StringValue(spaceID),
+		Mrn:     types.StringValue(string(framework.Mrn)),
+		Uid:     types.StringValue(parsed.ID),
+		Tags:    types.MapNull(types.StringType),
+	}
+	resp.Diagnostics.Append(flattenFramework(ctx, []byte(content), &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
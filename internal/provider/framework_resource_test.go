@@ -0,0 +1,278 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+)
+
+func TestAccFrameworkResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFrameworkResourceConfig(accSpace.ID(), "Asset Inventory"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mondoo_framework.test", "uid", "tf-acc-framework"),
+					resource.TestCheckResourceAttr("mondoo_framework.test", "mrn", "//policy.api.mondoo.app/spaces/"+accSpace.ID()+"/frameworks/tf-acc-framework"),
+					resource.TestCheckResourceAttr("mondoo_framework.test", "groups.tf-acc-framework-am.controls.%", "2"),
+					resource.TestCheckResourceAttr("mondoo_framework.test", "groups.tf-acc-framework-am.controls.tf-acc-framework-am-01.title", "Asset Inventory"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "mondoo_framework.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "mrn",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["mondoo_framework.test"].Primary.Attributes["mrn"], nil
+				},
+			},
+			// Update and Read testing
+			{
+				Config: testAccFrameworkResourceConfig(accSpace.ID(), "Complete Asset Inventory"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mondoo_framework.test", "groups.tf-acc-framework-am.controls.tf-acc-framework-am-01.title", "Complete Asset Inventory"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccFrameworkResourceConfig(spaceID, controlTitle string) string {
+	return fmt.Sprintf(`
+resource "mondoo_framework" "test" {
+  space_id = %[1]q
+  uid      = "tf-acc-framework"
+  name     = "Terraform Acceptance Framework"
+  version  = "1.0.0"
+
+  groups = {
+    "tf-acc-framework-am" = {
+      title = "Asset Management"
+
+      controls = {
+        "tf-acc-framework-am-01" = {
+          title       = %[2]q
+          description = "Maintain an inventory of all assets."
+        }
+        "tf-acc-framework-am-02" = {
+          title = "Asset Ownership"
+
+          evidence = {
+            "tf-acc-framework-am-02-review" = {
+              title = "Ownership review"
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, spaceID, controlTitle)
+}
+
+func TestFrameworkContent(t *testing.T) {
+	ctx := context.Background()
+	stringList := func(values ...string) types.List {
+		elems := make([]attr.Value, len(values))
+		for i, v := range values {
+			elems[i] = types.StringValue(v)
+		}
+		return types.ListValueMust(types.StringType, elems)
+	}
+	policyMrn := "//policy.api.mondoo.app/policies/mondoo-linux-security"
+	checkMrn := "//policy.api.mondoo.app/queries/mondoo-linux-security-permitrootlogin-is-set-to-no"
+
+	data := frameworkResourceModel{
+		Uid:         types.StringValue("my-framework"),
+		Name:        types.StringValue("My Framework"),
+		Version:     types.StringValue("1.0.0"),
+		Description: types.StringValue("A framework."),
+		Tags:        types.MapNull(types.StringType),
+		Groups: map[string]frameworkGroupModel{
+			"my-framework-ssh": {
+				Title: types.StringValue("SSH"),
+				Controls: map[string]frameworkControlModel{
+					"my-framework-ssh-03": {
+						Title:    types.StringValue("Manual only"),
+						Checks:   types.ListNull(types.StringType),
+						Policies: types.ListNull(types.StringType),
+					},
+					"my-framework-ssh-01": {
+						Title:    types.StringValue("No root login"),
+						Checks:   stringList(checkMrn),
+						Policies: stringList(policyMrn),
+					},
+					"my-framework-ssh-02": {
+						Title:    types.StringValue("Key rotation"),
+						Checks:   types.ListNull(types.StringType),
+						Policies: stringList(policyMrn),
+						Evidence: map[string]frameworkEvidenceModel{
+							"my-framework-ssh-02-review": {
+								Description: types.StringValue("Rotation log."),
+								Checks:      types.ListNull(types.StringType),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	content, diags := frameworkContent(ctx, data)
+	require.False(t, diags.HasError(), diags)
+
+	var bundle frameworkBundle
+	require.NoError(t, yaml.Unmarshal(content, &bundle))
+
+	require.Len(t, bundle.Frameworks, 1)
+	framework := bundle.Frameworks[0]
+	assert.Equal(t, "my-framework", framework.Uid)
+	assert.Equal(t, "A framework.", framework.Docs.Desc)
+	require.Len(t, framework.Groups, 1)
+	assert.Equal(t, "my-framework-ssh", framework.Groups[0].Uid)
+	// controls are rendered in the order of their uid
+	require.Len(t, framework.Groups[0].Controls, 3)
+	assert.Equal(t, "my-framework-ssh-01", framework.Groups[0].Controls[0].Uid)
+	assert.Equal(t, "my-framework-ssh-03", framework.Groups[0].Controls[2].Uid)
+	assert.Nil(t, framework.Groups[0].Controls[0].Docs)
+	assert.Equal(t, "my-framework-ssh-02-review", framework.Groups[0].Controls[1].Evidence[0].Uid)
+	assert.Equal(t, "Rotation log.", framework.Groups[0].Controls[1].Evidence[0].Docs.Desc)
+
+	// controls without mappings are not part of the framework map
+	require.Len(t, bundle.FrameworkMaps, 1)
+	frameworkMap := bundle.FrameworkMaps[0]
+	assert.Equal(t, "my-framework", frameworkMap.FrameworkOwner.Uid)
+	assert.Equal(t, []frameworkRef{{Mrn: policyMrn}}, frameworkMap.PolicyDependencies)
+	require.Len(t, frameworkMap.Controls, 2)
+	assert.Equal(t, []frameworkRef{{Mrn: checkMrn}}, frameworkMap.Controls[0].Checks)
+	assert.Empty(t, frameworkMap.Controls[1].Checks)
+}
+
+func TestFlattenFramework(t *testing.T) {
+	ctx := context.Background()
+	policyMrn := "//policy.api.mondoo.app/policies/mondoo-linux-security"
+	checkMrn := "//policy.api.mondoo.app/queries/mondoo-linux-security-permitrootlogin-is-set-to-no"
+
+	content := fmt.Sprintf(`frameworks:
+- uid: my-framework
+  name: My Framework
+  version: 1.0.1
+  groups:
+  - uid: my-framework-ssh
+    title: SSH
+    controls:
+    - uid: my-framework-ssh-02
+      title: Key rotation
+      evidence:
+      - uid: my-framework-ssh-02-review
+        title: Rotation log
+    - uid: my-framework-ssh-01
+      title: No root login over SSH
+      docs:
+        desc: Changed in the console.
+    - uid: my-framework-ssh-03
+      title: Added in the console
+framework_maps:
+- uid: my-framework-mapping
+  framework_owner:
+    uid: my-framework
+  controls:
+  - uid: my-framework-ssh-01
+    checks:
+    - mrn: %[1]s
+    policies:
+    - mrn: %[2]s
+`, checkMrn, policyMrn)
+
+	// prior state, as configured before the framework was changed outside of Terraform
+	data := frameworkResourceModel{
+		Mrn:         types.StringValue("//policy.api.mondoo.app/spaces/my-space/frameworks/my-framework"),
+		Uid:         types.StringValue("my-framework"),
+		Name:        types.StringValue("My Framework"),
+		Version:     types.StringValue("1.0.0"),
+		Description: types.StringNull(),
+		Tags:        types.MapNull(types.StringType),
+		Groups: map[string]frameworkGroupModel{
+			"my-framework-ssh": {
+				Title: types.StringValue("SSH"),
+				Controls: map[string]frameworkControlModel{
+					"my-framework-ssh-01": {
+						Title:    types.StringValue("No root login"),
+						Checks:   types.ListNull(types.StringType),
+						Policies: types.ListNull(types.StringType),
+					},
+					"my-framework-ssh-02": {
+						Title:       types.StringValue("Key rotation"),
+						Description: types.StringValue("Rotate SSH keys."),
+						Checks:      types.ListNull(types.StringType),
+						Policies:    types.ListNull(types.StringType),
+						Evidence: map[string]frameworkEvidenceModel{
+							"my-framework-ssh-02-review": {
+								Title:  types.StringValue("Rotation log"),
+								Checks: types.ListNull(types.StringType),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	diags := flattenFramework(ctx, []byte(content), &data)
+	require.False(t, diags.HasError(), diags)
+
+	assert.Equal(t, "1.0.1", data.Version.ValueString())
+	assert.True(t, data.Description.IsNull())
+	assert.True(t, data.Tags.IsNull())
+	require.Len(t, data.Groups, 1)
+	group := data.Groups["my-framework-ssh"]
+	assert.True(t, group.Description.IsNull())
+	require.Len(t, group.Controls, 3)
+
+	// controls are matched to the prior state by uid, not by their position
+	control := group.Controls["my-framework-ssh-01"]
+	assert.Equal(t, "No root login over SSH", control.Title.ValueString())
+	assert.Equal(t, "Changed in the console.", control.Description.ValueString())
+	checks := []string{}
+	require.False(t, control.Checks.ElementsAs(ctx, &checks, false).HasError())
+	assert.Equal(t, []string{checkMrn}, checks)
+	policies := []string{}
+	require.False(t, control.Policies.ElementsAs(ctx, &policies, false).HasError())
+	assert.Equal(t, []string{policyMrn}, policies)
+
+	// the description removed in the console shows up as drift of this control only
+	rotation := group.Controls["my-framework-ssh-02"]
+	assert.Equal(t, "", rotation.Description.ValueString())
+	assert.False(t, rotation.Description.IsNull())
+	require.Len(t, rotation.Evidence, 1)
+	assert.Equal(t, "Rotation log", rotation.Evidence["my-framework-ssh-02-review"].Title.ValueString())
+	assert.True(t, rotation.Evidence["my-framework-ssh-02-review"].Checks.IsNull())
+
+	// controls added outside of Terraform are read without a prior state
+	added := group.Controls["my-framework-ssh-03"]
+	assert.Equal(t, "Added in the console", added.Title.ValueString())
+	assert.True(t, added.Description.IsNull())
+	assert.True(t, added.Checks.IsNull())
+	assert.True(t, added.Policies.IsNull())
+	assert.Nil(t, added.Evidence)
+
+	diags = flattenFramework(ctx, []byte("frameworks: []"), &data)
+	assert.True(t, diags.HasError())
+}
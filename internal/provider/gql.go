@@ -8,27 +8,24 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
-	"regexp"
-	"strings"
+	"reflect"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	mondoov1 "go.mondoo.com/mondoo-go"
+	"go.mondoo.com/terraform-provider-mondoo/internal/mrn"
 )
 
-const orgPrefix = "//captain.api.mondoo.app/organizations/"
+const orgPrefix = mrn.OrganizationPrefix
 
-var validIntegrationMRN = regexp.MustCompile(`^(//integration\.api\.mondoo\.app/(spaces|organizations)/[\w-]+/integrations/[\w-]+)$`)
-var validPlatformIntegrationMRN = regexp.MustCompile(`^(//integration\.api\.mondoo\.app/integrations/[\w-]+)$`)
-
-func IsValidIntegrationMrn(mrn string) bool {
-	return validIntegrationMRN.MatchString(mrn) || validPlatformIntegrationMRN.MatchString(mrn)
+func IsValidIntegrationMrn(integrationMrn string) bool {
+	return mrn.IsValidIntegration(integrationMrn)
 }
 
-func IsValidPlatformIntegrationMrn(mrn string) bool {
-	return validPlatformIntegrationMRN.MatchString(mrn)
+func IsValidPlatformIntegrationMrn(integrationMrn string) bool {
+	return mrn.IsPlatformIntegration(integrationMrn)
 }
 
 // The extended GraphQL client allows us to pass additional information to
@@ -39,6 +36,48 @@ type ExtendedGqlClient struct {
 	// The default space configured at the provider level, if configured, all resources
 	// will be managed there unless the resource itself specifies a different space
 	space Space
+
+	// Credentials exchanged from OIDC tokens, if configured, they replace the client
+	// before the credential expires
+	oidc *oidcCredentials
+}
+
+// Query runs a GraphQL query with the current credential.
+func (c *ExtendedGqlClient) Query(ctx context.Context, q interface{}, variables map[string]interface{}) error {
+	return c.withClient(ctx, func(client *mondoov1.Client) error {
+		return client.Query(ctx, q, variables)
+	})
+}
+
+// Mutate runs a GraphQL mutation with the current credential.
+func (c *ExtendedGqlClient) Mutate(ctx context.Context, m interface{}, input mondoov1.Input, variables map[string]interface{}) error {
+	return c.withClient(ctx, func(client *mondoov1.Client) error {
+		return client.Mutate(ctx, m, input, variables)
+	})
+}
+
+// withClient calls fn with the client of the current credential. A request that is rejected
+// because an exchanged credential expired is retried once with a new credential.
+func (c *ExtendedGqlClient) withClient(ctx context.Context, fn func(client *mondoov1.Client) error) error {
+	if c.oidc == nil {
+		return fn(c.Client)
+	}
+
+	client, err := c.oidc.current(ctx)
+	if err != nil {
+		return err
+	}
+	err = fn(client)
+	if !isUnauthenticatedError(err) {
+		return err
+	}
+
+	tflog.Debug(ctx, "Credential was rejected, exchanging a new OIDC token")
+	client, renewErr := c.oidc.renew(ctx, client)
+	if renewErr != nil {
+		return errors.Join(err, renewErr)
+	}
+	return fn(client)
 }
 
 // Space returns the space configured into the extended GraphQL client.
@@ -181,6 +220,123 @@ func (c *ExtendedGqlClient) DeleteSpace(ctx context.Context, spaceID string) err
 	return c.Mutate(ctx, &deleteMutation, nil, variables)
 }
 
+// spaceContents is what would be lost when a space is deleted.
+type spaceContents struct {
+	Assets       int
+	Integrations int
+}
+
+func (s spaceContents) IsEmpty() bool {
+	return s.Assets == 0 && s.Integrations == 0
+}
+
+func (s spaceContents) String() string {
+	return fmt.Sprintf("%d asset(s) and %d integration(s)", s.Assets, s.Integrations)
+}
+
+// GetSpaceContents counts the assets and integrations of a space.
+func (c *ExtendedGqlClient) GetSpaceContents(ctx context.Context, spaceMrn string) (spaceContents, error) {
+	var q struct {
+		Assets struct {
+			TotalCount int
+		} `graphql:"assets(spaceMrn: $spaceMrn)"`
+		ClientIntegrations struct {
+			Integrations []struct {
+				Mrn string
+			}
+		} `graphql:"clientIntegrations(input: $input)"`
+	}
+	variables := map[string]interface{}{
+		"spaceMrn": mondoov1.String(spaceMrn),
+		"input": ClientIntegrationsInput{
+			SpaceMrn: mondoov1.String(spaceMrn),
+		},
+	}
+
+	err := c.Query(ctx, &q, variables)
+	if err != nil {
+		return spaceContents{}, err
+	}
+
+	return spaceContents{
+		Assets:       q.Assets.TotalCount,
+		Integrations: len(q.ClientIntegrations.Integrations),
+	}, nil
+}
+
+// spaceContentsBatchSize is the number of spaces checked by a single query of GetSpacesContents.
+const spaceContentsBatchSize = 50
+
+var (
+	spaceAssetsType = reflect.TypeOf(struct {
+		TotalCount int
+	}{})
+	spaceIntegrationsType = reflect.TypeOf(struct {
+		Integrations []struct {
+			Mrn string
+		}
+	}{})
+)
+
+// spacesContentsQuery returns the query type that counts the assets and integrations of n spaces,
+// the fields of every space are aliased and use the variables spaceMrn<i> and input<i>.
+func spacesContentsQuery(n int) reflect.Type {
+	fields := make([]reflect.StructField, 0, 2*n)
+	for i := 0; i < n; i++ {
+		fields = append(fields,
+			reflect.StructField{
+				Name: fmt.Sprintf("Assets%d", i),
+				Type: spaceAssetsType,
+				Tag:  reflect.StructTag(fmt.Sprintf(`graphql:"assets%d: assets(spaceMrn: $spaceMrn%d)"`, i, i)),
+			},
+			reflect.StructField{
+				Name: fmt.Sprintf("Integrations%d", i),
+				Type: spaceIntegrationsType,
+				Tag:  reflect.StructTag(fmt.Sprintf(`graphql:"integrations%d: clientIntegrations(input: $input%d)"`, i, i)),
+			},
+		)
+	}
+	return reflect.StructOf(fields)
+}
+
+// GetSpacesContents counts the assets and integrations of many spaces, batching the spaces into
+// few queries instead of one query per space. The contents are returned in the order of the spaces.
+func (c *ExtendedGqlClient) GetSpacesContents(ctx context.Context, spaceMrns []string) ([]spaceContents, error) {
+	contents := make([]spaceContents, 0, len(spaceMrns))
+	for start := 0; start < len(spaceMrns); start += spaceContentsBatchSize {
+		batch := spaceMrns[start:min(start+spaceContentsBatchSize, len(spaceMrns))]
+
+		variables := map[string]interface{}{}
+		for i, spaceMrn := range batch {
+			variables[fmt.Sprintf("spaceMrn%d", i)] = mondoov1.String(spaceMrn)
+			variables[fmt.Sprintf("input%d", i)] = ClientIntegrationsInput{
+				SpaceMrn: mondoov1.String(spaceMrn),
+			}
+		}
+
+		q := reflect.New(spacesContentsQuery(len(batch)))
+		err := c.Query(ctx, q.Interface(), variables)
+		if err != nil {
+			return nil, err
+		}
+
+		contents = append(contents, spacesContentsFrom(q.Elem())...)
+	}
+	return contents, nil
+}
+
+// spacesContentsFrom returns the contents of the spaces of a query built by spacesContentsQuery.
+func spacesContentsFrom(q reflect.Value) []spaceContents {
+	contents := make([]spaceContents, 0, q.NumField()/2)
+	for i := 0; i < q.NumField(); i += 2 {
+		contents = append(contents, spaceContents{
+			Assets:       int(q.Field(i).FieldByName("TotalCount").Int()),
+			Integrations: q.Field(i + 1).FieldByName("Integrations").Len(),
+		})
+	}
+	return contents
+}
+
 type spacePayload struct {
 	Id           string
 	Mrn          string
@@ -372,6 +528,423 @@ func (c *ExtendedGqlClient) DeleteOrganization(ctx context.Context, orgMrn strin
 	return c.Mutate(ctx, &deleteMutation, nil, variables)
 }
 
+// Organization settings types
+
+type UpdateOrganizationSettingsInput struct {
+	OrgMrn               mondoov1.String              `json:"orgMrn"`
+	AllowedEmailDomains  *[]mondoov1.String           `json:"allowedEmailDomains,omitempty"`
+	RequireSso           *mondoov1.Boolean            `json:"requireSso,omitempty"`
+	RequireMfa           *mondoov1.Boolean            `json:"requireMfa,omitempty"`
+	SessionTimeout       *mondoov1.Int                `json:"sessionTimeout,omitempty"`
+	IdleTimeout          *mondoov1.Int                `json:"idleTimeout,omitempty"`
+	DefaultSpaceSettings *mondoov1.SpaceSettingsInput `json:"defaultSpaceSettings,omitempty"`
+}
+
+type OrganizationSettingsPayload struct {
+	AllowedEmailDomains  []string
+	RequireSso           bool
+	RequireMfa           bool
+	SessionTimeout       int32
+	IdleTimeout          int32
+	DefaultSpaceSettings *MondooSpaceSettingsInput
+}
+
+// Organization settings client methods
+
+func (c *ExtendedGqlClient) GetOrganizationSettings(ctx context.Context, orgMrn string) (OrganizationSettingsPayload, error) {
+	var q struct {
+		Organization struct {
+			Settings OrganizationSettingsPayload
+		} `graphql:"organization(mrn: $mrn)"`
+	}
+	variables := map[string]interface{}{
+		"mrn": mondoov1.String(orgMrn),
+	}
+
+	err := c.Query(ctx, &q, variables)
+	return q.Organization.Settings, err
+}
+
+func (c *ExtendedGqlClient) UpdateOrganizationSettings(ctx context.Context, input UpdateOrganizationSettingsInput) (OrganizationSettingsPayload, error) {
+	var mutation struct {
+		UpdateOrganizationSettings OrganizationSettingsPayload `graphql:"updateOrganizationSettings(input: $input)"`
+	}
+
+	tflog.Trace(ctx, "UpdateOrganizationSettingsInput", map[string]interface{}{
+		"input": fmt.Sprintf("%+v", input),
+	})
+
+	err := c.Mutate(ctx, &mutation, input, nil)
+	return mutation.UpdateOrganizationSettings, err
+}
+
+// Organization SSO types
+
+type SamlIdentityProviderInput struct {
+	MetadataUrl  *mondoov1.String   `json:"metadataUrl,omitempty"`
+	MetadataXml  *mondoov1.String   `json:"metadataXml,omitempty"`
+	Certificates *[]mondoov1.String `json:"certificates,omitempty"`
+}
+
+type OidcIdentityProviderInput struct {
+	Issuer       mondoov1.String    `json:"issuer"`
+	ClientId     mondoov1.String    `json:"clientId"`
+	ClientSecret mondoov1.String    `json:"clientSecret"`
+	Scopes       *[]mondoov1.String `json:"scopes,omitempty"`
+}
+
+type SsoAttributeMappingInput struct {
+	Email     mondoov1.String `json:"email"`
+	FirstName mondoov1.String `json:"firstName"`
+	LastName  mondoov1.String `json:"lastName"`
+	Groups    mondoov1.String `json:"groups"`
+}
+
+type SetOrganizationSsoInput struct {
+	OrgMrn           mondoov1.String            `json:"orgMrn"`
+	Enabled          mondoov1.Boolean           `json:"enabled"`
+	Saml             *SamlIdentityProviderInput `json:"saml,omitempty"`
+	Oidc             *OidcIdentityProviderInput `json:"oidc,omitempty"`
+	AttributeMapping *SsoAttributeMappingInput  `json:"attributeMapping,omitempty"`
+	ScimEnabled      mondoov1.Boolean           `json:"scimEnabled"`
+}
+
+type OrganizationSsoPayload struct {
+	Enabled bool
+	Saml    *struct {
+		MetadataUrl  string
+		MetadataXml  string
+		Certificates []string
+	}
+	Oidc *struct {
+		Issuer   string
+		ClientId string
+		Scopes   []string
+	}
+	AttributeMapping struct {
+		Email     string
+		FirstName string
+		LastName  string
+		Groups    string
+	}
+	ServiceProviderEntityId string
+	AcsUrl                  string
+	RedirectUrl             string
+	ScimEnabled             bool
+	ScimBaseUrl             string
+	ScimTokenCreatedAt      string
+}
+
+type ScimTokenPayload struct {
+	Token     string
+	CreatedAt string
+}
+
+// Organization SSO client methods
+
+func (c *ExtendedGqlClient) GetOrganizationSso(ctx context.Context, orgMrn string) (*OrganizationSsoPayload, error) {
+	var q struct {
+		Organization struct {
+			Sso *OrganizationSsoPayload
+		} `graphql:"organization(mrn: $mrn)"`
+	}
+	variables := map[string]interface{}{
+		"mrn": mondoov1.String(orgMrn),
+	}
+
+	err := c.Query(ctx, &q, variables)
+	return q.Organization.Sso, err
+}
+
+func (c *ExtendedGqlClient) SetOrganizationSso(ctx context.Context, input SetOrganizationSsoInput) (OrganizationSsoPayload, error) {
+	var mutation struct {
+		SetOrganizationSso OrganizationSsoPayload `graphql:"setOrganizationSso(input: $input)"`
+	}
+
+	// the input contains the client secret of the identity provider, it is not logged
+	tflog.Trace(ctx, "SetOrganizationSsoInput", map[string]interface{}{
+		"orgMrn": string(input.OrgMrn),
+	})
+
+	err := c.Mutate(ctx, &mutation, input, nil)
+	return mutation.SetOrganizationSso, err
+}
+
+func (c *ExtendedGqlClient) DeleteOrganizationSso(ctx context.Context, orgMrn string) error {
+	var mutation struct {
+		DeleteOrganizationSso mondoov1.Boolean `graphql:"deleteOrganizationSso(orgMrn: $orgMrn)"`
+	}
+	variables := map[string]interface{}{
+		"orgMrn": mondoov1.String(orgMrn),
+	}
+	return c.Mutate(ctx, &mutation, nil, variables)
+}
+
+// RotateScimToken creates a new SCIM provisioning token and revokes the previous one.
+func (c *ExtendedGqlClient) RotateScimToken(ctx context.Context, orgMrn string) (ScimTokenPayload, error) {
+	var mutation struct {
+		RotateScimToken ScimTokenPayload `graphql:"rotateScimToken(orgMrn: $orgMrn)"`
+	}
+	variables := map[string]interface{}{
+		"orgMrn": mondoov1.String(orgMrn),
+	}
+
+	err := c.Mutate(ctx, &mutation, nil, variables)
+	return mutation.RotateScimToken, err
+}
+
+// Registration token types
+
+// RegistrationTokenInput extends mondoov1.RegistrationTokenInput with the labels and
+// annotations that are applied to assets that register with the token.
+type RegistrationTokenInput struct {
+	Description  *mondoov1.String            `json:"description,omitempty"`
+	ScopeMrn     *mondoov1.String            `json:"scopeMrn,omitempty"`
+	ExpiresIn    *mondoov1.Int               `json:"expiresIn,omitempty"`
+	NoExpiration *mondoov1.Boolean           `json:"noExpiration,omitempty"`
+	Labels       *[]mondoov1.AnnotationInput `json:"labels,omitempty"`
+	Annotations  *[]mondoov1.AnnotationInput `json:"annotations,omitempty"`
+}
+
+type UpdateRegistrationTokenInput struct {
+	Mrn         mondoov1.String             `json:"mrn"`
+	ExpiresAt   *mondoov1.String            `json:"expiresAt,omitempty"`
+	Labels      *[]mondoov1.AnnotationInput `json:"labels,omitempty"`
+	Annotations *[]mondoov1.AnnotationInput `json:"annotations,omitempty"`
+}
+
+type GeneratedRegistrationTokenPayload struct {
+	Mrn       mondoov1.String
+	Token     mondoov1.String
+	Revoked   mondoov1.Boolean
+	ExpiresAt mondoov1.String
+}
+
+type RegistrationTokenPayload struct {
+	Mrn         mondoov1.String
+	Description mondoov1.String
+	Revoked     mondoov1.Boolean
+	ExpiresAt   mondoov1.String
+	Labels      []AnnotationPayload
+	Annotations []AnnotationPayload
+	UsageCount  mondoov1.Int
+	LastUsedAt  mondoov1.String
+}
+
+// Registration token client methods
+
+func (c *ExtendedGqlClient) GenerateRegistrationToken(ctx context.Context, input RegistrationTokenInput) (GeneratedRegistrationTokenPayload, error) {
+	var mutation struct {
+		GenerateRegistrationToken GeneratedRegistrationTokenPayload `graphql:"generateRegistrationToken(input: $input)"`
+	}
+
+	tflog.Trace(ctx, "RegistrationTokenInput", map[string]interface{}{
+		"input": fmt.Sprintf("%+v", input),
+	})
+
+	err := c.Mutate(ctx, &mutation, input, nil)
+	return mutation.GenerateRegistrationToken, err
+}
+
+func (c *ExtendedGqlClient) GetRegistrationToken(ctx context.Context, tokenMrn string) (RegistrationTokenPayload, error) {
+	var q struct {
+		RegistrationToken RegistrationTokenPayload `graphql:"registrationToken(mrn: $mrn)"`
+	}
+	variables := map[string]interface{}{
+		"mrn": mondoov1.String(tokenMrn),
+	}
+
+	err := c.Query(ctx, &q, variables)
+	return q.RegistrationToken, err
+}
+
+func (c *ExtendedGqlClient) UpdateRegistrationToken(ctx context.Context, input UpdateRegistrationTokenInput) error {
+	var mutation struct {
+		UpdateRegistrationToken struct {
+			Mrn mondoov1.String
+		} `graphql:"updateRegistrationToken(input: $input)"`
+	}
+
+	tflog.Trace(ctx, "UpdateRegistrationTokenInput", map[string]interface{}{
+		"input": fmt.Sprintf("%+v", input),
+	})
+
+	return c.Mutate(ctx, &mutation, input, nil)
+}
+
+func (c *ExtendedGqlClient) RevokeRegistrationToken(ctx context.Context, tokenMrn string) error {
+	var mutation struct {
+		RevokeRegistrationTokenResponse struct {
+			RevokeRegistrationTokenSuccess struct {
+				Ok mondoov1.Boolean
+			} `graphql:"... on RevokeRegistrationTokenSuccess"`
+			RevokeRegistrationTokenFailure struct {
+				Message mondoov1.String
+				Code    mondoov1.String
+			} `graphql:"... on RevokeRegistrationTokenFailure"`
+		} `graphql:"revokeRegistrationToken(input: $input)"`
+	}
+
+	input := mondoov1.RevokeRegistrationTokenInput{
+		Mrn: mondoov1.String(tokenMrn),
+	}
+	tflog.Trace(ctx, "RevokeRegistrationTokenInput", map[string]interface{}{
+		"input": fmt.Sprintf("%+v", input),
+	})
+
+	err := c.Mutate(ctx, &mutation, input, nil)
+	if err != nil {
+		return err
+	}
+	if failure := mutation.RevokeRegistrationTokenResponse.RevokeRegistrationTokenFailure; failure.Message != "" {
+		return fmt.Errorf("%s: %s", failure.Code, failure.Message)
+	}
+	return nil
+}
+
+// Service account types
+
+type ServiceAccountPayload struct {
+	Id          string
+	Mrn         string
+	Name        string
+	Description string
+	Roles       []struct {
+		Mrn string
+	}
+	CreatedAt  string
+	LastUsedAt string
+	Creator    struct {
+		Mrn   string
+		Name  string
+		Email string
+	}
+}
+
+// Service account client methods
+
+type serviceAccountsQuery struct {
+	ServiceAccounts struct {
+		Edges []struct {
+			Node ServiceAccountPayload
+		}
+		PageInfo struct {
+			EndCursor   string
+			HasNextPage bool
+		}
+	} `graphql:"serviceAccounts(scopeMrn: $scopeMrn, after: $after)"`
+}
+
+// ListServiceAccounts returns the service accounts of the given space or organization, following
+// pagination until all service accounts are fetched.
+func (c *ExtendedGqlClient) ListServiceAccounts(ctx context.Context, scopeMrn string) ([]ServiceAccountPayload, error) {
+	accounts := []ServiceAccountPayload{}
+	after := ""
+	for {
+		var q serviceAccountsQuery
+		variables := map[string]interface{}{
+			"scopeMrn": mondoov1.String(scopeMrn),
+			"after":    mondoov1.String(after),
+		}
+		if err := c.Query(ctx, &q, variables); err != nil {
+			return nil, err
+		}
+		for _, edge := range q.ServiceAccounts.Edges {
+			accounts = append(accounts, edge.Node)
+		}
+		if !q.ServiceAccounts.PageInfo.HasNextPage {
+			return accounts, nil
+		}
+		after = q.ServiceAccounts.PageInfo.EndCursor
+	}
+}
+
+func (c *ExtendedGqlClient) GetServiceAccount(ctx context.Context, serviceAccountMrn string) (ServiceAccountPayload, error) {
+	var q struct {
+		ServiceAccount ServiceAccountPayload `graphql:"serviceAccount(mrn: $mrn)"`
+	}
+	variables := map[string]interface{}{
+		"mrn": mondoov1.String(serviceAccountMrn),
+	}
+
+	err := c.Query(ctx, &q, variables)
+	return q.ServiceAccount, err
+}
+
+// Service account key types
+
+type CreateServiceAccountKeyInput struct {
+	ServiceAccountMrn mondoov1.String  `json:"serviceAccountMrn"`
+	Description       *mondoov1.String `json:"description,omitempty"`
+}
+
+type RevokeServiceAccountKeyInput struct {
+	Mrn mondoov1.String `json:"mrn"`
+}
+
+type CreatedServiceAccountKeyPayload struct {
+	Mrn         mondoov1.String
+	Certificate mondoov1.String
+	PrivateKey  mondoov1.String
+	ScopeMrn    mondoov1.String
+	ApiEndpoint mondoov1.String
+	CreatedAt   mondoov1.String
+}
+
+type ServiceAccountKeyPayload struct {
+	Mrn         string
+	Description string
+	CreatedAt   string
+	LastUsedAt  string
+	Revoked     bool
+}
+
+// Service account key client methods
+
+// CreateServiceAccountKey issues an additional key for an existing service account. The private key
+// is only returned once.
+func (c *ExtendedGqlClient) CreateServiceAccountKey(ctx context.Context, input CreateServiceAccountKeyInput) (CreatedServiceAccountKeyPayload, error) {
+	var mutation struct {
+		CreateServiceAccountKey CreatedServiceAccountKeyPayload `graphql:"createServiceAccountKey(input: $input)"`
+	}
+
+	tflog.Trace(ctx, "CreateServiceAccountKeyInput", map[string]interface{}{
+		"input": fmt.Sprintf("%+v", input),
+	})
+
+	err := c.Mutate(ctx, &mutation, input, nil)
+	return mutation.CreateServiceAccountKey, err
+}
+
+func (c *ExtendedGqlClient) GetServiceAccountKey(ctx context.Context, keyMrn string) (ServiceAccountKeyPayload, error) {
+	var q struct {
+		ServiceAccountKey ServiceAccountKeyPayload `graphql:"serviceAccountKey(mrn: $mrn)"`
+	}
+	variables := map[string]interface{}{
+		"mrn": mondoov1.String(keyMrn),
+	}
+
+	err := c.Query(ctx, &q, variables)
+	return q.ServiceAccountKey, err
+}
+
+func (c *ExtendedGqlClient) RevokeServiceAccountKey(ctx context.Context, keyMrn string) error {
+	var mutation struct {
+		RevokeServiceAccountKey struct {
+			Mrn mondoov1.String
+		} `graphql:"revokeServiceAccountKey(input: $input)"`
+	}
+
+	input := RevokeServiceAccountKeyInput{
+		Mrn: mondoov1.String(keyMrn),
+	}
+	tflog.Trace(ctx, "RevokeServiceAccountKeyInput", map[string]interface{}{
+		"input": fmt.Sprintf("%+v", input),
+	})
+
+	return c.Mutate(ctx, &mutation, input, nil)
+}
+
 type setCustomPolicyPayload struct {
 	PolicyMrns []mondoov1.String
 }
@@ -672,17 +1245,27 @@ type CreateClientIntegrationPayload struct {
 }
 
 func (c *ExtendedGqlClient) CreateIntegration(ctx context.Context, spaceMrn, name string, typ mondoov1.ClientIntegrationType, opts mondoov1.ClientIntegrationConfigurationInput) (*CreateClientIntegrationPayload, error) {
+	return c.CreateIntegrationWithOptions(ctx, spaceMrn, name, typ, ClientIntegrationConfigurationInput{
+		ClientIntegrationConfigurationInput: opts,
+	})
+}
+
+// CreateIntegrationWithOptions creates an integration with configuration options that are
+// not yet part of the mondoo-go client.
+func (c *ExtendedGqlClient) CreateIntegrationWithOptions(ctx context.Context, spaceMrn, name string, typ mondoov1.ClientIntegrationType, opts ClientIntegrationConfigurationInput) (*CreateClientIntegrationPayload, error) {
 	var createMutation struct {
 		CreateClientIntegration struct {
 			Integration CreateClientIntegrationPayload
 		} `graphql:"createClientIntegration(input: $input)"`
 	}
 
-	createInput := mondoov1.CreateClientIntegrationInput{
-		SpaceMrn:             mondoov1.NewStringPtr(mondoov1.String(spaceMrn)),
-		Name:                 mondoov1.String(name),
-		Type:                 typ,
-		LongLivedToken:       false,
+	createInput := CreateClientIntegrationInput{
+		CreateClientIntegrationInput: mondoov1.CreateClientIntegrationInput{
+			SpaceMrn:       mondoov1.NewStringPtr(mondoov1.String(spaceMrn)),
+			Name:           mondoov1.String(name),
+			Type:           typ,
+			LongLivedToken: false,
+		},
 		ConfigurationOptions: opts,
 	}
 
@@ -769,14 +1352,24 @@ type UpdateIntegrationPayload struct {
 }
 
 func (c *ExtendedGqlClient) UpdateIntegration(ctx context.Context, mrn, name string, typ mondoov1.ClientIntegrationType, opts mondoov1.ClientIntegrationConfigurationInput) (*UpdateIntegrationPayload, error) {
+	return c.UpdateIntegrationWithOptions(ctx, mrn, name, typ, ClientIntegrationConfigurationInput{
+		ClientIntegrationConfigurationInput: opts,
+	})
+}
+
+// UpdateIntegrationWithOptions updates an integration with configuration options that are
+// not yet part of the mondoo-go client.
+func (c *ExtendedGqlClient) UpdateIntegrationWithOptions(ctx context.Context, mrn, name string, typ mondoov1.ClientIntegrationType, opts ClientIntegrationConfigurationInput) (*UpdateIntegrationPayload, error) {
 	var updateMutation struct {
 		UpdateIntegrationPayload `graphql:"updateClientIntegrationConfiguration(input: $input)"`
 	}
 
-	updateInput := mondoov1.UpdateClientIntegrationConfigurationInput{
-		Mrn:                  mondoov1.String(mrn),
-		Name:                 mondoov1.NewStringPtr(mondoov1.String(name)),
-		Type:                 typ,
+	updateInput := UpdateClientIntegrationConfigurationInput{
+		UpdateClientIntegrationConfigurationInput: mondoov1.UpdateClientIntegrationConfigurationInput{
+			Mrn:  mondoov1.String(mrn),
+			Name: mondoov1.NewStringPtr(mondoov1.String(name)),
+			Type: typ,
+		},
 		ConfigurationOptions: opts,
 	}
 	tflog.Trace(ctx, "UpdateIntegration", map[string]interface{}{
@@ -828,6 +1421,14 @@ type SlackConfigurationOptions struct {
 	Placeholder string
 }
 
+type SlackChannel struct {
+	ChannelId         string
+	SeverityThreshold string
+	EventTypes        []string
+	SpaceMrns         []string
+	WorkspaceMrns     []string
+}
+
 type GithubConfigurationOptions struct {
 	Owner                string
 	Repository           string
@@ -930,6 +1531,68 @@ type JiraConfigurationOptions struct {
 	AutoCreateCases  bool
 }
 
+// JiraIssueConfigurationOptions are the fields of the issues created by a Jira integration. They are
+// read with GetJiraIssueConfigurationOptions.
+type JiraIssueConfigurationOptions struct {
+	IssueType           string
+	Labels              []string
+	Components          []string
+	PriorityMapping     []JiraPriorityMapping
+	CustomFields        []JiraCustomField
+	SummaryTemplate     string
+	DescriptionTemplate string
+}
+
+type JiraPriorityMapping struct {
+	Severity string
+	Priority string
+}
+
+type JiraCustomField struct {
+	ID    string
+	Value string
+}
+
+type MsTeamsConfigurationOptions struct {
+	App struct {
+		TenantId  string
+		TeamId    string
+		ChannelId string
+	}
+}
+
+type PagerDutyConfigurationOptions struct {
+	SeverityMapping []PagerDutySeverityMapping
+}
+
+type PagerDutySeverityMapping struct {
+	Severity          string
+	PagerDutySeverity string
+}
+
+type WebhookConfigurationOptions struct {
+	Url           string
+	Headers       []WebhookHeader
+	Events        []string
+	PayloadFormat string
+}
+
+// WebhookHeader is a custom header of a webhook integration, its value is a secret and not returned by the API.
+type WebhookHeader struct {
+	Name string
+}
+
+type K8sConfigurationOptions struct {
+	ClusterName             string
+	ScanNodes               bool
+	ScanWorkloads           bool
+	ScanAdmissionController bool
+	ScanContainerImages     bool
+	NamespaceAllowList      []string
+	NamespaceDenyList       []string
+	Schedule                string `graphql:"k8sSchedule: schedule"`
+}
+
 type EmailConfigurationOptions struct {
 	Recipients        []EmailRecipient
 	AutoCreateTickets bool
@@ -963,19 +1626,23 @@ type SentinelOneConfigurationOptions struct {
 type Integration struct {
 	Mrn                  string
 	Name                 string
+	Type                 string
 	ConfigurationOptions ClientIntegrationConfigurationOptions `graphql:"configurationOptions"`
 }
 
 // SpaceID returns the space where the integration is configured (using the integration MRN).
-func (i Integration) SpaceID() string {
+// It returns an error for organization and platform integrations.
+func (i Integration) SpaceID() (string, error) {
 	// we are expecting MRNs like:
-	// => "//captain.api.mondoo.app/spaces/{ID}/integrations/{ID}"
-	mrnSplit := strings.Split(i.Mrn, "/")
-	l := len(mrnSplit)
-	if l >= 3 { // check for safety
-		return mrnSplit[l-3]
+	// => "//integration.api.mondoo.app/spaces/{ID}/integrations/{ID}"
+	m, err := mrn.Parse(i.Mrn)
+	if err != nil {
+		return "", err
 	}
-	return ""
+	if m.SpaceID == "" {
+		return "", fmt.Errorf("integration %s is not configured in a space", i.Mrn)
+	}
+	return m.SpaceID, nil
 }
 
 // ScopeMRN returns the scope MRN (using the captain API domain) for the integration.
@@ -987,25 +1654,20 @@ func (i Integration) SpaceID() string {
 //	//integration.api.mondoo.app/organizations/{ID}/integrations/{ID} → //captain.api.mondoo.app/organizations/{ID}
 //	//integration.api.mondoo.app/integrations/{ID} → //platform.api.mondoo.app
 func (i Integration) ScopeMRN() string {
-	if IsValidPlatformIntegrationMrn(i.Mrn) {
-		return "//platform.api.mondoo.app"
-	}
 	if !IsValidIntegrationMrn(i.Mrn) {
 		return ""
 	}
-	// Extract the scope path segment (e.g. "spaces/{ID}" or "organizations/{ID}")
-	idx := strings.LastIndex(i.Mrn, "/integrations/")
-	if idx == -1 {
+	m, err := mrn.Parse(i.Mrn)
+	if err != nil {
 		return ""
 	}
-	path := i.Mrn[:idx]
-	path = strings.Replace(path, "//integration.api.mondoo.app/", "//captain.api.mondoo.app/", 1)
-	return path
+	return m.ScopeMrn()
 }
 
 // IsSpaceScoped returns true if the integration belongs to a space (vs. org or platform).
 func (i Integration) IsSpaceScoped() bool {
-	return strings.Contains(i.Mrn, "/spaces/")
+	_, err := i.SpaceID()
+	return err == nil
 }
 
 type ClientIntegration struct {
@@ -1028,6 +1690,94 @@ func (c *ExtendedGqlClient) GetClientIntegration(ctx context.Context, mrn string
 	return q.ClientIntegration.Integration, nil
 }
 
+// getIntegrationConfigurationOptions reads the configuration options of a single integration. T holds
+// the fragment of one integration type, so options that are not part of ClientIntegrationConfigurationOptions
+// don't extend the query shared by all integrations.
+func getIntegrationConfigurationOptions[T any](ctx context.Context, c *ExtendedGqlClient, mrn string) (T, error) {
+	var q struct {
+		ClientIntegration struct {
+			Integration struct {
+				ConfigurationOptions T `graphql:"configurationOptions"`
+			}
+		} `graphql:"clientIntegration(input: {mrn: $mrn})"`
+	}
+	variables := map[string]interface{}{
+		"mrn": mondoov1.String(mrn),
+	}
+
+	err := c.Query(ctx, &q, variables)
+	return q.ClientIntegration.Integration.ConfigurationOptions, err
+}
+
+func (c *ExtendedGqlClient) GetJiraIssueConfigurationOptions(ctx context.Context, mrn string) (JiraIssueConfigurationOptions, error) {
+	options, err := getIntegrationConfigurationOptions[struct {
+		Jira JiraIssueConfigurationOptions `graphql:"... on JiraConfigurationOptions"`
+	}](ctx, c, mrn)
+	return options.Jira, err
+}
+
+func (c *ExtendedGqlClient) GetK8sConfigurationOptions(ctx context.Context, mrn string) (K8sConfigurationOptions, error) {
+	options, err := getIntegrationConfigurationOptions[struct {
+		K8s K8sConfigurationOptions `graphql:"... on K8sConfigurationOptions"`
+	}](ctx, c, mrn)
+	return options.K8s, err
+}
+
+func (c *ExtendedGqlClient) GetMsTeamsConfigurationOptions(ctx context.Context, mrn string) (MsTeamsConfigurationOptions, error) {
+	options, err := getIntegrationConfigurationOptions[struct {
+		MsTeams MsTeamsConfigurationOptions `graphql:"... on MsTeamsConfigurationOptions"`
+	}](ctx, c, mrn)
+	return options.MsTeams, err
+}
+
+func (c *ExtendedGqlClient) GetPagerDutyConfigurationOptions(ctx context.Context, mrn string) (PagerDutyConfigurationOptions, error) {
+	options, err := getIntegrationConfigurationOptions[struct {
+		PagerDuty PagerDutyConfigurationOptions `graphql:"... on PagerDutyConfigurationOptions"`
+	}](ctx, c, mrn)
+	return options.PagerDuty, err
+}
+
+func (c *ExtendedGqlClient) GetSlackChannels(ctx context.Context, mrn string) ([]SlackChannel, error) {
+	options, err := getIntegrationConfigurationOptions[struct {
+		Slack struct {
+			Channels []SlackChannel
+		} `graphql:"... on SlackConfigurationOptions"`
+	}](ctx, c, mrn)
+	return options.Slack.Channels, err
+}
+
+func (c *ExtendedGqlClient) GetWebhookConfigurationOptions(ctx context.Context, mrn string) (WebhookConfigurationOptions, error) {
+	options, err := getIntegrationConfigurationOptions[struct {
+		Webhook WebhookConfigurationOptions `graphql:"... on WebhookConfigurationOptions"`
+	}](ctx, c, mrn)
+	return options.Webhook, err
+}
+
+// ListIntegrations returns the integrations of the given type in a space.
+func (c *ExtendedGqlClient) ListIntegrations(ctx context.Context, spaceMrn string, typ mondoov1.ClientIntegrationType) ([]Integration, error) {
+	var q struct {
+		ClientIntegrations struct {
+			Integrations []Integration
+		} `graphql:"clientIntegrations(input: $input)"`
+	}
+	input := ClientIntegrationsInput{
+		SpaceMrn: mondoov1.String(spaceMrn),
+		FilterQuery: &ClientIntegrationFilterInput{
+			Types: []mondoov1.ClientIntegrationType{typ},
+		},
+	}
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	err := c.Query(ctx, &q, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	return q.ClientIntegrations.Integrations, nil
+}
+
 type triggerActionPayload struct {
 	Mrn string
 }
@@ -1142,7 +1892,7 @@ func (c *ExtendedGqlClient) GetFramework(ctx context.Context, spaceMrn string, s
 	var getFrameworkQuery struct {
 		ComplianceFramework ComplianceFrameworkPayload `graphql:"complianceFramework(input: $input)"`
 	}
-	frameworkMrn := fmt.Sprintf("//policy.api.mondoo.app/spaces/%s/frameworks/%s", spaceId, uid)
+	frameworkMrn := mrn.Framework(spaceId, uid)
 	// Define the input variable according to the provided query
 	input := mondoov1.ComplianceFrameworkInput{
 		ScopeMrn:     mondoov1.String(spaceMrn),
@@ -1162,6 +1912,28 @@ func (c *ExtendedGqlClient) GetFramework(ctx context.Context, spaceMrn string, s
 	return &getFrameworkQuery.ComplianceFramework, nil
 }
 
+// DownloadFramework returns the YAML bundle of a compliance framework, including the framework
+// maps with the checks and policies of its controls.
+func (c *ExtendedGqlClient) DownloadFramework(ctx context.Context, frameworkMrn string) (string, error) {
+	var q struct {
+		DownloadFramework struct {
+			Yaml string `graphql:"yaml"`
+		} `graphql:"downloadFramework(input: $input)"`
+	}
+	variables := map[string]interface{}{
+		"input": mondoov1.DownloadFrameworkInput{
+			Mrn: mondoov1.String(frameworkMrn),
+		},
+	}
+
+	err := c.Query(ctx, &q, variables)
+	if err != nil {
+		return "", err
+	}
+
+	return q.DownloadFramework.Yaml, nil
+}
+
 type ComplianceFrameworksPayload struct {
 	Authors                  []Author `graphql:"authors"`
 	Completion               mondoov1.Float
@@ -1221,6 +1993,15 @@ func (c *ExtendedGqlClient) ListFrameworks(ctx context.Context, scopeMrn string)
 }
 
 func (c *ExtendedGqlClient) UpdateFramework(ctx context.Context, frameworkMrn string, scopeMrn string, enabled bool) error {
+	action := mondoov1.ComplianceFrameworkMutationActionPreview
+	if enabled {
+		action = mondoov1.ComplianceFrameworkMutationActionEnable
+	}
+	return c.ApplyFrameworkAction(ctx, frameworkMrn, scopeMrn, action)
+}
+
+// ApplyFrameworkAction enables, previews or disables a compliance framework in the provided scope.
+func (c *ExtendedGqlClient) ApplyFrameworkAction(ctx context.Context, frameworkMrn string, scopeMrn string, action mondoov1.ComplianceFrameworkMutationAction) error {
 	var updateMutation struct {
 		ApplyFramework bool `graphql:"applyFrameworkMutation(input: $input)"`
 	}
@@ -1228,12 +2009,7 @@ func (c *ExtendedGqlClient) UpdateFramework(ctx context.Context, frameworkMrn st
 	input := mondoov1.ComplianceFrameworkMutationInput{
 		FrameworkMrn: mondoov1.String(frameworkMrn),
 		ScopeMrn:     mondoov1.String(scopeMrn),
-	}
-
-	if enabled {
-		input.Action = mondoov1.ComplianceFrameworkMutationActionEnable
-	} else {
-		input.Action = mondoov1.ComplianceFrameworkMutationActionPreview
+		Action:       action,
 	}
 
 	return c.Mutate(ctx, &updateMutation, input, nil)
@@ -1289,8 +2065,7 @@ func (c *ExtendedGqlClient) ImportIntegration(ctx context.Context, req resource.
 	}
 
 	// Only validate space match for space-scoped integrations
-	if integration.IsSpaceScoped() {
-		spaceID := integration.SpaceID()
+	if spaceID, err := integration.SpaceID(); err == nil {
 		if c.Space().ID() != "" && c.Space().ID() != spaceID {
 			// The provider is configured to manage resources in a different space than the one the
 			// resource is currently configured, we won't allow that
@@ -1307,6 +2082,25 @@ func (c *ExtendedGqlClient) ImportIntegration(ctx context.Context, req resource.
 	return &integration, true
 }
 
+// ImportSpaceIntegration imports an integration like ImportIntegration for resources that are configured in
+// a space and returns the ID of that space. Organization and platform integrations can't be imported.
+func (c *ExtendedGqlClient) ImportSpaceIntegration(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) (*Integration, string, bool) {
+	integration, ok := c.ImportIntegration(ctx, req, resp)
+	if !ok {
+		return nil, "", false
+	}
+
+	spaceID, err := integration.SpaceID()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Unable to import integration. Got error: %s", err),
+		)
+		return nil, "", false
+	}
+	return integration, spaceID, true
+}
+
 func (c *ExtendedGqlClient) ApplyException(
 	ctx context.Context,
 	scopeMrn string,
@@ -1715,6 +2509,9 @@ func (c *ExtendedGqlClient) ImportException(ctx context.Context, req resource.Im
 	return exception, true
 }
 
+// errExceptionNotFound is returned by GetException when no exception group matches the ID.
+var errExceptionNotFound = errors.New("failed to find exception")
+
 func (c *ExtendedGqlClient) GetException(ctx context.Context, scopeMrn string, id string) (*ExceptionGroup, error) {
 	var listExceptionGroups struct {
 		ListExceptionGroups ListExceptionGroupsConnection `graphql:"listExceptionGroups(input: $input)"`
@@ -1736,7 +2533,7 @@ func (c *ExtendedGqlClient) GetException(ctx context.Context, scopeMrn string, i
 		return nil, fmt.Errorf("failed to find exception: %w", err)
 	}
 	if len(listExceptionGroups.ListExceptionGroups.Edges) == 0 {
-		return nil, fmt.Errorf("failed to find exception with id %s in scope %s", id, scopeMrn)
+		return nil, fmt.Errorf("%w with id %s in scope %s", errExceptionNotFound, id, scopeMrn)
 	}
 	tflog.Debug(ctx, "found exception", map[string]interface{}{
 		"exceptionId": listExceptionGroups.ListExceptionGroups.Edges[0].Node.ExceptionID,
@@ -1744,6 +2541,54 @@ func (c *ExtendedGqlClient) GetException(ctx context.Context, scopeMrn string, i
 	return &listExceptionGroups.ListExceptionGroups.Edges[0].Node, nil
 }
 
+// ControlExceptionGroup is an exception group for compliance framework controls.
+type ControlExceptionGroup struct {
+	ExceptionID   string             `graphql:"exceptionId"`
+	ScopeMrn      string             `graphql:"scopeMrn"`
+	ValidUntil    *string            `graphql:"validUntil"`
+	Justification *string            `graphql:"justification"`
+	Action        string             `graphql:"action"`
+	Exceptions    []ControlException `graphql:"exceptions"`
+}
+
+type ControlException struct {
+	Control struct {
+		Mrn string `graphql:"mrn"`
+	} `graphql:"...on ControlException"`
+}
+
+// GetControlException looks up an exception group for framework controls. It uses its own query, so the
+// control fragment is not added to the exception groups read by GetException.
+func (c *ExtendedGqlClient) GetControlException(ctx context.Context, scopeMrn string, id string) (*ControlExceptionGroup, error) {
+	var listExceptionGroups struct {
+		ListExceptionGroups struct {
+			Edges []struct {
+				Node ControlExceptionGroup `graphql:"node"`
+			} `graphql:"edges"`
+		} `graphql:"listExceptionGroups(input: $input)"`
+	}
+	tflog.Debug(ctx, "GetControlException", map[string]interface{}{
+		"scopeMrn": scopeMrn,
+		"id":       id,
+	})
+	input := mondoov1.ListExceptionGroupsInput{
+		ScopeMrn: mondoov1.String(scopeMrn),
+		Filter:   &mondoov1.ListExceptionGroupsFilter{Id: ToPtr(mondoov1.String(id))},
+	}
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	err := c.Query(ctx, &listExceptionGroups, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find exception: %w", err)
+	}
+	if len(listExceptionGroups.ListExceptionGroups.Edges) == 0 {
+		return nil, fmt.Errorf("%w with id %s in scope %s", errExceptionNotFound, id, scopeMrn)
+	}
+	return &listExceptionGroups.ListExceptionGroups.Edges[0].Node, nil
+}
+
 // Asset routing types
 
 type AssetRoutingConditionField string
@@ -1901,3 +2746,639 @@ func (c *ExtendedGqlClient) DeleteAssetRoutingRule(ctx context.Context, ruleMrn
 	}
 	return c.Mutate(ctx, &mutation, nil, variables)
 }
+
+// Compliance evidence types
+
+type CreateComplianceEvidenceInput struct {
+	ScopeMrn    mondoov1.String  `json:"scopeMrn"`
+	ControlMrn  mondoov1.String  `json:"controlMrn"`
+	Title       mondoov1.String  `json:"title"`
+	Description *mondoov1.String `json:"description,omitempty"`
+	Url         *mondoov1.String `json:"url,omitempty"`
+	// Document is the uploaded file encoded as data URL.
+	Document *mondoov1.String `json:"document,omitempty"`
+}
+
+type UpdateComplianceEvidenceInput struct {
+	Mrn         mondoov1.String  `json:"mrn"`
+	Title       mondoov1.String  `json:"title"`
+	Description *mondoov1.String `json:"description,omitempty"`
+	Url         *mondoov1.String `json:"url,omitempty"`
+	Document    *mondoov1.String `json:"document,omitempty"`
+}
+
+type ComplianceEvidencePayload struct {
+	Mrn         string `json:"mrn" graphql:"mrn"`
+	ScopeMrn    string `json:"scopeMrn" graphql:"scopeMrn"`
+	ControlMrn  string `json:"controlMrn" graphql:"controlMrn"`
+	Title       string `json:"title" graphql:"title"`
+	Description string `json:"description" graphql:"description"`
+	Url         string `json:"url" graphql:"url"`
+}
+
+// Compliance evidence client methods
+
+func (c *ExtendedGqlClient) CreateComplianceEvidence(ctx context.Context, input CreateComplianceEvidenceInput) (ComplianceEvidencePayload, error) {
+	var mutation struct {
+		CreateComplianceEvidence ComplianceEvidencePayload `graphql:"createComplianceEvidence(input: $input)"`
+	}
+
+	tflog.Trace(ctx, "CreateComplianceEvidenceInput", map[string]interface{}{
+		"scopeMrn":   input.ScopeMrn,
+		"controlMrn": input.ControlMrn,
+	})
+
+	err := c.Mutate(ctx, &mutation, input, nil)
+	return mutation.CreateComplianceEvidence, err
+}
+
+func (c *ExtendedGqlClient) GetComplianceEvidence(ctx context.Context, evidenceMrn string) (ComplianceEvidencePayload, error) {
+	var q struct {
+		ComplianceEvidence ComplianceEvidencePayload `graphql:"complianceEvidence(mrn: $mrn)"`
+	}
+	variables := map[string]interface{}{
+		"mrn": mondoov1.String(evidenceMrn),
+	}
+
+	err := c.Query(ctx, &q, variables)
+	return q.ComplianceEvidence, err
+}
+
+func (c *ExtendedGqlClient) UpdateComplianceEvidence(ctx context.Context, input UpdateComplianceEvidenceInput) (ComplianceEvidencePayload, error) {
+	var mutation struct {
+		UpdateComplianceEvidence ComplianceEvidencePayload `graphql:"updateComplianceEvidence(input: $input)"`
+	}
+
+	tflog.Trace(ctx, "UpdateComplianceEvidenceInput", map[string]interface{}{
+		"mrn": input.Mrn,
+	})
+
+	err := c.Mutate(ctx, &mutation, input, nil)
+	return mutation.UpdateComplianceEvidence, err
+}
+
+func (c *ExtendedGqlClient) DeleteComplianceEvidence(ctx context.Context, evidenceMrn string) error {
+	var mutation struct {
+		DeleteComplianceEvidence mondoov1.Boolean `graphql:"deleteComplianceEvidence(mrn: $mrn)"`
+	}
+	variables := map[string]interface{}{
+		"mrn": mondoov1.String(evidenceMrn),
+	}
+	return c.Mutate(ctx, &mutation, nil, variables)
+}
+
+// Workspace selection preview types
+
+type WorkspaceSelectionPreviewInput struct {
+	ScopeMrn   mondoov1.String          `json:"scopeMrn"`
+	Selections WorkspaceSelectionsInput `json:"selections"`
+	// Maximum number of matching assets to return, the total count is always computed.
+	First *mondoov1.Int `json:"first,omitempty"`
+}
+
+type WorkspaceSelectionPreviewAsset struct {
+	Mrn  string `json:"mrn" graphql:"mrn"`
+	Name string `json:"name" graphql:"name"`
+}
+
+type WorkspaceSelectionPreviewPayload struct {
+	TotalCount int                              `json:"totalCount" graphql:"totalCount"`
+	Assets     []WorkspaceSelectionPreviewAsset `json:"assets" graphql:"assets"`
+}
+
+// Workspace selection preview client methods
+
+// PreviewWorkspaceSelections returns the number of assets in the scope matched by the given
+// selections, together with up to first of those assets.
+func (c *ExtendedGqlClient) PreviewWorkspaceSelections(ctx context.Context, scopeMrn string, selections WorkspaceSelectionsInput, first int) (WorkspaceSelectionPreviewPayload, error) {
+	var q struct {
+		WorkspaceSelectionPreview WorkspaceSelectionPreviewPayload `graphql:"workspaceSelectionPreview(input: $input)"`
+	}
+	input := WorkspaceSelectionPreviewInput{
+		ScopeMrn:   mondoov1.String(scopeMrn),
+		Selections: selections,
+		First:      ToPtr(mondoov1.Int(first)),
+	}
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	tflog.Trace(ctx, "WorkspaceSelectionPreviewInput", map[string]interface{}{
+		"scopeMrn": input.ScopeMrn,
+		"first":    first,
+	})
+
+	err := c.Query(ctx, &q, variables)
+	return q.WorkspaceSelectionPreview, err
+}
+
+// Workspace list types
+
+type WorkspacesInput struct {
+	ScopeMrn mondoov1.String `json:"scopeMrn"`
+}
+
+// Workspace client methods
+
+// ListWorkspaces returns all workspaces of a space.
+func (c *ExtendedGqlClient) ListWorkspaces(ctx context.Context, scopeMrn string) ([]Workspace, error) {
+	var q struct {
+		Workspaces struct {
+			Edges []struct {
+				Node struct {
+					Workspace `graphql:"... on Workspace"`
+				}
+			}
+			PageInfo struct {
+				EndCursor   string
+				HasNextPage bool
+			}
+		} `graphql:"workspaces(input: $input, first: $first, after: $after)"`
+	}
+	variables := map[string]interface{}{
+		"input": WorkspacesInput{ScopeMrn: mondoov1.String(scopeMrn)},
+		"first": mondoov1.Int(100),
+		"after": mondoov1.String(""),
+	}
+
+	workspaces := []Workspace{}
+	for {
+		err := c.Query(ctx, &q, variables)
+		if err != nil {
+			return nil, err
+		}
+		for _, edge := range q.Workspaces.Edges {
+			workspaces = append(workspaces, edge.Node.Workspace)
+		}
+		if !q.Workspaces.PageInfo.HasNextPage {
+			return workspaces, nil
+		}
+		variables["after"] = mondoov1.String(q.Workspaces.PageInfo.EndCursor)
+	}
+}
+
+func (c *ExtendedGqlClient) CreateWorkspace(ctx context.Context, input CreateWorkspaceInput) (Workspace, error) {
+	var createMutation struct {
+		Workspace struct {
+			Workspace
+		} `graphql:"createWorkspace(input: $input)"`
+	}
+
+	tflog.Trace(ctx, "CreateWorkspaceInput", map[string]interface{}{
+		"input": fmt.Sprintf("%+v", input),
+	})
+
+	err := c.Mutate(ctx, &createMutation, input, nil)
+	return createMutation.Workspace.Workspace, err
+}
+
+// Case routing types
+
+type CaseRoutingConditionField string
+
+const (
+	CaseRoutingConditionFieldSeverity   CaseRoutingConditionField = "SEVERITY"
+	CaseRoutingConditionFieldPolicy     CaseRoutingConditionField = "POLICY"
+	CaseRoutingConditionFieldAssetLabel CaseRoutingConditionField = "ASSET_LABEL"
+	CaseRoutingConditionFieldWorkspace  CaseRoutingConditionField = "WORKSPACE"
+)
+
+type CaseRoutingConditionOperator string
+
+const (
+	CaseRoutingConditionOperatorEqual    CaseRoutingConditionOperator = "EQUAL"
+	CaseRoutingConditionOperatorNotEqual CaseRoutingConditionOperator = "NOT_EQUAL"
+	CaseRoutingConditionOperatorAtLeast  CaseRoutingConditionOperator = "AT_LEAST"
+)
+
+// Input types for case routing mutations
+
+type CaseRoutingConditionInput struct {
+	Field    CaseRoutingConditionField    `json:"field"`
+	Operator CaseRoutingConditionOperator `json:"operator"`
+	Values   []mondoov1.String            `json:"values"`
+	Key      *mondoov1.String             `json:"key,omitempty"`
+}
+
+type CaseRoutingTargetInput struct {
+	Project    *mondoov1.String  `json:"project,omitempty"`
+	IssueType  *mondoov1.String  `json:"issueType,omitempty"`
+	Assignee   *mondoov1.String  `json:"assignee,omitempty"`
+	Priority   *mondoov1.String  `json:"priority,omitempty"`
+	Recipients []mondoov1.String `json:"recipients,omitempty"`
+}
+
+type CreateCaseRoutingRuleInput struct {
+	IntegrationMrn mondoov1.String             `json:"integrationMrn"`
+	Priority       mondoov1.Int                `json:"priority"`
+	Conditions     []CaseRoutingConditionInput `json:"conditions"`
+	Target         CaseRoutingTargetInput      `json:"target"`
+}
+
+type UpdateCaseRoutingRuleInput struct {
+	RuleMrn    mondoov1.String             `json:"ruleMrn"`
+	Priority   mondoov1.Int                `json:"priority"`
+	Conditions []CaseRoutingConditionInput `json:"conditions"`
+	Target     CaseRoutingTargetInput      `json:"target"`
+}
+
+// Payload types for case routing responses
+
+type CaseRoutingConditionPayload struct {
+	Field    string   `json:"field" graphql:"field"`
+	Operator string   `json:"operator" graphql:"operator"`
+	Values   []string `json:"values" graphql:"values"`
+	Key      string   `json:"key" graphql:"key"`
+}
+
+type CaseRoutingTargetPayload struct {
+	Project    string   `json:"project" graphql:"project"`
+	IssueType  string   `json:"issueType" graphql:"issueType"`
+	Assignee   string   `json:"assignee" graphql:"assignee"`
+	Priority   string   `json:"priority" graphql:"priority"`
+	Recipients []string `json:"recipients" graphql:"recipients"`
+}
+
+type CaseRoutingRulePayload struct {
+	Mrn            string                        `json:"mrn" graphql:"mrn"`
+	IntegrationMrn string                        `json:"integrationMrn" graphql:"integrationMrn"`
+	Priority       int                           `json:"priority" graphql:"priority"`
+	Conditions     []CaseRoutingConditionPayload `json:"conditions" graphql:"conditions"`
+	Target         CaseRoutingTargetPayload      `json:"target" graphql:"target"`
+}
+
+// Case routing client methods
+
+func (c *ExtendedGqlClient) CreateCaseRoutingRule(ctx context.Context, input CreateCaseRoutingRuleInput) (CaseRoutingRulePayload, error) {
+	var mutation struct {
+		CreateCaseRoutingRule CaseRoutingRulePayload `graphql:"createCaseRoutingRule(input: $input)"`
+	}
+
+	tflog.Trace(ctx, "CreateCaseRoutingRuleInput", map[string]interface{}{
+		"input": fmt.Sprintf("%+v", input),
+	})
+
+	err := c.Mutate(ctx, &mutation, input, nil)
+	return mutation.CreateCaseRoutingRule, err
+}
+
+func (c *ExtendedGqlClient) GetCaseRoutingRule(ctx context.Context, ruleMrn string) (CaseRoutingRulePayload, error) {
+	var q struct {
+		CaseRoutingRule CaseRoutingRulePayload `graphql:"caseRoutingRule(ruleMrn: $ruleMrn)"`
+	}
+	variables := map[string]interface{}{
+		"ruleMrn": mondoov1.String(ruleMrn),
+	}
+
+	err := c.Query(ctx, &q, variables)
+	return q.CaseRoutingRule, err
+}
+
+func (c *ExtendedGqlClient) UpdateCaseRoutingRule(ctx context.Context, input UpdateCaseRoutingRuleInput) (CaseRoutingRulePayload, error) {
+	var mutation struct {
+		UpdateCaseRoutingRule CaseRoutingRulePayload `graphql:"updateCaseRoutingRule(input: $input)"`
+	}
+
+	tflog.Trace(ctx, "UpdateCaseRoutingRuleInput", map[string]interface{}{
+		"input": fmt.Sprintf("%+v", input),
+	})
+
+	err := c.Mutate(ctx, &mutation, input, nil)
+	return mutation.UpdateCaseRoutingRule, err
+}
+
+func (c *ExtendedGqlClient) DeleteCaseRoutingRule(ctx context.Context, ruleMrn string) error {
+	var mutation struct {
+		DeleteCaseRoutingRule mondoov1.Boolean `graphql:"deleteCaseRoutingRule(ruleMrn: $ruleMrn)"`
+	}
+	variables := map[string]interface{}{
+		"ruleMrn": mondoov1.String(ruleMrn),
+	}
+	return c.Mutate(ctx, &mutation, nil, variables)
+}
+
+// Client integration configuration types
+//
+// The types below extend the mondoo-go inputs with configuration options that are not
+// yet part of the generated client. The type names match the GraphQL input types.
+
+type ClientIntegrationConfigurationInput struct {
+	mondoov1.ClientIntegrationConfigurationInput
+
+	JiraConfigurationOptions      *JiraConfigurationOptionsInput      `json:"jiraConfigurationOptions,omitempty"`
+	K8sConfigurationOptions       *K8sConfigurationOptionsInput       `json:"k8sConfigurationOptions,omitempty"`
+	MsTeamsConfigurationOptions   *MsTeamsConfigurationOptionsInput   `json:"msTeamsConfigurationOptions,omitempty"`
+	PagerDutyConfigurationOptions *PagerDutyConfigurationOptionsInput `json:"pagerDutyConfigurationOptions,omitempty"`
+	SlackConfigurationOptions     *SlackConfigurationOptionsInput     `json:"slackConfigurationOptions,omitempty"`
+	WebhookConfigurationOptions   *WebhookConfigurationOptionsInput   `json:"webhookConfigurationOptions,omitempty"`
+}
+
+type CreateClientIntegrationInput struct {
+	mondoov1.CreateClientIntegrationInput
+
+	ConfigurationOptions ClientIntegrationConfigurationInput `json:"configurationOptions"`
+}
+
+type UpdateClientIntegrationConfigurationInput struct {
+	mondoov1.UpdateClientIntegrationConfigurationInput
+
+	ConfigurationOptions ClientIntegrationConfigurationInput `json:"configurationOptions"`
+}
+
+type ClientIntegrationsInput struct {
+	SpaceMrn    mondoov1.String               `json:"spaceMrn"`
+	FilterQuery *ClientIntegrationFilterInput `json:"filterQuery,omitempty"`
+}
+
+type ClientIntegrationFilterInput struct {
+	Types []mondoov1.ClientIntegrationType `json:"types,omitempty"`
+}
+
+// JiraConfigurationOptionsInput extends the Jira options with the issue fields. Issue fields are only
+// sent when they are set, an empty value clears them.
+type JiraConfigurationOptionsInput struct {
+	mondoov1.JiraConfigurationOptionsInput
+
+	IssueType           *mondoov1.String            `json:"issueType,omitempty"`
+	Labels              *[]mondoov1.String          `json:"labels,omitempty"`
+	Components          *[]mondoov1.String          `json:"components,omitempty"`
+	PriorityMapping     *[]JiraPriorityMappingInput `json:"priorityMapping,omitempty"`
+	CustomFields        *[]JiraCustomFieldInput     `json:"customFields,omitempty"`
+	SummaryTemplate     *mondoov1.String            `json:"summaryTemplate,omitempty"`
+	DescriptionTemplate *mondoov1.String            `json:"descriptionTemplate,omitempty"`
+}
+
+type JiraPriorityMappingInput struct {
+	Severity mondoov1.String `json:"severity"`
+	Priority mondoov1.String `json:"priority"`
+}
+
+type JiraCustomFieldInput struct {
+	ID    mondoov1.String `json:"id"`
+	Value mondoov1.String `json:"value"`
+}
+
+// ClientIntegrationTypeWebhook is the integration type of generic webhook notifications.
+const ClientIntegrationTypeWebhook mondoov1.ClientIntegrationType = "WEBHOOK"
+
+type WebhookEvent string
+
+const (
+	WebhookEventNewCriticalFinding WebhookEvent = "NEW_CRITICAL_FINDING"
+	WebhookEventScanFailure        WebhookEvent = "SCAN_FAILURE"
+	WebhookEventScoreDrop          WebhookEvent = "SCORE_DROP"
+)
+
+type WebhookPayloadFormat string
+
+const (
+	WebhookPayloadFormatJSON        WebhookPayloadFormat = "JSON"
+	WebhookPayloadFormatCloudEvents WebhookPayloadFormat = "CLOUDEVENTS"
+)
+
+type WebhookConfigurationOptionsInput struct {
+	Url           mondoov1.String      `json:"url"`
+	Headers       []WebhookHeaderInput `json:"headers"`
+	Secret        *mondoov1.String     `json:"secret,omitempty"`
+	Events        []WebhookEvent       `json:"events"`
+	PayloadFormat WebhookPayloadFormat `json:"payloadFormat"`
+}
+
+type WebhookHeaderInput struct {
+	Name  mondoov1.String `json:"name"`
+	Value mondoov1.String `json:"value"`
+}
+
+// ClientIntegrationTypeK8s is the integration type of Kubernetes clusters scanned by the Mondoo operator.
+const ClientIntegrationTypeK8s mondoov1.ClientIntegrationType = "K8S"
+
+type K8sConfigurationOptionsInput struct {
+	ClusterName             mondoov1.String   `json:"clusterName"`
+	ScanNodes               mondoov1.Boolean  `json:"scanNodes"`
+	ScanWorkloads           mondoov1.Boolean  `json:"scanWorkloads"`
+	ScanAdmissionController mondoov1.Boolean  `json:"scanAdmissionController"`
+	ScanContainerImages     mondoov1.Boolean  `json:"scanContainerImages"`
+	NamespaceAllowList      []mondoov1.String `json:"namespaceAllowList"`
+	NamespaceDenyList       []mondoov1.String `json:"namespaceDenyList"`
+	Schedule                mondoov1.String   `json:"schedule"`
+}
+
+// ClientIntegrationTypeMsTeams is the integration type of Microsoft Teams notifications.
+const ClientIntegrationTypeMsTeams mondoov1.ClientIntegrationType = "MS_TEAMS"
+
+type MsTeamsConfigurationOptionsInput struct {
+	WebhookUrl *mondoov1.String        `json:"webhookUrl,omitempty"`
+	App        *MsTeamsAppChannelInput `json:"app,omitempty"`
+}
+
+type MsTeamsAppChannelInput struct {
+	TenantId  mondoov1.String `json:"tenantId"`
+	TeamId    mondoov1.String `json:"teamId"`
+	ChannelId mondoov1.String `json:"channelId"`
+}
+
+// ClientIntegrationTypePagerDuty is the integration type of PagerDuty notifications.
+const ClientIntegrationTypePagerDuty mondoov1.ClientIntegrationType = "PAGERDUTY"
+
+type PagerDutyConfigurationOptionsInput struct {
+	RoutingKey      mondoov1.String                 `json:"routingKey"`
+	SeverityMapping []PagerDutySeverityMappingInput `json:"severityMapping"`
+}
+
+type PagerDutySeverityMappingInput struct {
+	Severity          mondoov1.String `json:"severity"`
+	PagerDutySeverity mondoov1.String `json:"pagerDutySeverity"`
+}
+
+// SlackChannelEvent is an event that is posted to a Slack channel.
+type SlackChannelEvent string
+
+const (
+	SlackChannelEventNewFinding  SlackChannelEvent = "NEW_FINDING"
+	SlackChannelEventScanFailure SlackChannelEvent = "SCAN_FAILURE"
+	SlackChannelEventScoreDrop   SlackChannelEvent = "SCORE_DROP"
+)
+
+// SlackConfigurationOptionsInput configures a Slack integration. Channels are only sent when they are
+// managed with Terraform, an empty list removes them.
+type SlackConfigurationOptionsInput struct {
+	SlackToken *mondoov1.String     `json:"slackToken,omitempty"`
+	Channels   *[]SlackChannelInput `json:"channels,omitempty"`
+}
+
+type SlackChannelInput struct {
+	ChannelId         mondoov1.String     `json:"channelId"`
+	SeverityThreshold mondoov1.String     `json:"severityThreshold"`
+	EventTypes        []SlackChannelEvent `json:"eventTypes"`
+	SpaceMrns         []mondoov1.String   `json:"spaceMrns,omitempty"`
+	WorkspaceMrns     []mondoov1.String   `json:"workspaceMrns,omitempty"`
+}
+
+// Case types
+
+type CaseStatus string
+
+const (
+	CaseStatusOpen   CaseStatus = "OPEN"
+	CaseStatusClosed CaseStatus = "CLOSED"
+	CaseStatusError  CaseStatus = "ERROR"
+)
+
+type CaseFindingType string
+
+const (
+	CaseFindingTypeCheck         CaseFindingType = "CHECK"
+	CaseFindingTypeVulnerability CaseFindingType = "VULNERABILITY"
+)
+
+// Input types for case queries and mutations
+
+type CasesInput struct {
+	ScopeMrn mondoov1.String `json:"scopeMrn"`
+	Statuses []CaseStatus    `json:"statuses,omitempty"`
+}
+
+type CaseFindingInput struct {
+	Mrn  mondoov1.String `json:"mrn"`
+	Type CaseFindingType `json:"type"`
+}
+
+type CreateCaseInput struct {
+	ScopeMrn       mondoov1.String    `json:"scopeMrn"`
+	IntegrationMrn mondoov1.String    `json:"integrationMrn"`
+	Title          mondoov1.String    `json:"title"`
+	Notes          *mondoov1.String   `json:"notes,omitempty"`
+	Findings       []CaseFindingInput `json:"findings"`
+}
+
+type UpdateCaseInput struct {
+	Mrn   mondoov1.String  `json:"mrn"`
+	Title mondoov1.String  `json:"title"`
+	Notes *mondoov1.String `json:"notes,omitempty"`
+}
+
+type CloseCaseInput struct {
+	Mrn mondoov1.String `json:"mrn"`
+}
+
+// Payload types for case responses
+
+type CaseFindingPayload struct {
+	Mrn   string `json:"mrn" graphql:"mrn"`
+	Title string `json:"title" graphql:"title"`
+	Type  string `json:"type" graphql:"type"`
+}
+
+type CaseTicketPayload struct {
+	Url string `json:"url" graphql:"url"`
+}
+
+type CasePayload struct {
+	Mrn            string               `json:"mrn" graphql:"mrn"`
+	IntegrationMrn string               `json:"integrationMrn" graphql:"integrationMrn"`
+	Title          string               `json:"title" graphql:"title"`
+	Notes          string               `json:"notes" graphql:"notes"`
+	Status         string               `json:"status" graphql:"status"`
+	CreatedAt      string               `json:"createdAt" graphql:"createdAt"`
+	Findings       []CaseFindingPayload `json:"findings" graphql:"findings"`
+	Tickets        []CaseTicketPayload  `json:"tickets" graphql:"tickets"`
+}
+
+// Case client methods
+
+type casesQuery struct {
+	Cases struct {
+		Edges []struct {
+			Node CasePayload
+		}
+		PageInfo struct {
+			EndCursor   string
+			HasNextPage bool
+		}
+	} `graphql:"cases(input: $input, after: $after)"`
+}
+
+// ListCases returns the cases in the given scope, following pagination until all
+// cases are fetched. If no statuses are given, cases of all statuses are returned.
+func (c *ExtendedGqlClient) ListCases(ctx context.Context, scopeMrn string, statuses []CaseStatus) ([]CasePayload, error) {
+	input := CasesInput{
+		ScopeMrn: mondoov1.String(scopeMrn),
+		Statuses: statuses,
+	}
+	tflog.Trace(ctx, "CasesInput", map[string]interface{}{
+		"input": fmt.Sprintf("%+v", input),
+	})
+
+	cases := []CasePayload{}
+	after := ""
+	for {
+		var q casesQuery
+		variables := map[string]interface{}{
+			"input": input,
+			"after": mondoov1.String(after),
+		}
+		if err := c.Query(ctx, &q, variables); err != nil {
+			return nil, err
+		}
+		for _, edge := range q.Cases.Edges {
+			cases = append(cases, edge.Node)
+		}
+		if !q.Cases.PageInfo.HasNextPage {
+			return cases, nil
+		}
+		after = q.Cases.PageInfo.EndCursor
+	}
+}
+
+func (c *ExtendedGqlClient) GetCase(ctx context.Context, caseMrn string) (CasePayload, error) {
+	var q struct {
+		Case CasePayload `graphql:"case(mrn: $mrn)"`
+	}
+	variables := map[string]interface{}{
+		"mrn": mondoov1.String(caseMrn),
+	}
+
+	err := c.Query(ctx, &q, variables)
+	return q.Case, err
+}
+
+func (c *ExtendedGqlClient) CreateCase(ctx context.Context, input CreateCaseInput) (CasePayload, error) {
+	var mutation struct {
+		CreateCase CasePayload `graphql:"createCase(input: $input)"`
+	}
+
+	tflog.Trace(ctx, "CreateCaseInput", map[string]interface{}{
+		"input": fmt.Sprintf("%+v", input),
+	})
+
+	err := c.Mutate(ctx, &mutation, input, nil)
+	return mutation.CreateCase, err
+}
+
+func (c *ExtendedGqlClient) UpdateCase(ctx context.Context, input UpdateCaseInput) (CasePayload, error) {
+	var mutation struct {
+		UpdateCase CasePayload `graphql:"updateCase(input: $input)"`
+	}
+
+	tflog.Trace(ctx, "UpdateCaseInput", map[string]interface{}{
+		"input": fmt.Sprintf("%+v", input),
+	})
+
+	err := c.Mutate(ctx, &mutation, input, nil)
+	return mutation.UpdateCase, err
+}
+
+func (c *ExtendedGqlClient) CloseCase(ctx context.Context, caseMrn string) error {
+	var mutation struct {
+		CloseCase mondoov1.Boolean `graphql:"closeCase(input: $input)"`
+	}
+
+	input := CloseCaseInput{
+		Mrn: mondoov1.String(caseMrn),
+	}
+	tflog.Trace(ctx, "CloseCaseInput", map[string]interface{}{
+		"input": fmt.Sprintf("%+v", input),
+	})
+
+	return c.Mutate(ctx, &mutation, input, nil)
+}
@@ -4,9 +4,12 @@
 package provider
 
 import (
+	"encoding/json"
+	"reflect"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestIsValidIntegrationMrn(t *testing.T) {
@@ -175,3 +178,38 @@ func TestIntegration_IsSpaceScoped(t *testing.T) {
 		})
 	}
 }
+
+func TestIntegration_SpaceID(t *testing.T) {
+	spaceID, err := Integration{Mrn: "//integration.api.mondoo.app/spaces/my-space/integrations/abc"}.SpaceID()
+	require.NoError(t, err)
+	assert.Equal(t, "my-space", spaceID)
+
+	for _, integrationMrn := range []string{
+		"//integration.api.mondoo.app/organizations/my-org/integrations/abc",
+		"//integration.api.mondoo.app/integrations/abc",
+		"not-an-mrn",
+	} {
+		t.Run(integrationMrn, func(t *testing.T) {
+			_, err := Integration{Mrn: integrationMrn}.SpaceID()
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestSpacesContentsQuery(t *testing.T) {
+	typ := spacesContentsQuery(2)
+	require.Equal(t, 4, typ.NumField())
+	assert.Equal(t, `assets0: assets(spaceMrn: $spaceMrn0)`, typ.Field(0).Tag.Get("graphql"))
+	assert.Equal(t, `integrations0: clientIntegrations(input: $input0)`, typ.Field(1).Tag.Get("graphql"))
+	assert.Equal(t, `assets1: assets(spaceMrn: $spaceMrn1)`, typ.Field(2).Tag.Get("graphql"))
+	assert.Equal(t, `integrations1: clientIntegrations(input: $input1)`, typ.Field(3).Tag.Get("graphql"))
+
+	q := reflect.New(typ)
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"assets0": {"totalCount": 0},
+		"integrations0": {"integrations": []},
+		"assets1": {"totalCount": 3},
+		"integrations1": {"integrations": [{"mrn": "//integration.api.mondoo.app/spaces/my-space/integrations/1"}]}
+	}`), q.Interface()))
+	assert.Equal(t, []spaceContents{{}, {Assets: 3, Integrations: 1}}, spacesContentsFrom(q.Elem()))
+}
@@ -7,6 +7,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
@@ -22,6 +24,9 @@ import (
 )
 
 var _ resource.Resource = (*IAMWorkloadIdentityBindingResource)(nil)
+var _ resource.ResourceWithConfigValidators = (*IAMWorkloadIdentityBindingResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*IAMWorkloadIdentityBindingResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*IAMWorkloadIdentityBindingResource)(nil)
 
 func NewIAMWorkloadIdentityBindingResource() resource.Resource {
 	return &IAMWorkloadIdentityBindingResource{}
@@ -57,6 +62,12 @@ type IAMWorkloadIdentityBindingResourceModel struct {
 	AllowedAudiences types.List `tfsdk:"allowed_audiences"`
 	// List of additional configurations to confirm. (Optional.)
 	Mappings types.Map `tfsdk:"mappings"`
+
+	// Presets that render the issuer, subject, audiences and mappings. (Optional.)
+	GitHubActions *wifGitHubActionsModel `tfsdk:"github_actions"`
+	GitLabCI      *wifGitLabCIModel      `tfsdk:"gitlab_ci"`
+	Azure         *wifAzureModel         `tfsdk:"azure"`
+	GCP           *wifGCPModel           `tfsdk:"gcp"`
 }
 
 func (r *IAMWorkloadIdentityBindingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -113,17 +124,21 @@ func (r *IAMWorkloadIdentityBindingResource) Schema(ctx context.Context, req res
 				},
 			},
 			"issuer_uri": schema.StringAttribute{
-				MarkdownDescription: "URI for the token issuer, e.g. https://accounts.google.com.",
-				Required:            true,
+				MarkdownDescription: "URI for the token issuer, e.g. https://accounts.google.com. Required unless a preset is set.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
 			"subject": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier to confirm.",
-				Required:            true,
+				MarkdownDescription: "Unique identifier to confirm. Required unless a preset is set.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
 			"expiration": schema.Int32Attribute{
@@ -136,7 +151,7 @@ func (r *IAMWorkloadIdentityBindingResource) Schema(ctx context.Context, req res
 				},
 			},
 			"allowed_audiences": schema.ListAttribute{
-				MarkdownDescription: " List of allowed audiences.",
+				MarkdownDescription: "List of allowed audiences. Overrides the audience that a preset renders.",
 				ElementType:         types.StringType,
 				Optional:            true,
 				Computed:            true,
@@ -146,8 +161,9 @@ func (r *IAMWorkloadIdentityBindingResource) Schema(ctx context.Context, req res
 				},
 			},
 			"mappings": schema.MapAttribute{
-				MarkdownDescription: "List of additional configurations to confirm.",
+				MarkdownDescription: "Claims to confirm, as claim names and the values they must have.",
 				Optional:            true,
+				Computed:            true,
 				ElementType:         types.StringType,
 				PlanModifiers: []planmodifier.Map{
 					mapplanmodifier.RequiresReplace(),
@@ -155,9 +171,119 @@ func (r *IAMWorkloadIdentityBindingResource) Schema(ctx context.Context, req res
 				},
 			},
 		},
+		Blocks: wifPresetBlocks(),
+	}
+}
+
+func (r *IAMWorkloadIdentityBindingResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	presets := []path.Expression{
+		path.MatchRoot("github_actions"),
+		path.MatchRoot("gitlab_ci"),
+		path.MatchRoot("azure"),
+		path.MatchRoot("gcp"),
+	}
+
+	validators := []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(append([]path.Expression{path.MatchRoot("issuer_uri")}, presets...)...),
+		resourcevalidator.RequiredTogether(path.MatchRoot("issuer_uri"), path.MatchRoot("subject")),
+	}
+	for _, preset := range presets {
+		validators = append(validators,
+			resourcevalidator.Conflicting(preset, path.MatchRoot("subject")),
+			resourcevalidator.Conflicting(preset, path.MatchRoot("mappings")),
+		)
+	}
+	return validators
+}
+
+func (r *IAMWorkloadIdentityBindingResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data IAMWorkloadIdentityBindingResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.wifPresetConfigured() {
+		if _, _, err := data.renderWIFPreset(); err != nil {
+			resp.Diagnostics.AddError("Invalid Preset", err.Error())
+		}
+		return
+	}
+
+	if !data.IssuerURI.IsUnknown() && !data.Subject.IsUnknown() {
+		if err := validateWIFSubject(data.IssuerURI.ValueString(), data.Subject.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("subject"), "Invalid Subject", err.Error())
+		}
+	}
+
+	if !data.Mappings.IsNull() && !data.Mappings.IsUnknown() {
+		mappings := map[string]string{}
+		resp.Diagnostics.Append(data.Mappings.ElementsAs(ctx, &mappings, true)...)
+		if err := validateWIFMappings(mappings); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("mappings"), "Invalid Mapping", err.Error())
+		}
 	}
 }
 
+// ModifyPlan renders the issuer, subject, audiences and mappings of the configured preset.
+func (r *IAMWorkloadIdentityBindingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, config IAMWorkloadIdentityBindingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || !plan.wifPresetConfigured() {
+		return
+	}
+
+	binding, ok, err := plan.renderWIFPreset()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Preset", err.Error())
+		return
+	}
+
+	if !ok {
+		plan.IssuerURI = types.StringUnknown()
+		plan.Subject = types.StringUnknown()
+		plan.Mappings = types.MapUnknown(types.StringType)
+		if config.AllowedAudiences.IsNull() {
+			plan.AllowedAudiences = types.ListUnknown(types.StringType)
+		}
+	} else {
+		tflog.Debug(ctx, "Rendered workload identity binding preset", map[string]interface{}{
+			"issuer_uri": binding.IssuerURI,
+			"subject":    binding.Subject,
+		})
+		plan.IssuerURI = types.StringValue(binding.IssuerURI)
+		plan.Subject = types.StringValue(binding.Subject)
+		plan.Mappings = ConvertMapValue(binding.Mappings)
+		if config.AllowedAudiences.IsNull() && len(binding.Audiences) != 0 {
+			plan.AllowedAudiences = ConvertListValue(binding.Audiences)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// flattenKeyValues converts key-value pairs returned by the API to a map, it returns a null map
+// if there are none.
+func flattenKeyValues(keyValues []KeyValue) types.Map {
+	if len(keyValues) == 0 {
+		return types.MapNull(types.StringType)
+	}
+
+	m := make(map[string]string, len(keyValues))
+	for _, kv := range keyValues {
+		m[kv.Key] = kv.Value
+	}
+	return ConvertMapValue(m)
+}
+
 func (r *IAMWorkloadIdentityBindingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -236,15 +362,17 @@ func (r *IAMWorkloadIdentityBindingResource) Create(ctx context.Context, req res
 	)
 
 	var mappings []mondoov1.KeyValueInput
-	mappingsMap, d := data.Mappings.ToMapValue(context.Background())
-	resp.Diagnostics.Append(d...)
-	if resp.Diagnostics.HasError() {
-		return
+	mappingsMap := map[string]string{}
+	if !data.Mappings.IsNull() && !data.Mappings.IsUnknown() {
+		resp.Diagnostics.Append(data.Mappings.ElementsAs(ctx, &mappingsMap, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
-	for key, value := range mappingsMap.Elements() {
+	for key, value := range mappingsMap {
 		mappings = append(mappings, mondoov1.KeyValueInput{
 			Key:   mondoov1.String(key),
-			Value: mondoov1.NewStringPtr(mondoov1.String(value.String())),
+			Value: mondoov1.NewStringPtr(mondoov1.String(value)),
 		})
 	}
 
@@ -294,9 +422,15 @@ func (r *IAMWorkloadIdentityBindingResource) Create(ctx context.Context, req res
 	data.AllowedAudiences = ConvertListValue(createMutation.CreateIAMWorkloadIdentityBinding.Binding.AllowedAudiences)
 	data.ScopeMRN = types.StringValue(scopeMRN)
 	data.Expiration = types.Int32Value(createMutation.CreateIAMWorkloadIdentityBinding.Binding.Expiration)
-	if len(createMutation.CreateIAMWorkloadIdentityBinding.Binding.Mappings) != 0 {
-		newMappings, _ := types.MapValueFrom(context.Background(), types.StringType, createMutation.CreateIAMWorkloadIdentityBinding.Binding.Mappings)
-		data.Mappings = newMappings
+	// values that a preset couldn't render at plan time
+	if data.IssuerURI.IsUnknown() {
+		data.IssuerURI = types.StringValue(createMutation.CreateIAMWorkloadIdentityBinding.Binding.IssuerURI)
+	}
+	if data.Subject.IsUnknown() {
+		data.Subject = types.StringValue(createMutation.CreateIAMWorkloadIdentityBinding.Binding.Subject)
+	}
+	if data.Mappings.IsUnknown() {
+		data.Mappings = flattenKeyValues(createMutation.CreateIAMWorkloadIdentityBinding.Binding.Mappings)
 	}
 
 	// Save data into Terraform state
@@ -337,7 +471,7 @@ func (r *IAMWorkloadIdentityBindingResource) readIAMWorkloadIdentityBinding(ctx
 		Expiration:       types.Int32Value(q.IAMWorkloadIdentityBinding.Binding.Expiration),
 		Roles:            ConvertListValue(q.IAMWorkloadIdentityBinding.Binding.Roles),
 		AllowedAudiences: ConvertListValue(q.IAMWorkloadIdentityBinding.Binding.AllowedAudiences),
-		Mappings:         types.MapNull(types.StringType),
+		Mappings:         flattenKeyValues(q.IAMWorkloadIdentityBinding.Binding.Mappings),
 	}, nil
 }
 
@@ -360,6 +494,12 @@ func (r *IAMWorkloadIdentityBindingResource) Read(ctx context.Context, req resou
 		return
 	}
 
+	// The presets are only known to Terraform
+	m.GitHubActions = data.GitHubActions
+	m.GitLabCI = data.GitLabCI
+	m.Azure = data.Azure
+	m.GCP = data.GCP
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &m)...)
 }
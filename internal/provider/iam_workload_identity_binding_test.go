@@ -5,10 +5,14 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAccIAMWorkloadIdentityBindingResource(t *testing.T) {
@@ -57,3 +61,150 @@ resource "mondoo_iam_workload_identity_binding" "test" {
 }
 `, spaceID, name, issuerURI, subject)
 }
+
+func TestAccIAMWorkloadIdentityBindingResourcePresets(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIAMWorkloadIdentityBindingResourceGitHubConfig(accSpace.ID(), "production"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mondoo_iam_workload_identity_binding.github", "issuer_uri", "https://token.actions.githubusercontent.com"),
+					resource.TestCheckResourceAttr("mondoo_iam_workload_identity_binding.github", "subject", "repo:mondoohq/server:environment:production"),
+					resource.TestCheckResourceAttr("mondoo_iam_workload_identity_binding.github", "allowed_audiences.0", "https://github.com/mondoohq"),
+					resource.TestCheckResourceAttr("mondoo_iam_workload_identity_binding.github", "mappings.repository", "mondoohq/server"),
+					resource.TestCheckResourceAttr("mondoo_iam_workload_identity_binding.github", "mappings.ref", "refs/heads/main"),
+				),
+			},
+			// Changing the preset replaces the binding
+			{
+				Config: testAccIAMWorkloadIdentityBindingResourceGitHubConfig(accSpace.ID(), "staging"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mondoo_iam_workload_identity_binding.github", "subject", "repo:mondoohq/server:environment:staging"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+provider "mondoo" {
+  space = %[1]q
+}
+
+resource "mondoo_iam_workload_identity_binding" "github" {
+  name       = "github"
+  issuer_uri = "https://token.actions.githubusercontent.com"
+  subject    = "mondoohq/server:main"
+}
+`, accSpace.ID()),
+				ExpectError: regexp.MustCompile("GitHub Actions subjects have the form"),
+			},
+			{
+				Config: fmt.Sprintf(`
+provider "mondoo" {
+  space = %[1]q
+}
+
+resource "mondoo_iam_workload_identity_binding" "github" {
+  name = "github"
+
+  github_actions {
+    repository = "mondoohq/server"
+  }
+}
+`, accSpace.ID()),
+				ExpectError: regexp.MustCompile("github_actions requires ref or environment"),
+			},
+		},
+	})
+}
+
+func testAccIAMWorkloadIdentityBindingResourceGitHubConfig(spaceID, environment string) string {
+	return fmt.Sprintf(`
+provider "mondoo" {
+  space = %[1]q
+}
+
+resource "mondoo_iam_workload_identity_binding" "github" {
+  name = "github"
+
+  github_actions {
+    repository  = "mondoohq/server"
+    ref         = "refs/heads/main"
+    environment = %[2]q
+  }
+}
+`, spaceID, environment)
+}
+
+func TestRenderWIFPresets(t *testing.T) {
+	binding, ok, err := renderGitHubActionsBinding("mondoohq/server", "refs/heads/main", "")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, wifBinding{
+		IssuerURI: "https://token.actions.githubusercontent.com",
+		Subject:   "repo:mondoohq/server:ref:refs/heads/main",
+		Audiences: []string{"https://github.com/mondoohq"},
+		Mappings:  map[string]string{"repository": "mondoohq/server"},
+	}, binding)
+
+	binding, _, err = renderGitHubActionsBinding("mondoohq/server", "refs/heads/main", "production")
+	require.NoError(t, err)
+	assert.Equal(t, "repo:mondoohq/server:environment:production", binding.Subject)
+	assert.Equal(t, map[string]string{"repository": "mondoohq/server", "ref": "refs/heads/main"}, binding.Mappings)
+
+	_, _, err = renderGitHubActionsBinding("mondoohq/server", "", "")
+	assert.Error(t, err)
+
+	binding, _, err = renderGitLabCIBinding("my-group/my-project", "branch", "main", "")
+	require.NoError(t, err)
+	assert.Equal(t, "https://gitlab.com", binding.IssuerURI)
+	assert.Equal(t, "project_path:my-group/my-project:ref_type:branch:ref:main", binding.Subject)
+	assert.Equal(t, []string{"https://gitlab.com"}, binding.Audiences)
+
+	binding, _, err = renderGitLabCIBinding("my-group/my-project", "tag", "v1.0.0", "https://gitlab.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "https://gitlab.example.com", binding.IssuerURI)
+
+	binding, _, err = renderAzureBinding("11111111-2222-3333-4444-555555555555", "66666666-7777-8888-9999-000000000000")
+	require.NoError(t, err)
+	assert.Equal(t, "https://login.microsoftonline.com/11111111-2222-3333-4444-555555555555/v2.0", binding.IssuerURI)
+	assert.Equal(t, "66666666-7777-8888-9999-000000000000", binding.Subject)
+
+	binding, _, err = renderGCPBinding("123456789012345678901", "")
+	require.NoError(t, err)
+	assert.Equal(t, "https://accounts.google.com", binding.IssuerURI)
+	assert.Empty(t, binding.Audiences)
+	assert.Empty(t, binding.Mappings)
+}
+
+func TestRenderWIFPresetUnknown(t *testing.T) {
+	data := IAMWorkloadIdentityBindingResourceModel{
+		GitHubActions: &wifGitHubActionsModel{
+			Repository:  types.StringUnknown(),
+			Ref:         types.StringValue("refs/heads/main"),
+			Environment: types.StringNull(),
+		},
+	}
+	assert.True(t, data.wifPresetConfigured())
+
+	_, ok, err := data.renderWIFPreset()
+	require.NoError(t, err)
+	assert.False(t, ok, "the preset can't be rendered before the repository is known")
+}
+
+func TestValidateWIFSubject(t *testing.T) {
+	assert.NoError(t, validateWIFSubject("https://token.actions.githubusercontent.com", "repo:mondoohq/server:ref:refs/heads/main"))
+	assert.NoError(t, validateWIFSubject("https://token.actions.githubusercontent.com", "repo:mondoohq/server:environment:prod"))
+	assert.NoError(t, validateWIFSubject("https://token.actions.githubusercontent.com", "repo:mondoohq/server:pull_request"))
+	assert.Error(t, validateWIFSubject("https://token.actions.githubusercontent.com", "repo:mondoohq/server:ref:main"))
+	assert.NoError(t, validateWIFSubject("https://gitlab.com", "project_path:group/project:ref_type:branch:ref:main"))
+	assert.Error(t, validateWIFSubject("https://gitlab.com", "project_path:group/project:ref:main"))
+	assert.Error(t, validateWIFSubject("https://accounts.google.com", "sa@project.iam.gserviceaccount.com"))
+	assert.NoError(t, validateWIFSubject("https://issuer.example.com", "anything"))
+}
+
+func TestValidateWIFMappings(t *testing.T) {
+	assert.NoError(t, validateWIFMappings(map[string]string{"repository": "mondoohq/server", "job_workflow_ref": "x"}))
+	assert.Error(t, validateWIFMappings(map[string]string{"repository owner": "mondoohq"}))
+	assert.Error(t, validateWIFMappings(map[string]string{"ref": ""}))
+}
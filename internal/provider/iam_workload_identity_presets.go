@@ -0,0 +1,311 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	githubActionsIssuer = "https://token.actions.githubusercontent.com"
+	gitlabIssuer        = "https://gitlab.com"
+	googleIssuer        = "https://accounts.google.com"
+	azureAudience       = "api://AzureADTokenExchange"
+)
+
+var (
+	githubRepositoryPattern = regexp.MustCompile(`^[A-Za-z0-9_.-]+/[A-Za-z0-9_.-]+$`)
+	githubSubjectPattern    = regexp.MustCompile(`^repo:[A-Za-z0-9_.-]+/[A-Za-z0-9_.-]+:(ref:refs/.+|environment:.+|pull_request|job_workflow_ref:.+)$`)
+	gitlabProjectPattern    = regexp.MustCompile(`^[A-Za-z0-9_.-]+(/[A-Za-z0-9_.-]+)+$`)
+	gitlabSubjectPattern    = regexp.MustCompile(`^project_path:[^:]+:ref_type:(branch|tag):ref:.+$`)
+	uuidPattern             = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	googleUniqueIDPattern   = regexp.MustCompile(`^[0-9]{21}$`)
+	claimNamePattern        = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.:-]*$`)
+)
+
+type wifGitHubActionsModel struct {
+	Repository  types.String `tfsdk:"repository"`
+	Ref         types.String `tfsdk:"ref"`
+	Environment types.String `tfsdk:"environment"`
+}
+
+type wifGitLabCIModel struct {
+	ProjectPath types.String `tfsdk:"project_path"`
+	RefType     types.String `tfsdk:"ref_type"`
+	Ref         types.String `tfsdk:"ref"`
+	GitLabURL   types.String `tfsdk:"gitlab_url"`
+}
+
+type wifAzureModel struct {
+	TenantID types.String `tfsdk:"tenant_id"`
+	ObjectID types.String `tfsdk:"object_id"`
+}
+
+type wifGCPModel struct {
+	ServiceAccountUniqueID types.String `tfsdk:"service_account_unique_id"`
+	ServiceAccountEmail    types.String `tfsdk:"service_account_email"`
+}
+
+// wifBinding holds the issuer, subject, audiences and claim mappings that a preset renders.
+type wifBinding struct {
+	IssuerURI string
+	Subject   string
+	Audiences []string
+	Mappings  map[string]string
+}
+
+// wifPresetBlock returns the schema of a preset block, changing a preset replaces the binding.
+func wifPresetBlock(description string, attributes map[string]schema.Attribute) schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		MarkdownDescription: description + " Conflicts with `issuer_uri`, `subject` and `mappings`.",
+		Attributes:          attributes,
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+func wifPresetBlocks() map[string]schema.Block {
+	return map[string]schema.Block{
+		"github_actions": wifPresetBlock("Trust the OIDC tokens of GitHub Actions workflows.", map[string]schema.Attribute{
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "Repository that runs the workflow, in the form `owner/name`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(githubRepositoryPattern, "must be in the form owner/name"),
+				},
+			},
+			"ref": schema.StringAttribute{
+				MarkdownDescription: "Git ref the workflow runs for, e.g. `refs/heads/main` or `refs/tags/v1.0.0`. Required unless `environment` is set.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^refs/(heads|tags|pull)/.+$`), "must be a full Git ref such as refs/heads/main"),
+				},
+			},
+			"environment": schema.StringAttribute{
+				MarkdownDescription: "GitHub environment of the job. If `ref` is set as well, it's confirmed with the `ref` claim.",
+				Optional:            true,
+			},
+		}),
+		"gitlab_ci": wifPresetBlock("Trust the ID tokens of GitLab CI/CD jobs.", map[string]schema.Attribute{
+			"project_path": schema.StringAttribute{
+				MarkdownDescription: "Path of the project that runs the job, e.g. `my-group/my-project`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(gitlabProjectPattern, "must be in the form group/project"),
+				},
+			},
+			"ref_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the ref the job runs for, either `branch` or `tag`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("branch", "tag"),
+				},
+			},
+			"ref": schema.StringAttribute{
+				MarkdownDescription: "Name of the branch or tag the job runs for, e.g. `main`.",
+				Optional:            true,
+			},
+			"gitlab_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the GitLab instance, which issues the tokens. Defaults to `https://gitlab.com`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^https://[^/]+(/.*[^/])?$`), "must be an https URL without a trailing slash"),
+				},
+			},
+		}),
+		"azure": wifPresetBlock("Trust the tokens of an Azure managed identity or app registration.", map[string]schema.Attribute{
+			"tenant_id": schema.StringAttribute{
+				MarkdownDescription: "Microsoft Entra ID tenant of the identity.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidPattern, "must be a UUID"),
+				},
+			},
+			"object_id": schema.StringAttribute{
+				MarkdownDescription: "Object (principal) ID of the managed identity or service principal.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidPattern, "must be a UUID"),
+				},
+			},
+		}),
+		"gcp": wifPresetBlock("Trust the identity tokens of a Google Cloud service account.", map[string]schema.Attribute{
+			"service_account_unique_id": schema.StringAttribute{
+				MarkdownDescription: "Unique ID of the service account, the 21 digit `sub` claim of its tokens.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(googleUniqueIDPattern, "must be the 21 digit unique ID of the service account, not its email"),
+				},
+			},
+			"service_account_email": schema.StringAttribute{
+				MarkdownDescription: "Email of the service account. If set, it's confirmed with the `email` claim.",
+				Optional:            true,
+			},
+		}),
+	}
+}
+
+// wifPresetConfigured returns whether any preset block is set.
+func (m IAMWorkloadIdentityBindingResourceModel) wifPresetConfigured() bool {
+	return m.GitHubActions != nil || m.GitLabCI != nil || m.Azure != nil || m.GCP != nil
+}
+
+// renderWIFPreset returns the binding that the configured preset renders. It returns false if no
+// preset is configured or if it depends on values that are not known yet.
+func (m IAMWorkloadIdentityBindingResourceModel) renderWIFPreset() (wifBinding, bool, error) {
+	switch {
+	case m.GitHubActions != nil:
+		p := m.GitHubActions
+		if !allKnown(p.Repository, p.Ref, p.Environment) {
+			return wifBinding{}, false, nil
+		}
+		return renderGitHubActionsBinding(p.Repository.ValueString(), p.Ref.ValueString(), p.Environment.ValueString())
+	case m.GitLabCI != nil:
+		p := m.GitLabCI
+		if !allKnown(p.ProjectPath, p.RefType, p.Ref, p.GitLabURL) {
+			return wifBinding{}, false, nil
+		}
+		return renderGitLabCIBinding(p.ProjectPath.ValueString(), p.RefType.ValueString(), p.Ref.ValueString(), p.GitLabURL.ValueString())
+	case m.Azure != nil:
+		p := m.Azure
+		if !allKnown(p.TenantID, p.ObjectID) {
+			return wifBinding{}, false, nil
+		}
+		return renderAzureBinding(p.TenantID.ValueString(), p.ObjectID.ValueString())
+	case m.GCP != nil:
+		p := m.GCP
+		if !allKnown(p.ServiceAccountUniqueID, p.ServiceAccountEmail) {
+			return wifBinding{}, false, nil
+		}
+		return renderGCPBinding(p.ServiceAccountUniqueID.ValueString(), p.ServiceAccountEmail.ValueString())
+	}
+	return wifBinding{}, false, nil
+}
+
+func allKnown(values ...types.String) bool {
+	for _, v := range values {
+		if v.IsUnknown() {
+			return false
+		}
+	}
+	return true
+}
+
+func renderGitHubActionsBinding(repository, ref, environment string) (wifBinding, bool, error) {
+	if repository == "" {
+		return wifBinding{}, false, fmt.Errorf("github_actions requires repository")
+	}
+	if ref == "" && environment == "" {
+		return wifBinding{}, false, fmt.Errorf("github_actions requires ref or environment, GitHub includes one of them in the subject")
+	}
+
+	binding := wifBinding{
+		IssuerURI: githubActionsIssuer,
+		Audiences: []string{"https://github.com/" + strings.Split(repository, "/")[0]},
+		Mappings:  map[string]string{"repository": repository},
+	}
+	if environment != "" {
+		// the subject of jobs that reference an environment contains the environment instead of the ref
+		binding.Subject = fmt.Sprintf("repo:%s:environment:%s", repository, environment)
+		if ref != "" {
+			binding.Mappings["ref"] = ref
+		}
+	} else {
+		binding.Subject = fmt.Sprintf("repo:%s:ref:%s", repository, ref)
+	}
+	return binding, true, nil
+}
+
+func renderGitLabCIBinding(projectPath, refType, ref, gitlabURL string) (wifBinding, bool, error) {
+	if projectPath == "" || refType == "" || ref == "" {
+		return wifBinding{}, false, fmt.Errorf("gitlab_ci requires project_path, ref_type and ref")
+	}
+	if gitlabURL == "" {
+		gitlabURL = gitlabIssuer
+	}
+
+	return wifBinding{
+		IssuerURI: gitlabURL,
+		Subject:   fmt.Sprintf("project_path:%s:ref_type:%s:ref:%s", projectPath, refType, ref),
+		Audiences: []string{gitlabURL},
+		Mappings: map[string]string{
+			"project_path": projectPath,
+			"ref_type":     refType,
+		},
+	}, true, nil
+}
+
+func renderAzureBinding(tenantID, objectID string) (wifBinding, bool, error) {
+	if tenantID == "" || objectID == "" {
+		return wifBinding{}, false, fmt.Errorf("azure requires tenant_id and object_id")
+	}
+
+	return wifBinding{
+		IssuerURI: fmt.Sprintf("https://login.microsoftonline.com/%s/v2.0", tenantID),
+		Subject:   objectID,
+		Audiences: []string{azureAudience},
+		Mappings: map[string]string{
+			"tid": tenantID,
+			"oid": objectID,
+		},
+	}, true, nil
+}
+
+func renderGCPBinding(uniqueID, email string) (wifBinding, bool, error) {
+	if uniqueID == "" {
+		return wifBinding{}, false, fmt.Errorf("gcp requires service_account_unique_id")
+	}
+
+	binding := wifBinding{
+		IssuerURI: googleIssuer,
+		Subject:   uniqueID,
+		Mappings:  map[string]string{},
+	}
+	if email != "" {
+		binding.Mappings["email"] = email
+	}
+	return binding, true, nil
+}
+
+// validateWIFSubject checks the subject against the format of well-known issuers.
+func validateWIFSubject(issuerURI, subject string) error {
+	switch issuerURI {
+	case githubActionsIssuer:
+		if !githubSubjectPattern.MatchString(subject) {
+			return fmt.Errorf("GitHub Actions subjects have the form repo:<owner>/<name>:ref:<ref> or repo:<owner>/<name>:environment:<environment>, got: %s", subject)
+		}
+	case gitlabIssuer:
+		if !gitlabSubjectPattern.MatchString(subject) {
+			return fmt.Errorf("GitLab CI subjects have the form project_path:<group>/<project>:ref_type:<branch|tag>:ref:<ref>, got: %s", subject)
+		}
+	case googleIssuer:
+		if !googleUniqueIDPattern.MatchString(subject) {
+			return fmt.Errorf("Google subjects are the 21 digit unique ID of the service account, got: %s", subject)
+		}
+	}
+	return nil
+}
+
+// validateWIFMappings checks that every mapping confirms a claim name with a value.
+func validateWIFMappings(mappings map[string]string) error {
+	for claim, value := range mappings {
+		if !claimNamePattern.MatchString(claim) {
+			return fmt.Errorf("mapping %q is not a valid claim name", claim)
+		}
+		if value == "" {
+			return fmt.Errorf("mapping %q must have a value to confirm", claim)
+		}
+	}
+	return nil
+}
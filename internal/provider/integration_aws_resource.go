@@ -368,14 +368,14 @@ func (r *integrationAwsResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *integrationAwsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	integration, ok := r.client.ImportIntegration(ctx, req, resp)
+	integration, spaceID, ok := r.client.ImportSpaceIntegration(ctx, req, resp)
 	if !ok {
 		return
 	}
 
 	opts := integration.ConfigurationOptions.HostedAwsConfigurationOptions
 	model := integrationAwsResourceModel{
-		SpaceID:    types.StringValue(integration.SpaceID()),
+		SpaceID:    types.StringValue(spaceID),
 		Mrn:        types.StringValue(integration.Mrn),
 		Name:       types.StringValue(integration.Name),
 		WifSubject: types.StringValue(opts.WifSubject),
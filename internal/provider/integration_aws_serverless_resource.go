@@ -665,7 +665,7 @@ func (r *integrationAwsServerlessResource) Delete(ctx context.Context, req resou
 }
 
 func (r *integrationAwsServerlessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	integration, ok := r.client.ImportIntegration(ctx, req, resp)
+	integration, spaceID, ok := r.client.ImportSpaceIntegration(ctx, req, resp)
 	if !ok {
 		return
 	}
@@ -673,7 +673,7 @@ func (r *integrationAwsServerlessResource) ImportState(ctx context.Context, req
 	model := integrationAwsServerlessResourceModel{
 		Mrn:     types.StringValue(integration.Mrn),
 		Name:    types.StringValue(integration.Name),
-		SpaceID: types.StringValue(integration.SpaceID()),
+		SpaceID: types.StringValue(spaceID),
 	}
 
 	resp.State.Set(ctx, &model)
@@ -275,14 +275,14 @@ func (r *integrationAzureDevopsResource) Delete(ctx context.Context, req resourc
 }
 
 func (r *integrationAzureDevopsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	integration, ok := r.client.ImportIntegration(ctx, req, resp)
+	integration, spaceID, ok := r.client.ImportSpaceIntegration(ctx, req, resp)
 	if !ok {
 		return
 	}
 	model := integrationAzureDevopsResourceModel{
 		Mrn:     types.StringValue(integration.Mrn),
 		Name:    types.StringValue(integration.Name),
-		SpaceID: types.StringValue(integration.SpaceID()),
+		SpaceID: types.StringValue(spaceID),
 		// AzureDevops options
 		AutoCloseTickets:   types.BoolValue(integration.ConfigurationOptions.AzureDevopsConfigurationOptions.AutoCloseTickets),
 		AutoCreateTickets:  types.BoolValue(integration.ConfigurationOptions.AzureDevopsConfigurationOptions.AutoCreateTickets),
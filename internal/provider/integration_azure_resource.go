@@ -325,7 +325,7 @@ func (r *integrationAzureResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *integrationAzureResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	integration, ok := r.client.ImportIntegration(ctx, req, resp)
+	integration, spaceID, ok := r.client.ImportSpaceIntegration(ctx, req, resp)
 	if !ok {
 		return
 	}
@@ -334,7 +334,7 @@ func (r *integrationAzureResource) ImportState(ctx context.Context, req resource
 	denyList := ConvertListValue(integration.ConfigurationOptions.AzureConfigurationOptions.SubscriptionsBlacklist)
 
 	model := integrationAzureResourceModel{
-		SpaceID:               types.StringValue(integration.SpaceID()),
+		SpaceID:               types.StringValue(spaceID),
 		Mrn:                   types.StringValue(integration.Mrn),
 		Name:                  types.StringValue(integration.Name),
 		ClientId:              types.StringValue(integration.ConfigurationOptions.AzureConfigurationOptions.ClientId),
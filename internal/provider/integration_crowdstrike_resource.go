@@ -247,7 +247,7 @@ func (r *integrationCrowdstrikeResource) Delete(ctx context.Context, req resourc
 }
 
 func (r *integrationCrowdstrikeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	integration, ok := r.client.ImportIntegration(ctx, req, resp)
+	integration, spaceID, ok := r.client.ImportSpaceIntegration(ctx, req, resp)
 	if !ok {
 		return
 	}
@@ -255,7 +255,7 @@ func (r *integrationCrowdstrikeResource) ImportState(ctx context.Context, req re
 	model := integrationCrowdstrikeResourceModel{
 		Mrn:          types.StringValue(integration.Mrn),
 		Name:         types.StringValue(integration.Name),
-		SpaceID:      types.StringValue(integration.SpaceID()),
+		SpaceID:      types.StringValue(spaceID),
 		ClientId:     types.StringValue(integration.ConfigurationOptions.CrowdstrikeFalconConfigurationOptions.ClientId),
 		ClientSecret: types.StringPointerValue(nil),
 		Cloud:        types.StringValue(integration.ConfigurationOptions.CrowdstrikeFalconConfigurationOptions.Cloud),
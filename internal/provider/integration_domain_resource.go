@@ -242,9 +242,14 @@ func (r *integrationDomainResource) Read(ctx context.Context, req resource.ReadR
 		resp.State.RemoveResource(ctx)
 		return
 	}
+	spaceID, err := integration.SpaceID()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read domain integration. Got error: %s", err))
+		return
+	}
 
 	model := integrationDomainResourceModel{
-		SpaceID: types.StringValue(integration.SpaceID()),
+		SpaceID: types.StringValue(spaceID),
 		Mrn:     types.StringValue(integration.Mrn),
 		Host:    types.StringValue(integration.ConfigurationOptions.HostConfigurationOptions.Host),
 		Https:   types.BoolValue(integration.ConfigurationOptions.HostConfigurationOptions.HTTPS),
@@ -316,13 +321,13 @@ func (r *integrationDomainResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *integrationDomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	integration, ok := r.client.ImportIntegration(ctx, req, resp)
+	integration, spaceID, ok := r.client.ImportSpaceIntegration(ctx, req, resp)
 	if !ok {
 		return
 	}
 
 	model := integrationDomainResourceModel{
-		SpaceID: types.StringValue(integration.SpaceID()),
+		SpaceID: types.StringValue(spaceID),
 		Mrn:     types.StringValue(integration.Mrn),
 		Host:    types.StringValue(integration.ConfigurationOptions.HostConfigurationOptions.Host),
 		Https:   types.BoolValue(integration.ConfigurationOptions.HostConfigurationOptions.HTTPS),
@@ -427,7 +427,7 @@ func (r *integrationEmailResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *integrationEmailResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	integration, ok := r.client.ImportIntegration(ctx, req, resp)
+	integration, spaceID, ok := r.client.ImportSpaceIntegration(ctx, req, resp)
 	if !ok {
 		return
 	}
@@ -445,7 +445,7 @@ func (r *integrationEmailResource) ImportState(ctx context.Context, req resource
 	model := integrationEmailResourceModel{
 		Mrn:               types.StringValue(integration.Mrn),
 		Name:              types.StringValue(integration.Name),
-		SpaceID:           types.StringValue(integration.SpaceID()),
+		SpaceID:           types.StringValue(spaceID),
 		AutoCreateTickets: types.BoolValue(integration.ConfigurationOptions.EmailConfigurationOptions.AutoCreateTickets),
 		AutoCloseTickets:  types.BoolValue(integration.ConfigurationOptions.EmailConfigurationOptions.AutoCloseTickets),
 		Recipients:        &recipients,
@@ -331,7 +331,7 @@ func (r *integrationGcpResource) Delete(ctx context.Context, req resource.Delete
 
 func (r *integrationGcpResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 
-	integration, ok := r.client.ImportIntegration(ctx, req, resp)
+	integration, spaceID, ok := r.client.ImportSpaceIntegration(ctx, req, resp)
 	if !ok {
 		return
 	}
@@ -340,7 +340,7 @@ func (r *integrationGcpResource) ImportState(ctx context.Context, req resource.I
 	model := integrationGcpResourceModel{
 		Mrn:        types.StringValue(integration.Mrn),
 		Name:       types.StringValue(integration.Name),
-		SpaceID:    types.StringValue(integration.SpaceID()),
+		SpaceID:    types.StringValue(spaceID),
 		ProjectId:  types.StringValue(opts.ProjectId),
 		WifSubject: types.StringValue(opts.WifSubject),
 		Credential: integrationGcpCredentialModel{
@@ -356,7 +356,7 @@ func (r *integrationGithubResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *integrationGithubResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	integration, ok := r.client.ImportIntegration(ctx, req, resp)
+	integration, spaceID, ok := r.client.ImportSpaceIntegration(ctx, req, resp)
 	if !ok {
 		return
 	}
@@ -367,7 +367,7 @@ func (r *integrationGithubResource) ImportState(ctx context.Context, req resourc
 	model := integrationGithubResourceModel{
 		Mrn:                 types.StringValue(integration.Mrn),
 		Name:                types.StringValue(integration.Name),
-		SpaceID:             types.StringValue(integration.SpaceID()),
+		SpaceID:             types.StringValue(spaceID),
 		Owner:               types.StringValue(integration.ConfigurationOptions.GithubConfigurationOptions.Owner),
 		Repository:          types.StringValue(integration.ConfigurationOptions.GithubConfigurationOptions.Repository),
 		RepositoryAllowList: allowList,
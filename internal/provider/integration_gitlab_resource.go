@@ -308,7 +308,7 @@ func (r *integrationGitlabResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *integrationGitlabResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	integration, ok := r.client.ImportIntegration(ctx, req, resp)
+	integration, spaceID, ok := r.client.ImportSpaceIntegration(ctx, req, resp)
 	if !ok {
 		return
 	}
@@ -316,7 +316,7 @@ func (r *integrationGitlabResource) ImportState(ctx context.Context, req resourc
 	model := integrationGitlabResourceModel{
 		Mrn:     types.StringValue(integration.Mrn),
 		Name:    types.StringValue(integration.Name),
-		SpaceID: types.StringValue(integration.SpaceID()),
+		SpaceID: types.StringValue(spaceID),
 		Group:   types.StringValue(integration.ConfigurationOptions.GitlabConfigurationOptions.Group),
 		BaseUrl: types.StringValue(integration.ConfigurationOptions.GitlabConfigurationOptions.BaseUrl),
 		Discovery: &integrationGitlabDiscoveryModel{
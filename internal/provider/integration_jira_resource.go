@@ -6,9 +6,13 @@ package provider
 import (
 	"context"
 	"fmt"
+	"io"
 	"regexp"
+	"text/template"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -22,6 +26,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = (*integrationJiraResource)(nil)
 var _ resource.ResourceWithImportState = (*integrationJiraResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*integrationJiraResource)(nil)
 
 func NewIntegrationJiraResource() resource.Resource {
 	return &integrationJiraResource{}
@@ -45,10 +50,24 @@ type integrationJiraResourceModel struct {
 	AutoCreate     types.Bool   `tfsdk:"auto_create"`
 	AutoClose      types.Bool   `tfsdk:"auto_close"`
 
+	// Issue fields
+	IssueType           types.String                      `tfsdk:"issue_type"`
+	Labels              types.List                        `tfsdk:"labels"`
+	Components          types.List                        `tfsdk:"components"`
+	PriorityMapping     types.Map                         `tfsdk:"priority_mapping"`
+	CustomFields        []integrationJiraCustomFieldModel `tfsdk:"custom_fields"`
+	SummaryTemplate     types.String                      `tfsdk:"summary_template"`
+	DescriptionTemplate types.String                      `tfsdk:"description_template"`
+
 	// credentials
 	Credential *integrationJiraCredentialModel `tfsdk:"credentials"`
 }
 
+type integrationJiraCustomFieldModel struct {
+	ID    types.String `tfsdk:"id"`
+	Value types.String `tfsdk:"value"`
+}
+
 type integrationJiraCredentialModel struct {
 	Token types.String `tfsdk:"token"`
 }
@@ -57,17 +76,135 @@ func (r *integrationJiraResource) Metadata(ctx context.Context, req resource.Met
 	resp.TypeName = req.ProviderTypeName + "_integration_jira"
 }
 
-func (m integrationJiraResourceModel) GetConfigurationOptions() *mondoov1.JiraConfigurationOptionsInput {
-	opts := &mondoov1.JiraConfigurationOptionsInput{
-		Host:             mondoov1.String(m.Host.ValueString()),
-		Email:            mondoov1.String(m.Email.ValueString()),
-		ApiToken:         mondoov1.String(m.Credential.Token.ValueString()),
-		DefaultProject:   mondoov1.String(m.DefaultProject.ValueString()),
-		AutoCreateCases:  mondoov1.NewBooleanPtr(mondoov1.Boolean(m.AutoCreate.ValueBool())),
-		AutoCloseTickets: mondoov1.NewBooleanPtr(mondoov1.Boolean(m.AutoClose.ValueBool())),
+func (m integrationJiraResourceModel) GetConfigurationOptions(ctx context.Context) (*JiraConfigurationOptionsInput, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+	opts := &JiraConfigurationOptionsInput{
+		JiraConfigurationOptionsInput: mondoov1.JiraConfigurationOptionsInput{
+			Host:             mondoov1.String(m.Host.ValueString()),
+			Email:            mondoov1.String(m.Email.ValueString()),
+			ApiToken:         mondoov1.String(m.Credential.Token.ValueString()),
+			DefaultProject:   mondoov1.String(m.DefaultProject.ValueString()),
+			AutoCreateCases:  mondoov1.NewBooleanPtr(mondoov1.Boolean(m.AutoCreate.ValueBool())),
+			AutoCloseTickets: mondoov1.NewBooleanPtr(mondoov1.Boolean(m.AutoClose.ValueBool())),
+		},
+	}
+
+	// only the configured issue fields are sent
+	opts.IssueType = (*mondoov1.String)(m.IssueType.ValueStringPointer())
+	opts.SummaryTemplate = (*mondoov1.String)(m.SummaryTemplate.ValueStringPointer())
+	opts.DescriptionTemplate = (*mondoov1.String)(m.DescriptionTemplate.ValueStringPointer())
+	if !m.Labels.IsNull() {
+		opts.Labels = ToPtr(append([]mondoov1.String{}, ConvertSliceStrings(m.Labels)...))
+	}
+	if !m.Components.IsNull() {
+		opts.Components = ToPtr(append([]mondoov1.String{}, ConvertSliceStrings(m.Components)...))
+	}
+
+	if !m.PriorityMapping.IsNull() {
+		// send the mapping ordered from the highest to the lowest severity
+		priorities := map[string]string{}
+		diagnostics.Append(m.PriorityMapping.ElementsAs(ctx, &priorities, false)...)
+		priorityMapping := []JiraPriorityMappingInput{}
+		for _, severity := range caseSeverities {
+			if priority, ok := priorities[severity]; ok {
+				priorityMapping = append(priorityMapping, JiraPriorityMappingInput{
+					Severity: mondoov1.String(severity),
+					Priority: mondoov1.String(priority),
+				})
+			}
+		}
+		opts.PriorityMapping = &priorityMapping
+	}
+
+	if m.CustomFields != nil {
+		customFields := make([]JiraCustomFieldInput, 0, len(m.CustomFields))
+		for _, field := range m.CustomFields {
+			customFields = append(customFields, JiraCustomFieldInput{
+				ID:    mondoov1.String(field.ID.ValueString()),
+				Value: mondoov1.String(field.Value.ValueString()),
+			})
+		}
+		opts.CustomFields = &customFields
 	}
 
-	return opts
+	return opts, diagnostics
+}
+
+// clearRemovedIssueFields sends empty values for the issue fields that were removed from the
+// configuration, so they are cleared in the integration.
+func (m integrationJiraResourceModel) clearRemovedIssueFields(prior integrationJiraResourceModel, opts *JiraConfigurationOptionsInput) {
+	empty := mondoov1.String("")
+	if m.IssueType.IsNull() && !prior.IssueType.IsNull() {
+		opts.IssueType = &empty
+	}
+	if m.SummaryTemplate.IsNull() && !prior.SummaryTemplate.IsNull() {
+		opts.SummaryTemplate = &empty
+	}
+	if m.DescriptionTemplate.IsNull() && !prior.DescriptionTemplate.IsNull() {
+		opts.DescriptionTemplate = &empty
+	}
+	if m.Labels.IsNull() && !prior.Labels.IsNull() {
+		opts.Labels = &[]mondoov1.String{}
+	}
+	if m.Components.IsNull() && !prior.Components.IsNull() {
+		opts.Components = &[]mondoov1.String{}
+	}
+	if m.PriorityMapping.IsNull() && !prior.PriorityMapping.IsNull() {
+		opts.PriorityMapping = &[]JiraPriorityMappingInput{}
+	}
+	if m.CustomFields == nil && prior.CustomFields != nil {
+		opts.CustomFields = &[]JiraCustomFieldInput{}
+	}
+}
+
+// setIssueFields updates the issue fields with the configuration returned by the API. Fields the
+// API returns empty keep their value when it's unset or empty in the configuration.
+func (m *integrationJiraResourceModel) setIssueFields(ctx context.Context, jira JiraIssueConfigurationOptions) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	issueString := func(prior types.String, v string) types.String {
+		if v == "" && prior.ValueString() == "" {
+			return prior
+		}
+		return types.StringValue(v)
+	}
+	issueList := func(prior types.List, v []string) types.List {
+		if len(v) == 0 && len(prior.Elements()) == 0 {
+			return prior
+		}
+		list, d := types.ListValueFrom(ctx, types.StringType, v)
+		diagnostics.Append(d...)
+		return list
+	}
+
+	m.IssueType = issueString(m.IssueType, jira.IssueType)
+	m.Labels = issueList(m.Labels, jira.Labels)
+	m.Components = issueList(m.Components, jira.Components)
+	m.SummaryTemplate = issueString(m.SummaryTemplate, jira.SummaryTemplate)
+	m.DescriptionTemplate = issueString(m.DescriptionTemplate, jira.DescriptionTemplate)
+
+	if len(jira.PriorityMapping) > 0 || len(m.PriorityMapping.Elements()) > 0 {
+		priorities := map[string]string{}
+		for _, mapping := range jira.PriorityMapping {
+			priorities[mapping.Severity] = mapping.Priority
+		}
+		priorityMapping, d := types.MapValueFrom(ctx, types.StringType, priorities)
+		diagnostics.Append(d...)
+		m.PriorityMapping = priorityMapping
+	}
+
+	if len(jira.CustomFields) > 0 || len(m.CustomFields) > 0 {
+		fields := make([]integrationJiraCustomFieldModel, len(jira.CustomFields))
+		for i, field := range jira.CustomFields {
+			fields[i] = integrationJiraCustomFieldModel{
+				ID:    types.StringValue(field.ID),
+				Value: types.StringValue(field.Value),
+			}
+		}
+		m.CustomFields = fields
+	}
+
+	return diagnostics
 }
 
 func (r *integrationJiraResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -128,6 +265,55 @@ func (r *integrationJiraResource) Schema(ctx context.Context, req resource.Schem
 				MarkdownDescription: "Automatically close Jira issues for resolved Mondoo findings",
 				Optional:            true,
 			},
+			"issue_type": schema.StringAttribute{
+				MarkdownDescription: "Issue type of created Jira issues, such as `Bug` or `Task`. If not set, the default issue type of the project is used.",
+				Optional:            true,
+			},
+			"labels": schema.ListAttribute{
+				MarkdownDescription: "Labels to add to created Jira issues.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"components": schema.ListAttribute{
+				MarkdownDescription: "Names of the project components to add to created Jira issues.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"priority_mapping": schema.MapAttribute{
+				MarkdownDescription: "Jira priority of created issues by finding severity, such as `{ CRITICAL = \"Highest\" }`. Valid keys: `CRITICAL`, `HIGH`, `MEDIUM`, `LOW`, `NONE`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.OneOf(caseSeverities...)),
+				},
+			},
+			"custom_fields": schema.ListNestedAttribute{
+				MarkdownDescription: "Custom fields to set on created Jira issues. Use this for fields that are mandatory in your Jira project.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Custom field ID, such as `customfield_10010`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(regexp.MustCompile(`^customfield_[0-9]+$`), "must be a Jira custom field ID, such as customfield_10010"),
+							},
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "Custom field value. Use JSON for fields that expect an object or a list, such as `{\"value\": \"Security\"}`.",
+							Required:            true,
+						},
+					},
+				},
+			},
+			"summary_template": schema.StringAttribute{
+				MarkdownDescription: "Template for the summary of created Jira issues, using Go template syntax. " + jiraTemplateFieldsDescription,
+				Optional:            true,
+			},
+			"description_template": schema.StringAttribute{
+				MarkdownDescription: "Template for the description of created Jira issues, using Go template syntax. " + jiraTemplateFieldsDescription,
+				Optional:            true,
+			},
 			"credentials": schema.SingleNestedAttribute{
 				Required: true,
 				Attributes: map[string]schema.Attribute{
@@ -162,6 +348,73 @@ func (r *integrationJiraResource) Configure(ctx context.Context, req resource.Co
 	r.client = client
 }
 
+// jiraTemplateFields are the fields available in the summary and description templates.
+var jiraTemplateFields = []string{"Title", "Severity", "AssetName", "SpaceName", "Url"}
+
+var jiraTemplateFieldsDescription = "Available fields: `{{ .Title }}`, `{{ .Severity }}`, `{{ .AssetName }}`, `{{ .SpaceName }}` and `{{ .Url }}`. If not set, the Mondoo default is used."
+
+func (r *integrationJiraResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data integrationJiraResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateIntegrationJiraResourceModel(&data)...)
+}
+
+func validateIntegrationJiraResourceModel(data *integrationJiraResourceModel) (diagnostics diag.Diagnostics) {
+	templates := map[string]types.String{
+		"summary_template":     data.SummaryTemplate,
+		"description_template": data.DescriptionTemplate,
+	}
+	for _, attribute := range []string{"summary_template", "description_template"} {
+		if err := validateJiraTemplate(templates[attribute].ValueString()); err != nil {
+			diagnostics.AddError(
+				"InvalidAttributeError",
+				fmt.Sprintf("Attribute %s is not a valid template: %s", attribute, err),
+			)
+		}
+	}
+
+	seen := map[string]bool{}
+	for _, field := range data.CustomFields {
+		if field.ID.IsUnknown() {
+			continue
+		}
+		id := field.ID.ValueString()
+		if seen[id] {
+			diagnostics.AddError(
+				"InvalidAttributeError",
+				fmt.Sprintf("Custom field %s is set more than once.", id),
+			)
+		}
+		seen[id] = true
+	}
+	return
+}
+
+// validateJiraTemplate parses the template and renders it with placeholder values
+// to detect syntax errors and references to unknown fields.
+func validateJiraTemplate(text string) error {
+	if text == "" {
+		return nil
+	}
+	tmpl, err := template.New("jira").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return err
+	}
+	fields := map[string]string{}
+	for _, field := range jiraTemplateFields {
+		fields[field] = field
+	}
+	if err := tmpl.Execute(io.Discard, fields); err != nil {
+		return fmt.Errorf("%w, available fields: %s", err, jiraTemplateFields)
+	}
+	return nil
+}
+
 func (r *integrationJiraResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data integrationJiraResourceModel
 
@@ -180,14 +433,20 @@ func (r *integrationJiraResource) Create(ctx context.Context, req resource.Creat
 	}
 	ctx = tflog.SetField(ctx, "space_mrn", space.MRN())
 
+	jiraOpts, diags := data.GetConfigurationOptions(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Do GraphQL request to API to create the resource.
 	tflog.Debug(ctx, "Creating integration")
-	integration, err := r.client.CreateIntegration(ctx,
+	integration, err := r.client.CreateIntegrationWithOptions(ctx,
 		space.MRN(),
 		data.Name.ValueString(),
 		mondoov1.ClientIntegrationTypeTicketSystemJira,
-		mondoov1.ClientIntegrationConfigurationInput{
-			JiraConfigurationOptions: data.GetConfigurationOptions(),
+		ClientIntegrationConfigurationInput{
+			JiraConfigurationOptions: jiraOpts,
 		})
 	if err != nil {
 		resp.Diagnostics.
@@ -217,27 +476,62 @@ func (r *integrationJiraResource) Read(ctx context.Context, req resource.ReadReq
 	}
 
 	// Read API call logic
+	integration, err := r.client.GetClientIntegration(ctx, data.Mrn.ValueString())
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to read Jira integration. Got error: %s", err),
+			)
+		return
+	}
+
+	// detect drift on the issue fields, the token is a secret and not returned by the API
+	issueFields, err := r.client.GetJiraIssueConfigurationOptions(ctx, data.Mrn.ValueString())
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to read Jira integration. Got error: %s", err),
+			)
+		return
+	}
+	data.Name = types.StringValue(integration.Name)
+	resp.Diagnostics.Append(data.setIssueFields(ctx, issueFields)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *integrationJiraResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data integrationJiraResourceModel
+	var data, state integrationJiraResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and prior state data into the models
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	jiraOpts, diags := data.GetConfigurationOptions(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.clearRemovedIssueFields(state, jiraOpts)
+
 	// Do GraphQL request to API to update the resource.
-	opts := mondoov1.ClientIntegrationConfigurationInput{
-		JiraConfigurationOptions: data.GetConfigurationOptions(),
+	opts := ClientIntegrationConfigurationInput{
+		JiraConfigurationOptions: jiraOpts,
 	}
 
-	_, err := r.client.UpdateIntegration(ctx,
+	_, err := r.client.UpdateIntegrationWithOptions(ctx,
 		data.Mrn.ValueString(),
 		data.Name.ValueString(),
 		mondoov1.ClientIntegrationTypeTicketSystemJira,
@@ -277,24 +571,44 @@ func (r *integrationJiraResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *integrationJiraResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	integration, ok := r.client.ImportIntegration(ctx, req, resp)
+	integration, spaceID, ok := r.client.ImportSpaceIntegration(ctx, req, resp)
 	if !ok {
 		return
 	}
 
+	issueFields, err := r.client.GetJiraIssueConfigurationOptions(ctx, integration.Mrn)
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to import Jira integration. Got error: %s", err),
+			)
+		return
+	}
+
 	model := integrationJiraResourceModel{
 		Mrn:            types.StringValue(integration.Mrn),
 		Name:           types.StringValue(integration.Name),
-		SpaceID:        types.StringValue(integration.SpaceID()),
+		SpaceID:        types.StringValue(spaceID),
 		Host:           types.StringValue(integration.ConfigurationOptions.JiraConfigurationOptions.Host),
 		Email:          types.StringValue(integration.ConfigurationOptions.JiraConfigurationOptions.Email),
 		DefaultProject: types.StringValue(integration.ConfigurationOptions.JiraConfigurationOptions.DefaultProject),
 		AutoCreate:     types.BoolValue(integration.ConfigurationOptions.JiraConfigurationOptions.AutoCreateCases),
 		AutoClose:      types.BoolValue(integration.ConfigurationOptions.JiraConfigurationOptions.AutoCloseTickets),
+
+		IssueType:           types.StringNull(),
+		Labels:              types.ListNull(types.StringType),
+		Components:          types.ListNull(types.StringType),
+		PriorityMapping:     types.MapNull(types.StringType),
+		SummaryTemplate:     types.StringNull(),
+		DescriptionTemplate: types.StringNull(),
 		Credential: &integrationJiraCredentialModel{
 			Token: types.StringPointerValue(nil),
 		},
 	}
+	resp.Diagnostics.Append(model.setIssueFields(ctx, issueFields)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	resp.State.Set(ctx, &model)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 }
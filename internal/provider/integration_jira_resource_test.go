@@ -4,10 +4,16 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	mondoov1 "go.mondoo.com/mondoo-go"
 )
 
 func TestAccJiraResource(t *testing.T) {
@@ -57,6 +63,20 @@ func TestAccJiraResource(t *testing.T) {
 					resource.TestCheckResourceAttr("mondoo_integration_jira.test", "auto_close", "true"),
 				),
 			},
+			{
+				Config: testAccJiraResourceWithIssueFieldsConfig(accSpace.ID(), "three"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mondoo_integration_jira.test", "name", "three"),
+					resource.TestCheckResourceAttr("mondoo_integration_jira.test", "issue_type", "Bug"),
+					resource.TestCheckResourceAttr("mondoo_integration_jira.test", "labels.#", "2"),
+					resource.TestCheckResourceAttr("mondoo_integration_jira.test", "labels.0", "mondoo"),
+					resource.TestCheckResourceAttr("mondoo_integration_jira.test", "components.0", "Infrastructure"),
+					resource.TestCheckResourceAttr("mondoo_integration_jira.test", "priority_mapping.CRITICAL", "Highest"),
+					resource.TestCheckResourceAttr("mondoo_integration_jira.test", "priority_mapping.LOW", "Low"),
+					resource.TestCheckResourceAttr("mondoo_integration_jira.test", "custom_fields.0.id", "customfield_10010"),
+					resource.TestCheckResourceAttr("mondoo_integration_jira.test", "summary_template", "[{{ .Severity }}] {{ .Title }} on {{ .AssetName }}"),
+				),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -101,3 +121,193 @@ resource "mondoo_integration_jira" "test" {
 }
 `, spaceID, intName, token, autoCreate, autoClose)
 }
+
+func testAccJiraResourceWithIssueFieldsConfig(spaceID, intName string) string {
+	return fmt.Sprintf(`
+resource "mondoo_integration_jira" "test" {
+  space_id = %[1]q
+  name  = %[2]q
+  host  = "https://your-instance.atlassian.net"
+  email = "jira.owner@email.com"
+  default_project = "MONDOO"
+
+  issue_type = "Bug"
+  labels     = ["mondoo", "security"]
+  components = ["Infrastructure"]
+
+  priority_mapping = {
+    CRITICAL = "Highest"
+    HIGH     = "High"
+    LOW      = "Low"
+  }
+
+  custom_fields = [
+    {
+      id    = "customfield_10010"
+      value = jsonencode({ value = "Security" })
+    },
+  ]
+
+  summary_template     = "[{{ .Severity }}] {{ .Title }} on {{ .AssetName }}"
+  description_template = "{{ .Title }} was found on {{ .AssetName }} in {{ .SpaceName }}.\n\nDetails: {{ .Url }}"
+
+  credentials = {
+    token = "abcd1234567890"
+  }
+}
+`, spaceID, intName)
+}
+
+func TestValidateIntegrationJiraResourceModel(t *testing.T) {
+	customField := func(id string) integrationJiraCustomFieldModel {
+		return integrationJiraCustomFieldModel{ID: types.StringValue(id), Value: types.StringValue("x")}
+	}
+
+	tests := []struct {
+		name         string
+		summary      string
+		description  string
+		customFields []integrationJiraCustomFieldModel
+		wantErr      bool
+	}{
+		{
+			name: "no templates",
+		},
+		{
+			name:        "valid templates",
+			summary:     "[{{ .Severity }}] {{ .Title }}",
+			description: "{{ .Title }} on {{ .AssetName }} in {{ .SpaceName }}: {{ .Url }}",
+		},
+		{
+			name:    "template syntax error",
+			summary: "{{ .Title ",
+			wantErr: true,
+		},
+		{
+			name:        "unknown template field",
+			description: "{{ .Hostname }}",
+			wantErr:     true,
+		},
+		{
+			name:         "distinct custom fields",
+			customFields: []integrationJiraCustomFieldModel{customField("customfield_10010"), customField("customfield_10011")},
+		},
+		{
+			name:         "duplicate custom fields",
+			customFields: []integrationJiraCustomFieldModel{customField("customfield_10010"), customField("customfield_10010")},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := &integrationJiraResourceModel{
+				SummaryTemplate:     types.StringNull(),
+				DescriptionTemplate: types.StringNull(),
+				CustomFields:        tt.customFields,
+			}
+			if tt.summary != "" {
+				data.SummaryTemplate = types.StringValue(tt.summary)
+			}
+			if tt.description != "" {
+				data.DescriptionTemplate = types.StringValue(tt.description)
+			}
+
+			diagnostics := validateIntegrationJiraResourceModel(data)
+			if !tt.wantErr {
+				assert.False(t, diagnostics.HasError())
+				return
+			}
+			require.Len(t, diagnostics, 1)
+			assert.Equal(t, "InvalidAttributeError", diagnostics[0].Summary())
+		})
+	}
+}
+
+func TestIntegrationJiraConfigurationOptions(t *testing.T) {
+	priorities, diags := types.MapValueFrom(context.Background(), types.StringType, map[string]string{
+		"LOW":      "Low",
+		"CRITICAL": "Highest",
+		"HIGH":     "High",
+	})
+	require.False(t, diags.HasError())
+
+	data := integrationJiraResourceModel{
+		Host:                types.StringValue("https://your-instance.atlassian.net"),
+		Email:               types.StringValue("jira.owner@email.com"),
+		DefaultProject:      types.StringValue("MONDOO"),
+		IssueType:           types.StringValue("Bug"),
+		Labels:              ConvertListValue([]string{"mondoo"}),
+		Components:          types.ListNull(types.StringType),
+		PriorityMapping:     priorities,
+		SummaryTemplate:     types.StringNull(),
+		DescriptionTemplate: types.StringNull(),
+		Credential:          &integrationJiraCredentialModel{Token: types.StringValue("abcd1234567890")},
+	}
+	opts, diags := data.GetConfigurationOptions(context.Background())
+	require.False(t, diags.HasError())
+
+	assert.Equal(t, mondoov1.String("Bug"), *opts.IssueType)
+	assert.Equal(t, []mondoov1.String{"mondoo"}, *opts.Labels)
+	assert.Equal(t, []JiraPriorityMappingInput{
+		{Severity: "CRITICAL", Priority: "Highest"},
+		{Severity: "HIGH", Priority: "High"},
+		{Severity: "LOW", Priority: "Low"},
+	}, *opts.PriorityMapping)
+
+	// issue fields that are not configured are not sent
+	assert.Nil(t, opts.Components)
+	assert.Nil(t, opts.CustomFields)
+	assert.Nil(t, opts.SummaryTemplate)
+	body, err := json.Marshal(opts)
+	require.NoError(t, err)
+	assert.NotContains(t, string(body), "components")
+	assert.NotContains(t, string(body), "summaryTemplate")
+
+	// issue fields removed from the configuration are cleared
+	prior := data
+	prior.Components = ConvertListValue([]string{"backend"})
+	prior.SummaryTemplate = types.StringValue("{{ .Title }}")
+	data.clearRemovedIssueFields(prior, opts)
+	assert.Equal(t, []mondoov1.String{}, *opts.Components)
+	assert.Equal(t, mondoov1.String(""), *opts.SummaryTemplate)
+	assert.Nil(t, opts.DescriptionTemplate)
+	assert.Nil(t, opts.CustomFields)
+}
+
+func TestIntegrationJiraSetIssueFields(t *testing.T) {
+	ctx := context.Background()
+	jira := JiraIssueConfigurationOptions{
+		IssueType:       "Task",
+		Labels:          []string{"mondoo", "security"},
+		PriorityMapping: []JiraPriorityMapping{{Severity: "CRITICAL", Priority: "Highest"}},
+		CustomFields:    []JiraCustomField{{ID: "customfield_10010", Value: "Security"}},
+	}
+
+	// prior state as configured, changed in the console afterwards
+	data := integrationJiraResourceModel{
+		IssueType:           types.StringValue("Bug"),
+		Labels:              ConvertListValue([]string{"mondoo"}),
+		Components:          ConvertListValue([]string{}),
+		PriorityMapping:     types.MapNull(types.StringType),
+		SummaryTemplate:     types.StringValue("{{ .Title }}"),
+		DescriptionTemplate: types.StringNull(),
+	}
+	diags := data.setIssueFields(ctx, jira)
+	require.False(t, diags.HasError())
+
+	assert.Equal(t, types.StringValue("Task"), data.IssueType)
+	assert.True(t, data.Labels.Equal(ConvertListValue([]string{"mondoo", "security"})))
+	// empty values keep the configured value
+	assert.True(t, data.Components.Equal(ConvertListValue([]string{})))
+	assert.True(t, data.DescriptionTemplate.IsNull())
+	// values removed in the console show up as drift
+	assert.Equal(t, types.StringValue(""), data.SummaryTemplate)
+
+	priorities := map[string]string{}
+	require.False(t, data.PriorityMapping.ElementsAs(ctx, &priorities, false).HasError())
+	assert.Equal(t, map[string]string{"CRITICAL": "Highest"}, priorities)
+	assert.Equal(t, []integrationJiraCustomFieldModel{
+		{ID: types.StringValue("customfield_10010"), Value: types.StringValue("Security")},
+	}, data.CustomFields)
+}
@@ -0,0 +1,563 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	mondoov1 "go.mondoo.com/mondoo-go"
+	"gopkg.in/yaml.v2"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = (*integrationK8sResource)(nil)
+var _ resource.ResourceWithImportState = (*integrationK8sResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*integrationK8sResource)(nil)
+
+// k8sNamespaceRegex matches Kubernetes namespace names (RFC 1123 labels).
+var k8sNamespaceRegex = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]{0,61}[a-z0-9])?$`)
+
+// k8sTokenSecretName is the name of the secret the operator reads the registration token from.
+const k8sTokenSecretName = "mondoo-token"
+
+func NewIntegrationK8sResource() resource.Resource {
+	return &integrationK8sResource{}
+}
+
+type integrationK8sResource struct {
+	client *ExtendedGqlClient
+}
+
+type integrationK8sResourceModel struct {
+	// scope
+	SpaceID types.String `tfsdk:"space_id"`
+
+	// integration details
+	Mrn         types.String `tfsdk:"mrn"`
+	Name        types.String `tfsdk:"name"`
+	ClusterName types.String `tfsdk:"cluster_name"`
+
+	// scan options
+	ScanNodes               types.Bool   `tfsdk:"scan_nodes"`
+	ScanWorkloads           types.Bool   `tfsdk:"scan_workloads"`
+	ScanAdmissionController types.Bool   `tfsdk:"scan_admission_controller"`
+	ScanContainerImages     types.Bool   `tfsdk:"scan_container_images"`
+	NamespaceAllowList      types.List   `tfsdk:"namespace_allow_list"`
+	NamespaceDenyList       types.List   `tfsdk:"namespace_deny_list"`
+	Schedule                types.String `tfsdk:"schedule"`
+
+	// operator
+	OperatorNamespace types.String `tfsdk:"operator_namespace"`
+	RegistrationToken types.String `tfsdk:"registration_token"`
+	OperatorManifest  types.String `tfsdk:"operator_manifest"`
+}
+
+func (m integrationK8sResourceModel) GetConfigurationOptions() *K8sConfigurationOptionsInput {
+	return &K8sConfigurationOptionsInput{
+		ClusterName:             mondoov1.String(m.ClusterName.ValueString()),
+		ScanNodes:               mondoov1.Boolean(m.ScanNodes.ValueBool()),
+		ScanWorkloads:           mondoov1.Boolean(m.ScanWorkloads.ValueBool()),
+		ScanAdmissionController: mondoov1.Boolean(m.ScanAdmissionController.ValueBool()),
+		ScanContainerImages:     mondoov1.Boolean(m.ScanContainerImages.ValueBool()),
+		NamespaceAllowList:      ConvertSliceStrings(m.NamespaceAllowList),
+		NamespaceDenyList:       ConvertSliceStrings(m.NamespaceDenyList),
+		Schedule:                mondoov1.String(m.Schedule.ValueString()),
+	}
+}
+
+// mondooAuditConfig is the MondooAuditConfig custom resource of the Mondoo Kubernetes operator.
+type mondooAuditConfig struct {
+	APIVersion string                `yaml:"apiVersion"`
+	Kind       string                `yaml:"kind"`
+	Metadata   mondooAuditConfigMeta `yaml:"metadata"`
+	Spec       mondooAuditConfigSpec `yaml:"spec"`
+}
+
+type mondooAuditConfigMeta struct {
+	Name      string `yaml:"name"`
+	Namespace string `yaml:"namespace"`
+}
+
+type mondooAuditConfigSpec struct {
+	MondooTokenSecretRef mondooAuditConfigRef     `yaml:"mondooTokenSecretRef"`
+	ConsoleIntegration   mondooAuditConfigToggle  `yaml:"consoleIntegration"`
+	KubernetesResources  mondooAuditConfigToggle  `yaml:"kubernetesResources"`
+	Nodes                mondooAuditConfigToggle  `yaml:"nodes"`
+	Admission            mondooAuditConfigToggle  `yaml:"admission"`
+	Containers           mondooAuditConfigToggle  `yaml:"containers"`
+	Filtering            *mondooAuditConfigFilter `yaml:"filtering,omitempty"`
+}
+
+type mondooAuditConfigRef struct {
+	Name string `yaml:"name"`
+}
+
+type mondooAuditConfigToggle struct {
+	Enable   bool   `yaml:"enable"`
+	Schedule string `yaml:"schedule,omitempty"`
+}
+
+type mondooAuditConfigFilter struct {
+	Namespaces mondooAuditConfigNamespaces `yaml:"namespaces"`
+}
+
+type mondooAuditConfigNamespaces struct {
+	Include []string `yaml:"include,omitempty"`
+	Exclude []string `yaml:"exclude,omitempty"`
+}
+
+// RenderOperatorManifest renders the MondooAuditConfig that configures the Mondoo operator to scan
+// the cluster with the options of the integration.
+func (m integrationK8sResourceModel) RenderOperatorManifest(ctx context.Context) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	schedule := m.Schedule.ValueString()
+
+	config := mondooAuditConfig{
+		APIVersion: "k8s.mondoo.com/v1alpha2",
+		Kind:       "MondooAuditConfig",
+		Metadata: mondooAuditConfigMeta{
+			Name:      "mondoo-client",
+			Namespace: m.OperatorNamespace.ValueString(),
+		},
+		Spec: mondooAuditConfigSpec{
+			MondooTokenSecretRef: mondooAuditConfigRef{Name: k8sTokenSecretName},
+			ConsoleIntegration:   mondooAuditConfigToggle{Enable: true},
+			KubernetesResources:  mondooAuditConfigToggle{Enable: m.ScanWorkloads.ValueBool(), Schedule: schedule},
+			Nodes:                mondooAuditConfigToggle{Enable: m.ScanNodes.ValueBool(), Schedule: schedule},
+			Admission:            mondooAuditConfigToggle{Enable: m.ScanAdmissionController.ValueBool()},
+			Containers:           mondooAuditConfigToggle{Enable: m.ScanContainerImages.ValueBool(), Schedule: schedule},
+		},
+	}
+
+	namespaces := mondooAuditConfigNamespaces{}
+	diags.Append(m.NamespaceAllowList.ElementsAs(ctx, &namespaces.Include, true)...)
+	diags.Append(m.NamespaceDenyList.ElementsAs(ctx, &namespaces.Exclude, true)...)
+	if len(namespaces.Include) > 0 || len(namespaces.Exclude) > 0 {
+		config.Spec.Filtering = &mondooAuditConfigFilter{Namespaces: namespaces}
+	}
+
+	out, err := yaml.Marshal(config)
+	if err != nil {
+		diags.AddError("Unable to render operator manifest", err.Error())
+		return "", diags
+	}
+	return string(out), diags
+}
+
+func (r *integrationK8sResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration_k8s"
+}
+
+func (r *integrationK8sResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	namespaceValidators := []validator.List{
+		listvalidator.ValueStringsAre(
+			stringvalidator.RegexMatches(k8sNamespaceRegex, "must be a valid Kubernetes namespace name"),
+		),
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Register a Kubernetes cluster that is scanned by the Mondoo operator. Use the `registration_token` and `operator_manifest` outputs to configure the operator in the cluster.",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "Mondoo space identifier. If there is no space ID, the provider space is used.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"mrn": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Integration identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the integration.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(250),
+				},
+			},
+			"cluster_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the Kubernetes cluster, used to identify the cluster assets.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 250),
+				},
+			},
+			"scan_nodes": schema.BoolAttribute{
+				MarkdownDescription: "Scan the cluster nodes. Default `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"scan_workloads": schema.BoolAttribute{
+				MarkdownDescription: "Scan the Kubernetes resources and workloads. Default `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"scan_admission_controller": schema.BoolAttribute{
+				MarkdownDescription: "Deploy the admission controller to scan workloads before they are admitted to the cluster. Default `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"scan_container_images": schema.BoolAttribute{
+				MarkdownDescription: "Scan the container images of the running workloads. Default `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"namespace_allow_list": schema.ListAttribute{
+				MarkdownDescription: "Namespaces to scan. If set, all other namespaces are skipped. Conflicts with `namespace_deny_list`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: append([]validator.List{
+					listvalidator.ConflictsWith(path.MatchRoot("namespace_deny_list")),
+				}, namespaceValidators...),
+			},
+			"namespace_deny_list": schema.ListAttribute{
+				MarkdownDescription: "Namespaces to skip. Conflicts with `namespace_allow_list`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators:          namespaceValidators,
+			},
+			"schedule": schema.StringAttribute{
+				MarkdownDescription: "Cron schedule of the scans, such as `0 */6 * * *`. Default `0 * * * *` (hourly).",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("0 * * * *"),
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^\S+(\s+\S+){4}$`),
+						"must be a cron expression with five fields",
+					),
+				},
+			},
+			"operator_namespace": schema.StringAttribute{
+				MarkdownDescription: "Namespace the Mondoo operator is installed in. Default `mondoo-operator`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("mondoo-operator"),
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(k8sNamespaceRegex, "must be a valid Kubernetes namespace name"),
+				},
+			},
+			"registration_token": schema.StringAttribute{
+				MarkdownDescription: "Token the Mondoo operator uses to register the cluster. Store it in the `token` key of a secret named `mondoo-token` in the operator namespace.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"operator_manifest": schema.StringAttribute{
+				MarkdownDescription: "Rendered `MondooAuditConfig` manifest (YAML) for the Mondoo operator. Use it with `yamldecode()` in a `kubernetes_manifest` resource.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *integrationK8sResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ExtendedGqlClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client. Got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *integrationK8sResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data integrationK8sResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Compute and validate the space
+	space, err := r.client.ComputeSpace(data.SpaceID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+		return
+	}
+	ctx = tflog.SetField(ctx, "space_mrn", space.MRN())
+
+	// Do GraphQL request to API to create the resource.
+	tflog.Debug(ctx, "Creating integration")
+	integration, err := r.client.CreateIntegrationWithOptions(ctx,
+		space.MRN(),
+		data.Name.ValueString(),
+		ClientIntegrationTypeK8s,
+		ClientIntegrationConfigurationInput{
+			K8sConfigurationOptions: data.GetConfigurationOptions(),
+		})
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to create Kubernetes integration. Got error: %s", err),
+			)
+		return
+	}
+
+	// Save space mrn into the Terraform state.
+	data.Mrn = types.StringValue(string(integration.Mrn))
+	data.Name = types.StringValue(string(integration.Name))
+	data.SpaceID = types.StringValue(space.ID())
+
+	// Save the integration before fetching its token, so it's tracked (and replaced on the next apply)
+	// if the token can't be fetched.
+	data.RegistrationToken = types.StringNull()
+	data.OperatorManifest = types.StringNull()
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the operator registers the cluster with a long-lived token of the integration
+	token, err := r.client.GetClientIntegrationToken(ctx, data.Mrn.ValueString(), true)
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to get registration token of Kubernetes integration. Got error: %s", err),
+			)
+		return
+	}
+	data.RegistrationToken = types.StringValue(string(token.Token))
+
+	manifest, diags := data.RenderOperatorManifest(ctx)
+	resp.Diagnostics.Append(diags...)
+	data.OperatorManifest = types.StringValue(manifest)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *integrationK8sResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data integrationK8sResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	integration, err := r.client.GetClientIntegration(ctx, data.Mrn.ValueString())
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to read Kubernetes integration. Got error: %s", err),
+			)
+		return
+	}
+
+	// detect drift on the scan options, the manifest is rendered from them
+	options, err := r.client.GetK8sConfigurationOptions(ctx, data.Mrn.ValueString())
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to read Kubernetes integration. Got error: %s", err),
+			)
+		return
+	}
+	data.Name = types.StringValue(integration.Name)
+	data.setConfigurationOptions(options)
+
+	manifest, diags := data.RenderOperatorManifest(ctx)
+	resp.Diagnostics.Append(diags...)
+	data.OperatorManifest = types.StringValue(manifest)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *integrationK8sResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data integrationK8sResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Do GraphQL request to API to update the resource.
+	opts := ClientIntegrationConfigurationInput{
+		K8sConfigurationOptions: data.GetConfigurationOptions(),
+	}
+
+	_, err := r.client.UpdateIntegrationWithOptions(ctx,
+		data.Mrn.ValueString(),
+		data.Name.ValueString(),
+		ClientIntegrationTypeK8s,
+		opts,
+	)
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to update Kubernetes integration. Got error: %s", err),
+			)
+		return
+	}
+
+	manifest, diags := data.RenderOperatorManifest(ctx)
+	resp.Diagnostics.Append(diags...)
+	data.OperatorManifest = types.StringValue(manifest)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *integrationK8sResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data integrationK8sResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Do GraphQL request to API to update the resource.
+	_, err := r.client.DeleteIntegration(ctx, data.Mrn.ValueString())
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to delete Kubernetes integration. Got error: %s", err),
+			)
+		return
+	}
+}
+
+func (r *integrationK8sResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	integration, spaceID, ok := r.client.ImportSpaceIntegration(ctx, req, resp)
+	if !ok {
+		return
+	}
+
+	options, err := r.client.GetK8sConfigurationOptions(ctx, integration.Mrn)
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to import Kubernetes integration. Got error: %s", err),
+			)
+		return
+	}
+
+	token, err := r.client.GetClientIntegrationToken(ctx, integration.Mrn, true)
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to get registration token of Kubernetes integration. Got error: %s", err),
+			)
+		return
+	}
+
+	model := integrationK8sResourceModel{
+		Mrn:               types.StringValue(integration.Mrn),
+		Name:              types.StringValue(integration.Name),
+		SpaceID:           types.StringValue(spaceID),
+		OperatorNamespace: types.StringValue("mondoo-operator"),
+		RegistrationToken: types.StringValue(string(token.Token)),
+	}
+	model.setConfigurationOptions(options)
+
+	manifest, diags := model.RenderOperatorManifest(ctx)
+	resp.Diagnostics.Append(diags...)
+	model.OperatorManifest = types.StringValue(manifest)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// setConfigurationOptions sets the scan options of the model from the integration payload.
+func (m *integrationK8sResourceModel) setConfigurationOptions(opts K8sConfigurationOptions) {
+	m.ClusterName = types.StringValue(opts.ClusterName)
+	m.ScanNodes = types.BoolValue(opts.ScanNodes)
+	m.ScanWorkloads = types.BoolValue(opts.ScanWorkloads)
+	m.ScanAdmissionController = types.BoolValue(opts.ScanAdmissionController)
+	m.ScanContainerImages = types.BoolValue(opts.ScanContainerImages)
+	m.Schedule = types.StringValue(opts.Schedule)
+
+	// unset lists stay null so that they don't show a diff against the configuration
+	m.NamespaceAllowList = types.ListNull(types.StringType)
+	if len(opts.NamespaceAllowList) > 0 {
+		m.NamespaceAllowList = ConvertListValue(opts.NamespaceAllowList)
+	}
+	m.NamespaceDenyList = types.ListNull(types.StringType)
+	if len(opts.NamespaceDenyList) > 0 {
+		m.NamespaceDenyList = ConvertListValue(opts.NamespaceDenyList)
+	}
+}
+
+func (r *integrationK8sResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data integrationK8sResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateIntegrationK8sResourceModel(&data)...)
+}
+
+func validateIntegrationK8sResourceModel(data *integrationK8sResourceModel) (diagnostics diag.Diagnostics) {
+	// unset options use their defaults, only the admission controller is disabled by default
+	enabled := func(option types.Bool, defaultValue bool) bool {
+		if option.IsNull() {
+			return defaultValue
+		}
+		return option.IsUnknown() || option.ValueBool()
+	}
+	if enabled(data.ScanNodes, true) ||
+		enabled(data.ScanWorkloads, true) ||
+		enabled(data.ScanAdmissionController, false) ||
+		enabled(data.ScanContainerImages, true) {
+		return
+	}
+
+	diagnostics.AddAttributeError(
+		path.Root("scan_nodes"),
+		"InvalidAttributeError",
+		"At least one of scan_nodes, scan_workloads, scan_admission_controller or scan_container_images must be enabled.",
+	)
+	return
+}
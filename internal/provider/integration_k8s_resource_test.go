@@ -0,0 +1,189 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccK8sResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccK8sResourceConfig(accSpace.ID(), "one", "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mondoo_integration_k8s.test", "name", "one"),
+					resource.TestCheckResourceAttr("mondoo_integration_k8s.test", "space_id", accSpace.ID()),
+					resource.TestCheckResourceAttr("mondoo_integration_k8s.test", "scan_nodes", "true"),
+					resource.TestCheckResourceAttr("mondoo_integration_k8s.test", "schedule", "0 * * * *"),
+					resource.TestCheckResourceAttrSet("mondoo_integration_k8s.test", "registration_token"),
+					resource.TestCheckResourceAttrSet("mondoo_integration_k8s.test", "operator_manifest"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccK8sResourceConfig(accSpace.ID(), "two", "false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mondoo_integration_k8s.test", "name", "two"),
+					resource.TestCheckResourceAttr("mondoo_integration_k8s.test", "scan_nodes", "false"),
+				),
+			},
+			// Import testing
+			{
+				ResourceName:                         "mondoo_integration_k8s.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "mrn",
+				// every long-lived token of the integration is a new token
+				ImportStateVerifyIgnore: []string{"registration_token"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["mondoo_integration_k8s.test"].Primary.Attributes["mrn"], nil
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccK8sResourceConfig(spaceID, intName, scanNodes string) string {
+	return fmt.Sprintf(`
+resource "mondoo_integration_k8s" "test" {
+  space_id            = %[1]q
+  name                = %[2]q
+  cluster_name        = "production"
+  scan_nodes          = %[3]s
+  namespace_deny_list = ["kube-system"]
+}
+`, spaceID, intName, scanNodes)
+}
+
+func TestIntegrationK8sOperatorManifest(t *testing.T) {
+	ctx := context.Background()
+	model := integrationK8sResourceModel{
+		ClusterName:             types.StringValue("production"),
+		ScanNodes:               types.BoolValue(true),
+		ScanWorkloads:           types.BoolValue(true),
+		ScanAdmissionController: types.BoolValue(false),
+		ScanContainerImages:     types.BoolValue(false),
+		NamespaceAllowList:      types.ListNull(types.StringType),
+		NamespaceDenyList:       ConvertListValue([]string{"kube-system"}),
+		Schedule:                types.StringValue("0 */6 * * *"),
+		OperatorNamespace:       types.StringValue("mondoo-operator"),
+	}
+
+	manifest, diagnostics := model.RenderOperatorManifest(ctx)
+	require.False(t, diagnostics.HasError())
+	assert.Equal(t, `apiVersion: k8s.mondoo.com/v1alpha2
+kind: MondooAuditConfig
+metadata:
+  name: mondoo-client
+  namespace: mondoo-operator
+spec:
+  mondooTokenSecretRef:
+    name: mondoo-token
+  consoleIntegration:
+    enable: true
+  kubernetesResources:
+    enable: true
+    schedule: 0 */6 * * *
+  nodes:
+    enable: true
+    schedule: 0 */6 * * *
+  admission:
+    enable: false
+  containers:
+    enable: false
+    schedule: 0 */6 * * *
+  filtering:
+    namespaces:
+      exclude:
+      - kube-system
+`, manifest)
+
+	// the options read from the API render the same manifest, so there is no drift
+	imported := integrationK8sResourceModel{OperatorNamespace: types.StringValue("mondoo-operator")}
+	imported.setConfigurationOptions(K8sConfigurationOptions{
+		ClusterName:       "production",
+		ScanNodes:         true,
+		ScanWorkloads:     true,
+		NamespaceDenyList: []string{"kube-system"},
+		Schedule:          "0 */6 * * *",
+	})
+	assert.True(t, imported.NamespaceAllowList.IsNull())
+	importedManifest, diagnostics := imported.RenderOperatorManifest(ctx)
+	require.False(t, diagnostics.HasError())
+	assert.Equal(t, manifest, importedManifest)
+
+	opts := model.GetConfigurationOptions()
+	assert.Equal(t, "production", string(opts.ClusterName))
+	assert.Empty(t, opts.NamespaceAllowList)
+	assert.Len(t, opts.NamespaceDenyList, 1)
+}
+
+func TestValidateIntegrationK8sResourceModel(t *testing.T) {
+	tests := []struct {
+		name  string
+		model integrationK8sResourceModel
+		valid bool
+	}{
+		{
+			name: "defaults",
+			model: integrationK8sResourceModel{
+				ScanNodes:               types.BoolNull(),
+				ScanWorkloads:           types.BoolNull(),
+				ScanAdmissionController: types.BoolNull(),
+				ScanContainerImages:     types.BoolNull(),
+			},
+			valid: true,
+		},
+		{
+			name: "admission controller only",
+			model: integrationK8sResourceModel{
+				ScanNodes:               types.BoolValue(false),
+				ScanWorkloads:           types.BoolValue(false),
+				ScanAdmissionController: types.BoolValue(true),
+				ScanContainerImages:     types.BoolValue(false),
+			},
+			valid: true,
+		},
+		{
+			name: "unknown option",
+			model: integrationK8sResourceModel{
+				ScanNodes:               types.BoolUnknown(),
+				ScanWorkloads:           types.BoolValue(false),
+				ScanAdmissionController: types.BoolNull(),
+				ScanContainerImages:     types.BoolValue(false),
+			},
+			valid: true,
+		},
+		{
+			name: "nothing to scan",
+			model: integrationK8sResourceModel{
+				ScanNodes:               types.BoolValue(false),
+				ScanWorkloads:           types.BoolValue(false),
+				ScanAdmissionController: types.BoolNull(),
+				ScanContainerImages:     types.BoolValue(false),
+			},
+			valid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diagnostics := validateIntegrationK8sResourceModel(&tt.model)
+			assert.Equal(t, !tt.valid, diagnostics.HasError())
+		})
+	}
+}
@@ -248,7 +248,7 @@ func (r *integrationMsIntuneResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *integrationMsIntuneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	integration, ok := r.client.ImportIntegration(ctx, req, resp)
+	integration, spaceID, ok := r.client.ImportSpaceIntegration(ctx, req, resp)
 	if !ok {
 		return
 	}
@@ -262,7 +262,7 @@ func (r *integrationMsIntuneResource) ImportState(ctx context.Context, req resou
 	model := integrationMsIntuneResourceModel{
 		Mrn:      types.StringValue(integration.Mrn),
 		Name:     types.StringValue(integration.Name),
-		SpaceID:  types.StringValue(integration.SpaceID()),
+		SpaceID:  types.StringValue(spaceID),
 		TenantId: types.StringValue(opts.TenantId),
 		ClientId: types.StringValue(opts.ClientId),
 		Credential: integrationMsIntuneCredentialModel{
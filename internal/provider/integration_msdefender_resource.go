@@ -292,7 +292,7 @@ func (r *integrationMsDefenderResource) Delete(ctx context.Context, req resource
 }
 
 func (r *integrationMsDefenderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	integration, ok := r.client.ImportIntegration(ctx, req, resp)
+	integration, spaceID, ok := r.client.ImportSpaceIntegration(ctx, req, resp)
 	if !ok {
 		return
 	}
@@ -303,7 +303,7 @@ func (r *integrationMsDefenderResource) ImportState(ctx context.Context, req res
 	model := integrationMsDefenderResourceModel{
 		Mrn:                   types.StringValue(integration.Mrn),
 		Name:                  types.StringValue(integration.Name),
-		SpaceID:               types.StringValue(integration.SpaceID()),
+		SpaceID:               types.StringValue(spaceID),
 		ClientId:              types.StringValue(integration.ConfigurationOptions.MicrosoftDefenderConfigurationOptions.ClientId),
 		TenantId:              types.StringValue(integration.ConfigurationOptions.MicrosoftDefenderConfigurationOptions.TenantId),
 		SubscriptionAllowList: allowList,
@@ -0,0 +1,333 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	mondoov1 "go.mondoo.com/mondoo-go"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = (*integrationMsTeamsResource)(nil)
+var _ resource.ResourceWithImportState = (*integrationMsTeamsResource)(nil)
+
+func NewIntegrationMsTeamsResource() resource.Resource {
+	return &integrationMsTeamsResource{}
+}
+
+type integrationMsTeamsResource struct {
+	client *ExtendedGqlClient
+}
+
+type integrationMsTeamsResourceModel struct {
+	// scope
+	SpaceID types.String `tfsdk:"space_id"`
+
+	// integration details
+	Mrn  types.String                       `tfsdk:"mrn"`
+	Name types.String                       `tfsdk:"name"`
+	App  *integrationMsTeamsAppChannelModel `tfsdk:"app"`
+
+	// credentials
+	WebhookUrl types.String `tfsdk:"webhook_url"`
+}
+
+type integrationMsTeamsAppChannelModel struct {
+	TenantId  types.String `tfsdk:"tenant_id"`
+	TeamId    types.String `tfsdk:"team_id"`
+	ChannelId types.String `tfsdk:"channel_id"`
+}
+
+func (m integrationMsTeamsResourceModel) GetConfigurationOptions() *MsTeamsConfigurationOptionsInput {
+	opts := &MsTeamsConfigurationOptionsInput{}
+	if !m.WebhookUrl.IsNull() {
+		opts.WebhookUrl = mondoov1.NewStringPtr(mondoov1.String(m.WebhookUrl.ValueString()))
+	}
+	if m.App != nil {
+		opts.App = &MsTeamsAppChannelInput{
+			TenantId:  mondoov1.String(m.App.TenantId.ValueString()),
+			TeamId:    mondoov1.String(m.App.TeamId.ValueString()),
+			ChannelId: mondoov1.String(m.App.ChannelId.ValueString()),
+		}
+	}
+	return opts
+}
+
+// msTeamsAppFromPayload returns the app channel of a Microsoft Teams integration, or nil
+// when the integration posts to an incoming webhook.
+func msTeamsAppFromPayload(opts MsTeamsConfigurationOptions) *integrationMsTeamsAppChannelModel {
+	if opts.App.ChannelId == "" {
+		return nil
+	}
+	return &integrationMsTeamsAppChannelModel{
+		TenantId:  types.StringValue(opts.App.TenantId),
+		TeamId:    types.StringValue(opts.App.TeamId),
+		ChannelId: types.StringValue(opts.App.ChannelId),
+	}
+}
+
+func (r *integrationMsTeamsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration_msteams"
+}
+
+func (r *integrationMsTeamsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Send Mondoo notifications to a Microsoft Teams channel, using either an incoming webhook or the Mondoo app for Microsoft Teams.",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "Mondoo space identifier. If there is no space ID, the provider space is used.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"mrn": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Integration identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the integration.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(250),
+				},
+			},
+			"webhook_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the incoming webhook (Workflows) of the Microsoft Teams channel.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^https://`),
+						"must be an https URL",
+					),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("app")),
+				},
+			},
+			"app": schema.SingleNestedAttribute{
+				MarkdownDescription: "Channel to post to with the Mondoo app for Microsoft Teams. The app must be installed in the team.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"tenant_id": schema.StringAttribute{
+						MarkdownDescription: "Microsoft Entra ID tenant ID.",
+						Required:            true,
+					},
+					"team_id": schema.StringAttribute{
+						MarkdownDescription: "ID of the team.",
+						Required:            true,
+					},
+					"channel_id": schema.StringAttribute{
+						MarkdownDescription: "ID of the channel, such as `19:abc123@thread.tacv2`.",
+						Required:            true,
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.ExactlyOneOf(path.MatchRoot("webhook_url")),
+				},
+			},
+		},
+	}
+}
+
+func (r *integrationMsTeamsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ExtendedGqlClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client. Got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *integrationMsTeamsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data integrationMsTeamsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Compute and validate the space
+	space, err := r.client.ComputeSpace(data.SpaceID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+		return
+	}
+	ctx = tflog.SetField(ctx, "space_mrn", space.MRN())
+
+	// Do GraphQL request to API to create the resource.
+	tflog.Debug(ctx, "Creating integration")
+	integration, err := r.client.CreateIntegrationWithOptions(ctx,
+		space.MRN(),
+		data.Name.ValueString(),
+		ClientIntegrationTypeMsTeams,
+		ClientIntegrationConfigurationInput{
+			MsTeamsConfigurationOptions: data.GetConfigurationOptions(),
+		})
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to create Microsoft Teams integration. Got error: %s", err),
+			)
+		return
+	}
+
+	// Save space mrn into the Terraform state.
+	data.Mrn = types.StringValue(string(integration.Mrn))
+	data.Name = types.StringValue(string(integration.Name))
+	data.SpaceID = types.StringValue(space.ID())
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *integrationMsTeamsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data integrationMsTeamsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	integration, err := r.client.GetClientIntegration(ctx, data.Mrn.ValueString())
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to read Microsoft Teams integration. Got error: %s", err),
+			)
+		return
+	}
+
+	// detect drift on the app channel, the webhook URL is a secret and not returned by the API
+	options, err := r.client.GetMsTeamsConfigurationOptions(ctx, data.Mrn.ValueString())
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to read Microsoft Teams integration. Got error: %s", err),
+			)
+		return
+	}
+	data.Name = types.StringValue(integration.Name)
+	data.App = msTeamsAppFromPayload(options)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *integrationMsTeamsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data integrationMsTeamsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Do GraphQL request to API to update the resource.
+	opts := ClientIntegrationConfigurationInput{
+		MsTeamsConfigurationOptions: data.GetConfigurationOptions(),
+	}
+
+	_, err := r.client.UpdateIntegrationWithOptions(ctx,
+		data.Mrn.ValueString(),
+		data.Name.ValueString(),
+		ClientIntegrationTypeMsTeams,
+		opts,
+	)
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to update Microsoft Teams integration. Got error: %s", err),
+			)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *integrationMsTeamsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data integrationMsTeamsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Do GraphQL request to API to update the resource.
+	_, err := r.client.DeleteIntegration(ctx, data.Mrn.ValueString())
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to delete Microsoft Teams integration. Got error: %s", err),
+			)
+		return
+	}
+}
+
+func (r *integrationMsTeamsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	integration, spaceID, ok := r.client.ImportSpaceIntegration(ctx, req, resp)
+	if !ok {
+		return
+	}
+
+	options, err := r.client.GetMsTeamsConfigurationOptions(ctx, integration.Mrn)
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to import Microsoft Teams integration. Got error: %s", err),
+			)
+		return
+	}
+
+	model := integrationMsTeamsResourceModel{
+		Mrn:     types.StringValue(integration.Mrn),
+		Name:    types.StringValue(integration.Name),
+		SpaceID: types.StringValue(spaceID),
+		App:     msTeamsAppFromPayload(options),
+	}
+	// the webhook URL is a secret and not returned by the API
+	model.WebhookUrl = types.StringPointerValue(nil)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
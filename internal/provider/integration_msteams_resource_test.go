@@ -0,0 +1,90 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccMsTeamsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccMsTeamsResourceConfig(accSpace.ID(), "one"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mondoo_integration_msteams.test", "name", "one"),
+					resource.TestCheckResourceAttr("mondoo_integration_msteams.test", "space_id", accSpace.ID()),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccMsTeamsResourceWithAppConfig(accSpace.ID(), "two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mondoo_integration_msteams.test", "name", "two"),
+					resource.TestCheckResourceAttr("mondoo_integration_msteams.test", "app.channel_id", "19:abc123@thread.tacv2"),
+					resource.TestCheckNoResourceAttr("mondoo_integration_msteams.test", "webhook_url"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: "mondoo_integration_msteams.test",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["mondoo_integration_msteams.test"].Primary.Attributes["mrn"], nil
+				},
+				ImportStateVerifyIdentifierAttribute: "mrn",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccMsTeamsResourceConfig(spaceID, intName string) string {
+	return fmt.Sprintf(`
+resource "mondoo_integration_msteams" "test" {
+  space_id    = %[1]q
+  name        = %[2]q
+  webhook_url = "https://example.webhook.office.com/webhookb2/abcd1234567890"
+}
+`, spaceID, intName)
+}
+
+func testAccMsTeamsResourceWithAppConfig(spaceID, intName string) string {
+	return fmt.Sprintf(`
+resource "mondoo_integration_msteams" "test" {
+  space_id = %[1]q
+  name     = %[2]q
+
+  app = {
+    tenant_id  = "ffffffff-ffff-ffff-ffff-ffffffffffff"
+    team_id    = "eeeeeeee-eeee-eeee-eeee-eeeeeeeeeeee"
+    channel_id = "19:abc123@thread.tacv2"
+  }
+}
+`, spaceID, intName)
+}
+
+func TestMsTeamsAppFromPayload(t *testing.T) {
+	opts := MsTeamsConfigurationOptions{}
+	assert.Nil(t, msTeamsAppFromPayload(opts))
+
+	opts.App.TenantId = "ffffffff-ffff-ffff-ffff-ffffffffffff"
+	opts.App.TeamId = "eeeeeeee-eeee-eeee-eeee-eeeeeeeeeeee"
+	opts.App.ChannelId = "19:abc123@thread.tacv2"
+	assert.Equal(t, &integrationMsTeamsAppChannelModel{
+		TenantId:  types.StringValue("ffffffff-ffff-ffff-ffff-ffffffffffff"),
+		TeamId:    types.StringValue("eeeeeeee-eeee-eeee-eeee-eeeeeeeeeeee"),
+		ChannelId: types.StringValue("19:abc123@thread.tacv2"),
+	}, msTeamsAppFromPayload(opts))
+}
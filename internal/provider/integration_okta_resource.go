@@ -260,14 +260,14 @@ func (r *integrationOktaResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *integrationOktaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	integration, ok := r.client.ImportIntegration(ctx, req, resp)
+	integration, spaceID, ok := r.client.ImportSpaceIntegration(ctx, req, resp)
 	if !ok {
 		return
 	}
 	model := integrationOktaResourceModel{
 		Mrn:     types.StringValue(integration.Mrn),
 		Name:    types.StringValue(integration.Name),
-		SpaceID: types.StringValue(integration.SpaceID()),
+		SpaceID: types.StringValue(spaceID),
 		// Okta options
 		Organization: types.StringValue(integration.ConfigurationOptions.OktaConfigurationOptions.Organization),
 		Token:        types.StringPointerValue(nil),
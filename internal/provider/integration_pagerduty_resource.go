@@ -0,0 +1,340 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	mondoov1 "go.mondoo.com/mondoo-go"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = (*integrationPagerDutyResource)(nil)
+var _ resource.ResourceWithImportState = (*integrationPagerDutyResource)(nil)
+
+// pagerDutySeverities are the severities of a PagerDuty Events API v2 alert.
+var pagerDutySeverities = []string{"critical", "error", "warning", "info"}
+
+func NewIntegrationPagerDutyResource() resource.Resource {
+	return &integrationPagerDutyResource{}
+}
+
+type integrationPagerDutyResource struct {
+	client *ExtendedGqlClient
+}
+
+type integrationPagerDutyResourceModel struct {
+	// scope
+	SpaceID types.String `tfsdk:"space_id"`
+
+	// integration details
+	Mrn             types.String `tfsdk:"mrn"`
+	Name            types.String `tfsdk:"name"`
+	SeverityMapping types.Map    `tfsdk:"severity_mapping"`
+
+	// credentials
+	RoutingKey types.String `tfsdk:"routing_key"`
+}
+
+func (m integrationPagerDutyResourceModel) GetConfigurationOptions(ctx context.Context) (*PagerDutyConfigurationOptionsInput, diag.Diagnostics) {
+	opts := &PagerDutyConfigurationOptionsInput{
+		RoutingKey:      mondoov1.String(m.RoutingKey.ValueString()),
+		SeverityMapping: []PagerDutySeverityMappingInput{},
+	}
+
+	// send the mapping ordered from the highest to the lowest severity
+	mapping := map[string]string{}
+	diagnostics := m.SeverityMapping.ElementsAs(ctx, &mapping, false)
+	for _, severity := range caseSeverities {
+		if pagerDutySeverity, ok := mapping[severity]; ok {
+			opts.SeverityMapping = append(opts.SeverityMapping, PagerDutySeverityMappingInput{
+				Severity:          mondoov1.String(severity),
+				PagerDutySeverity: mondoov1.String(pagerDutySeverity),
+			})
+		}
+	}
+	return opts, diagnostics
+}
+
+// pagerDutySeverityMappingFromPayload returns the severity mapping of a PagerDuty integration.
+// An integration that uses the default mapping keeps a null mapping when none was configured.
+func pagerDutySeverityMappingFromPayload(ctx context.Context, prior types.Map, mappings []PagerDutySeverityMapping) (types.Map, diag.Diagnostics) {
+	if len(mappings) == 0 && prior.IsNull() {
+		return types.MapNull(types.StringType), nil
+	}
+	mapping := map[string]string{}
+	for _, m := range mappings {
+		mapping[m.Severity] = m.PagerDutySeverity
+	}
+	return types.MapValueFrom(ctx, types.StringType, mapping)
+}
+
+func (r *integrationPagerDutyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration_pagerduty"
+}
+
+func (r *integrationPagerDutyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Send Mondoo findings as alerts to a PagerDuty service using the Events API v2.",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "Mondoo space identifier. If there is no space ID, the provider space is used.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"mrn": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Integration identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the integration.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(250),
+				},
+			},
+			"severity_mapping": schema.MapAttribute{
+				MarkdownDescription: "PagerDuty alert severity by finding severity, such as `{ CRITICAL = \"critical\" }`. Valid keys: `CRITICAL`, `HIGH`, `MEDIUM`, `LOW`, `NONE`. Valid values: `critical`, `error`, `warning`, `info`. Findings with an unmapped severity do not create alerts.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.OneOf(caseSeverities...)),
+					mapvalidator.ValueStringsAre(stringvalidator.OneOf(pagerDutySeverities...)),
+				},
+			},
+			"routing_key": schema.StringAttribute{
+				MarkdownDescription: "Integration key (routing key) of the Events API v2 integration of the PagerDuty service.",
+				Required:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z0-9]{32}$`),
+						"must be a 32 character PagerDuty integration key",
+					),
+				},
+			},
+		},
+	}
+}
+
+func (r *integrationPagerDutyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ExtendedGqlClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client. Got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *integrationPagerDutyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data integrationPagerDutyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Compute and validate the space
+	space, err := r.client.ComputeSpace(data.SpaceID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+		return
+	}
+	ctx = tflog.SetField(ctx, "space_mrn", space.MRN())
+
+	opts, diags := data.GetConfigurationOptions(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Do GraphQL request to API to create the resource.
+	tflog.Debug(ctx, "Creating integration")
+	integration, err := r.client.CreateIntegrationWithOptions(ctx,
+		space.MRN(),
+		data.Name.ValueString(),
+		ClientIntegrationTypePagerDuty,
+		ClientIntegrationConfigurationInput{
+			PagerDutyConfigurationOptions: opts,
+		})
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to create PagerDuty integration. Got error: %s", err),
+			)
+		return
+	}
+
+	// Save space mrn into the Terraform state.
+	data.Mrn = types.StringValue(string(integration.Mrn))
+	data.Name = types.StringValue(string(integration.Name))
+	data.SpaceID = types.StringValue(space.ID())
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *integrationPagerDutyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data integrationPagerDutyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	integration, err := r.client.GetClientIntegration(ctx, data.Mrn.ValueString())
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to read PagerDuty integration. Got error: %s", err),
+			)
+		return
+	}
+
+	// detect drift on the severity mapping, the routing key is a secret and not returned by the API
+	options, err := r.client.GetPagerDutyConfigurationOptions(ctx, data.Mrn.ValueString())
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to read PagerDuty integration. Got error: %s", err),
+			)
+		return
+	}
+	severityMapping, diags := pagerDutySeverityMappingFromPayload(ctx, data.SeverityMapping, options.SeverityMapping)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Name = types.StringValue(integration.Name)
+	data.SeverityMapping = severityMapping
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *integrationPagerDutyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data integrationPagerDutyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pagerDutyOpts, diags := data.GetConfigurationOptions(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Do GraphQL request to API to update the resource.
+	opts := ClientIntegrationConfigurationInput{
+		PagerDutyConfigurationOptions: pagerDutyOpts,
+	}
+
+	_, err := r.client.UpdateIntegrationWithOptions(ctx,
+		data.Mrn.ValueString(),
+		data.Name.ValueString(),
+		ClientIntegrationTypePagerDuty,
+		opts,
+	)
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to update PagerDuty integration. Got error: %s", err),
+			)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *integrationPagerDutyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data integrationPagerDutyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Do GraphQL request to API to update the resource.
+	_, err := r.client.DeleteIntegration(ctx, data.Mrn.ValueString())
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to delete PagerDuty integration. Got error: %s", err),
+			)
+		return
+	}
+}
+
+func (r *integrationPagerDutyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	integration, spaceID, ok := r.client.ImportSpaceIntegration(ctx, req, resp)
+	if !ok {
+		return
+	}
+
+	options, err := r.client.GetPagerDutyConfigurationOptions(ctx, integration.Mrn)
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to import PagerDuty integration. Got error: %s", err),
+			)
+		return
+	}
+	severityMapping, diags := pagerDutySeverityMappingFromPayload(ctx, types.MapNull(types.StringType), options.SeverityMapping)
+	resp.Diagnostics.Append(diags...)
+
+	model := integrationPagerDutyResourceModel{
+		Mrn:             types.StringValue(integration.Mrn),
+		Name:            types.StringValue(integration.Name),
+		SpaceID:         types.StringValue(spaceID),
+		SeverityMapping: severityMapping,
+		RoutingKey:      types.StringPointerValue(nil),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
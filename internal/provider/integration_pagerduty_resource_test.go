@@ -0,0 +1,118 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	mondoov1 "go.mondoo.com/mondoo-go"
+)
+
+func TestAccPagerDutyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccPagerDutyResourceConfig(accSpace.ID(), "one", "critical"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mondoo_integration_pagerduty.test", "name", "one"),
+					resource.TestCheckResourceAttr("mondoo_integration_pagerduty.test", "space_id", accSpace.ID()),
+					resource.TestCheckResourceAttr("mondoo_integration_pagerduty.test", "severity_mapping.CRITICAL", "critical"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccPagerDutyResourceConfig(accSpace.ID(), "two", "error"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mondoo_integration_pagerduty.test", "name", "two"),
+					resource.TestCheckResourceAttr("mondoo_integration_pagerduty.test", "severity_mapping.CRITICAL", "error"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: "mondoo_integration_pagerduty.test",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["mondoo_integration_pagerduty.test"].Primary.Attributes["mrn"], nil
+				},
+				ImportStateVerifyIdentifierAttribute: "mrn",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIgnore:              []string{"routing_key"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccPagerDutyResourceConfig(spaceID, intName, criticalSeverity string) string {
+	return fmt.Sprintf(`
+resource "mondoo_integration_pagerduty" "test" {
+  space_id    = %[1]q
+  name        = %[2]q
+  routing_key = "0123456789abcdef0123456789abcdef"
+
+  severity_mapping = {
+    CRITICAL = %[3]q
+    HIGH     = "warning"
+  }
+}
+`, spaceID, intName, criticalSeverity)
+}
+
+func TestIntegrationPagerDutyConfigurationOptions(t *testing.T) {
+	model := integrationPagerDutyResourceModel{
+		RoutingKey: types.StringValue("0123456789abcdef0123456789abcdef"),
+		SeverityMapping: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"LOW":      types.StringValue("info"),
+			"CRITICAL": types.StringValue("critical"),
+			"HIGH":     types.StringValue("error"),
+		}),
+	}
+
+	opts, diags := model.GetConfigurationOptions(context.Background())
+	require.False(t, diags.HasError())
+	assert.Equal(t, mondoov1.String("0123456789abcdef0123456789abcdef"), opts.RoutingKey)
+	assert.Equal(t, []PagerDutySeverityMappingInput{
+		{Severity: "CRITICAL", PagerDutySeverity: "critical"},
+		{Severity: "HIGH", PagerDutySeverity: "error"},
+		{Severity: "LOW", PagerDutySeverity: "info"},
+	}, opts.SeverityMapping)
+
+	model.SeverityMapping = types.MapNull(types.StringType)
+	opts, diags = model.GetConfigurationOptions(context.Background())
+	require.False(t, diags.HasError())
+	assert.Empty(t, opts.SeverityMapping)
+}
+
+func TestPagerDutySeverityMappingFromPayload(t *testing.T) {
+	mapping, diags := pagerDutySeverityMappingFromPayload(context.Background(), types.MapNull(types.StringType), []PagerDutySeverityMapping{
+		{Severity: "CRITICAL", PagerDutySeverity: "critical"},
+		{Severity: "LOW", PagerDutySeverity: "info"},
+	})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, types.MapValueMust(types.StringType, map[string]attr.Value{
+		"CRITICAL": types.StringValue("critical"),
+		"LOW":      types.StringValue("info"),
+	}), mapping)
+
+	mapping, diags = pagerDutySeverityMappingFromPayload(context.Background(), types.MapNull(types.StringType), nil)
+	assert.False(t, diags.HasError())
+	assert.True(t, mapping.IsNull())
+
+	// an empty mapping stays empty
+	empty := types.MapValueMust(types.StringType, map[string]attr.Value{})
+	mapping, diags = pagerDutySeverityMappingFromPayload(context.Background(), empty, nil)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, empty, mapping)
+}
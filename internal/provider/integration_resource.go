@@ -0,0 +1,525 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	mondoov1 "go.mondoo.com/mondoo-go"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = (*integrationResource)(nil)
+var _ resource.ResourceWithImportState = (*integrationResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*integrationResource)(nil)
+
+func NewIntegrationResource() resource.Resource {
+	return &integrationResource{}
+}
+
+type integrationResource struct {
+	client *ExtendedGqlClient
+}
+
+type integrationResourceModel struct {
+	// scope
+	SpaceID types.String `tfsdk:"space_id"`
+
+	// integration details
+	Mrn           types.String         `tfsdk:"mrn"`
+	Name          types.String         `tfsdk:"name"`
+	Type          types.String         `tfsdk:"type"`
+	Configuration jsontypes.Normalized `tfsdk:"configuration"`
+
+	// credentials
+	SensitiveConfiguration jsontypes.Normalized `tfsdk:"sensitive_configuration"`
+}
+
+// GetConfigurationOptions merges the sensitive configuration into the configuration and
+// decodes the result into the configuration input of the API.
+func (m integrationResourceModel) GetConfigurationOptions() (ClientIntegrationConfigurationInput, error) {
+	return integrationConfigurationFromJSON(m.Configuration.ValueString(), m.SensitiveConfiguration.ValueString())
+}
+
+// integrationConfigurationFromJSON decodes JSON documents into a ClientIntegrationConfigurationInput.
+// The documents are merged in order, keys of later documents take precedence. Keys that are not part
+// of the input, for example a typo in an option name, are reported as errors.
+func integrationConfigurationFromJSON(documents ...string) (ClientIntegrationConfigurationInput, error) {
+	var opts ClientIntegrationConfigurationInput
+
+	merged := map[string]interface{}{}
+	for _, document := range documents {
+		if document == "" {
+			continue
+		}
+		values := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(document), &values); err != nil {
+			return opts, fmt.Errorf("must be a JSON object: %w", err)
+		}
+		mergeJSONObjects(merged, values)
+	}
+
+	data, err := json.Marshal(merged)
+	if err != nil {
+		return opts, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&opts); err != nil {
+		return opts, err
+	}
+	return opts, nil
+}
+
+// integrationConfigurationReaders read the configuration options that are not part of the
+// query shared by all integrations, keyed by the configuration of the integration type.
+var integrationConfigurationReaders = map[string]func(ctx context.Context, c *ExtendedGqlClient, mrn string) (interface{}, error){
+	"jiraConfigurationOptions": func(ctx context.Context, c *ExtendedGqlClient, mrn string) (interface{}, error) {
+		return c.GetJiraIssueConfigurationOptions(ctx, mrn)
+	},
+	"k8sConfigurationOptions": func(ctx context.Context, c *ExtendedGqlClient, mrn string) (interface{}, error) {
+		return c.GetK8sConfigurationOptions(ctx, mrn)
+	},
+	"msTeamsConfigurationOptions": func(ctx context.Context, c *ExtendedGqlClient, mrn string) (interface{}, error) {
+		return c.GetMsTeamsConfigurationOptions(ctx, mrn)
+	},
+	"pagerDutyConfigurationOptions": func(ctx context.Context, c *ExtendedGqlClient, mrn string) (interface{}, error) {
+		return c.GetPagerDutyConfigurationOptions(ctx, mrn)
+	},
+	"webhookConfigurationOptions": func(ctx context.Context, c *ExtendedGqlClient, mrn string) (interface{}, error) {
+		return c.GetWebhookConfigurationOptions(ctx, mrn)
+	},
+}
+
+// readConfigurationOptions reads the configuration options of the integration from the API
+// as a JSON object that uses the field names of the configuration input.
+func (r *integrationResource) readConfigurationOptions(ctx context.Context, integration Integration, configuration map[string]interface{}) (map[string]interface{}, error) {
+	options, err := jsonObjectFromOptions(integration.ConfigurationOptions)
+	if err != nil {
+		return nil, err
+	}
+	for key := range configuration {
+		reader, ok := integrationConfigurationReaders[key]
+		if !ok {
+			continue
+		}
+		value, err := reader(ctx, r.client, integration.Mrn)
+		if err != nil {
+			return nil, err
+		}
+		object, err := jsonObjectFromOptions(value)
+		if err != nil {
+			return nil, err
+		}
+		if existing, ok := options[key].(map[string]interface{}); ok {
+			mergeJSONObjects(existing, object)
+		} else {
+			options[key] = object
+		}
+	}
+	return options, nil
+}
+
+// refreshIntegrationConfiguration updates the configured JSON document with the options read
+// from the API. Only the keys of the document are refreshed, keys that the API doesn't return,
+// like secrets, keep their configured value.
+func refreshIntegrationConfiguration(configuration string, options map[string]interface{}) (string, error) {
+	values := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(configuration), &values); err != nil {
+		return "", err
+	}
+	data, err := json.Marshal(refreshJSONValue(values, options))
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// refreshJSONValue returns src for the value dst, objects are refreshed per key and lists of
+// objects per element when both lists have the same length.
+func refreshJSONValue(dst, src interface{}) interface{} {
+	switch dstValue := dst.(type) {
+	case map[string]interface{}:
+		srcObject, ok := src.(map[string]interface{})
+		if !ok {
+			return src
+		}
+		for key, value := range dstValue {
+			if srcValue, ok := srcObject[key]; ok {
+				dstValue[key] = refreshJSONValue(value, srcValue)
+			}
+		}
+		return dstValue
+	case []interface{}:
+		srcList, ok := src.([]interface{})
+		if !ok || len(srcList) != len(dstValue) {
+			return src
+		}
+		for i := range dstValue {
+			dstValue[i] = refreshJSONValue(dstValue[i], srcList[i])
+		}
+		return dstValue
+	default:
+		return src
+	}
+}
+
+// jsonObjectFromOptions encodes configuration options read from the API as a JSON object. The
+// read types don't declare JSON names, their field names are converted to the lower camel case
+// names of the configuration input.
+func jsonObjectFromOptions(options interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(options)
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	object, ok := lowerCamelCaseJSONKeys(value).(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("configuration options are not a JSON object")
+	}
+	return object, nil
+}
+
+func lowerCamelCaseJSONKeys(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		object := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			first, size := utf8.DecodeRuneInString(key)
+			object[string(unicode.ToLower(first))+key[size:]] = lowerCamelCaseJSONKeys(item)
+		}
+		return object
+	case []interface{}:
+		for i := range v {
+			v[i] = lowerCamelCaseJSONKeys(v[i])
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// mergeJSONObjects merges src into dst, nested objects are merged recursively.
+func mergeJSONObjects(dst, src map[string]interface{}) {
+	for key, value := range src {
+		srcObject, srcOk := value.(map[string]interface{})
+		dstObject, dstOk := dst[key].(map[string]interface{})
+		if srcOk && dstOk {
+			mergeJSONObjects(dstObject, srcObject)
+			continue
+		}
+		dst[key] = value
+	}
+}
+
+func (r *integrationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration"
+}
+
+func (r *integrationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manages an integration of any type supported by the Mondoo API.
+
+Use this resource for integration types that do not have a dedicated resource yet. The ` + "`configuration`" + ` maps onto the ` + "`ClientIntegrationConfigurationInput`" + ` of the Mondoo GraphQL API, secrets go into ` + "`sensitive_configuration`" + ` so that they are not shown in plans.`,
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "Mondoo space identifier. If there is no space ID, the provider space is used.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"mrn": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Integration identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the integration.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(250),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Integration type, such as `OKTA` or `HOSTED_SLACK`. Any `ClientIntegrationType` of the Mondoo API is accepted.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[A-Z][A-Z0-9_]*$`),
+						"must be an integration type in upper snake case, such as OKTA",
+					),
+				},
+			},
+			"configuration": schema.StringAttribute{
+				MarkdownDescription: "JSON encoded configuration options of the integration, such as `jsonencode({ oktaConfigurationOptions = { organization = \"example.okta.com\" } })`. Keys use the field names of the GraphQL API. The configured options are refreshed from the API, so changes made outside of Terraform show up in the plan.",
+				CustomType:          jsontypes.NormalizedType{},
+				Required:            true,
+			},
+			"sensitive_configuration": schema.StringAttribute{
+				MarkdownDescription: "JSON encoded configuration options that contain secrets, such as tokens or keys. They are merged into `configuration` before the integration is created or updated. The API does not return them, so they are not imported.",
+				CustomType:          jsontypes.NormalizedType{},
+				Optional:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *integrationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data integrationResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateIntegrationResourceModel(&data)...)
+}
+
+func validateIntegrationResourceModel(data *integrationResourceModel) (diagnostics diag.Diagnostics) {
+	documents := []struct {
+		attribute string
+		value     jsontypes.Normalized
+	}{
+		{"configuration", data.Configuration},
+		{"sensitive_configuration", data.SensitiveConfiguration},
+	}
+	for _, document := range documents {
+		if document.value.IsNull() || document.value.IsUnknown() {
+			continue
+		}
+		if _, err := integrationConfigurationFromJSON(document.value.ValueString()); err != nil {
+			diagnostics.AddAttributeError(
+				path.Root(document.attribute),
+				"InvalidAttributeError",
+				fmt.Sprintf("Invalid integration configuration: %s", err),
+			)
+		}
+	}
+	return
+}
+
+func (r *integrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ExtendedGqlClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client. Got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *integrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data integrationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Compute and validate the space
+	space, err := r.client.ComputeSpace(data.SpaceID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+		return
+	}
+	ctx = tflog.SetField(ctx, "space_mrn", space.MRN())
+
+	opts, err := data.GetConfigurationOptions()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+		return
+	}
+
+	// Do GraphQL request to API to create the resource.
+	tflog.Debug(ctx, "Creating integration", map[string]interface{}{"type": data.Type.ValueString()})
+	integration, err := r.client.CreateIntegrationWithOptions(ctx,
+		space.MRN(),
+		data.Name.ValueString(),
+		mondoov1.ClientIntegrationType(data.Type.ValueString()),
+		opts,
+	)
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to create %s integration. Got error: %s", data.Type.ValueString(), err),
+			)
+		return
+	}
+
+	// Save space mrn into the Terraform state.
+	data.Mrn = types.StringValue(string(integration.Mrn))
+	data.Name = types.StringValue(string(integration.Name))
+	data.SpaceID = types.StringValue(space.ID())
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *integrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data integrationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	integration, err := r.client.GetClientIntegration(ctx, data.Mrn.ValueString())
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to read integration. Got error: %s", err),
+			)
+		return
+	}
+	data.Name = types.StringValue(integration.Name)
+
+	// Refresh the configured options, the configuration is not known after an import
+	if !data.Configuration.IsNull() {
+		configuration := map[string]interface{}{}
+		resp.Diagnostics.Append(data.Configuration.Unmarshal(&configuration)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		options, err := r.readConfigurationOptions(ctx, integration, configuration)
+		if err != nil {
+			resp.Diagnostics.
+				AddError("Client Error",
+					fmt.Sprintf("Unable to read %s integration configuration. Got error: %s", data.Type.ValueString(), err),
+				)
+			return
+		}
+		refreshed, err := refreshIntegrationConfiguration(data.Configuration.ValueString(), options)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+			return
+		}
+		data.Configuration = jsontypes.NewNormalizedValue(refreshed)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *integrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data integrationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts, err := data.GetConfigurationOptions()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+		return
+	}
+
+	// Do GraphQL request to API to update the resource.
+	_, err = r.client.UpdateIntegrationWithOptions(ctx,
+		data.Mrn.ValueString(),
+		data.Name.ValueString(),
+		mondoov1.ClientIntegrationType(data.Type.ValueString()),
+		opts,
+	)
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to update %s integration. Got error: %s", data.Type.ValueString(), err),
+			)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *integrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data integrationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Do GraphQL request to API to update the resource.
+	_, err := r.client.DeleteIntegration(ctx, data.Mrn.ValueString())
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to delete %s integration. Got error: %s", data.Type.ValueString(), err),
+			)
+		return
+	}
+}
+
+func (r *integrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	integration, spaceID, ok := r.client.ImportSpaceIntegration(ctx, req, resp)
+	if !ok {
+		return
+	}
+
+	// the configuration options are typed per integration and the secrets are not returned
+	// by the API, both have to be added to the configuration after the import
+	model := integrationResourceModel{
+		Mrn:                    types.StringValue(integration.Mrn),
+		Name:                   types.StringValue(integration.Name),
+		SpaceID:                types.StringValue(spaceID),
+		Type:                   types.StringValue(integration.Type),
+		Configuration:          jsontypes.NewNormalizedNull(),
+		SensitiveConfiguration: jsontypes.NewNormalizedNull(),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
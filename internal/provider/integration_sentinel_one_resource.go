@@ -293,14 +293,14 @@ func (r *integrationSentinelOneResource) Delete(ctx context.Context, req resourc
 }
 
 func (r *integrationSentinelOneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	integration, ok := r.client.ImportIntegration(ctx, req, resp)
+	integration, spaceID, ok := r.client.ImportSpaceIntegration(ctx, req, resp)
 	if !ok {
 		return
 	}
 	model := integrationSentinelOneResourceModel{
 		Mrn:     types.StringValue(integration.Mrn),
 		Name:    types.StringValue(integration.Name),
-		SpaceID: types.StringValue(integration.SpaceID()),
+		SpaceID: types.StringValue(spaceID),
 		// SentinelOne options
 		Host:    types.StringValue(integration.ConfigurationOptions.SentinelOneConfigurationOptions.Host),
 		Account: types.StringValue(integration.ConfigurationOptions.SentinelOneConfigurationOptions.Account),
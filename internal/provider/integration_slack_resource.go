@@ -7,21 +7,28 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"sort"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	mondoov1 "go.mondoo.com/mondoo-go"
+	"go.mondoo.com/terraform-provider-mondoo/internal/mrn"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = (*integrationSlackResource)(nil)
 var _ resource.ResourceWithImportState = (*integrationSlackResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*integrationSlackResource)(nil)
 
 func NewIntegrationSlackResource() resource.Resource {
 	return &integrationSlackResource{}
@@ -36,13 +43,109 @@ type integrationSlackResourceModel struct {
 	SpaceID types.String `tfsdk:"space_id"`
 
 	// integration details
-	Mrn  types.String `tfsdk:"mrn"`
-	Name types.String `tfsdk:"name"`
+	Mrn      types.String                   `tfsdk:"mrn"`
+	Name     types.String                   `tfsdk:"name"`
+	Channels []integrationSlackChannelModel `tfsdk:"channel"`
 
 	// credentials
 	SlackToken types.String `tfsdk:"slack_token"`
 }
 
+type integrationSlackChannelModel struct {
+	ChannelId         types.String `tfsdk:"channel_id"`
+	SeverityThreshold types.String `tfsdk:"severity_threshold"`
+	EventTypes        types.Set    `tfsdk:"event_types"`
+	SpaceIds          types.Set    `tfsdk:"space_ids"`
+	WorkspaceMrns     types.Set    `tfsdk:"workspace_mrns"`
+}
+
+func (m integrationSlackResourceModel) GetConfigurationOptions(ctx context.Context) (*SlackConfigurationOptionsInput, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+	opts := &SlackConfigurationOptionsInput{
+		SlackToken: mondoov1.NewStringPtr(mondoov1.String(m.SlackToken.ValueString())),
+	}
+	if len(m.Channels) == 0 {
+		// channels that are not configured are managed in the Mondoo Console
+		return opts, diagnostics
+	}
+
+	channels := []SlackChannelInput{}
+	for _, channel := range m.Channels {
+		events := []string{}
+		diagnostics.Append(channel.EventTypes.ElementsAs(ctx, &events, false)...)
+		sort.Strings(events)
+		eventTypes := []SlackChannelEvent{}
+		for _, event := range events {
+			eventTypes = append(eventTypes, SlackChannelEvent(event))
+		}
+
+		spaceIds := []string{}
+		diagnostics.Append(channel.SpaceIds.ElementsAs(ctx, &spaceIds, false)...)
+		spaceMrns := []mondoov1.String{}
+		for _, spaceID := range spaceIds {
+			space, err := SpaceFrom(spaceID)
+			if err != nil {
+				diagnostics.AddError("Invalid Configuration", err.Error())
+				continue
+			}
+			spaceMrns = append(spaceMrns, mondoov1.String(space.MRN()))
+		}
+
+		workspaceMrns := []mondoov1.String{}
+		diagnostics.Append(channel.WorkspaceMrns.ElementsAs(ctx, &workspaceMrns, false)...)
+
+		channels = append(channels, SlackChannelInput{
+			ChannelId:         mondoov1.String(channel.ChannelId.ValueString()),
+			SeverityThreshold: mondoov1.String(channel.SeverityThreshold.ValueString()),
+			EventTypes:        eventTypes,
+			SpaceMrns:         spaceMrns,
+			WorkspaceMrns:     workspaceMrns,
+		})
+	}
+	opts.Channels = &channels
+	return opts, diagnostics
+}
+
+// slackChannelsFromPayload converts the channels returned by the API into the model, the
+// space filters are stored as space IDs the same way they are configured.
+func slackChannelsFromPayload(ctx context.Context, channels []SlackChannel) ([]integrationSlackChannelModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	models := []integrationSlackChannelModel{}
+
+	optionalSet := func(values []string) types.Set {
+		if len(values) == 0 {
+			return types.SetNull(types.StringType)
+		}
+		set, d := types.SetValueFrom(ctx, types.StringType, values)
+		diags.Append(d...)
+		return set
+	}
+
+	for _, channel := range channels {
+		eventTypes, d := types.SetValueFrom(ctx, types.StringType, channel.EventTypes)
+		diags.Append(d...)
+
+		spaceIds := make([]string, len(channel.SpaceMrns))
+		for i, spaceMrn := range channel.SpaceMrns {
+			space, err := SpaceFrom(spaceMrn)
+			if err != nil {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to read channel %s. Got error: %s", channel.ChannelId, err))
+				continue
+			}
+			spaceIds[i] = space.ID()
+		}
+
+		models = append(models, integrationSlackChannelModel{
+			ChannelId:         types.StringValue(channel.ChannelId),
+			SeverityThreshold: types.StringValue(channel.SeverityThreshold),
+			EventTypes:        eventTypes,
+			SpaceIds:          optionalSet(spaceIds),
+			WorkspaceMrns:     optionalSet(channel.WorkspaceMrns),
+		})
+	}
+	return models, diags
+}
+
 func (r *integrationSlackResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_integration_slack"
 }
@@ -85,7 +188,109 @@ func (r *integrationSlackResource) Schema(ctx context.Context, req resource.Sche
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"channel": schema.ListNestedBlock{
+				MarkdownDescription: "Slack channel that receives alerts. Each channel selects the alerts it receives with a severity threshold, event types and optional space or workspace filters. If no channel is configured, the channels are managed in the Mondoo Console.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"channel_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the Slack channel, such as `C0123456789`. The Mondoo app must be a member of private channels.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(
+									regexp.MustCompile(`^[CG][A-Z0-9]{8,}$`),
+									"must be a Slack channel ID, such as C0123456789",
+								),
+							},
+						},
+						"severity_threshold": schema.StringAttribute{
+							MarkdownDescription: "Lowest severity of the findings posted to the channel. Valid values: `CRITICAL`, `HIGH`, `MEDIUM`, `LOW`, `NONE`. Defaults to `HIGH`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("HIGH"),
+							Validators: []validator.String{
+								stringvalidator.OneOf(caseSeverities...),
+							},
+						},
+						"event_types": schema.SetAttribute{
+							MarkdownDescription: "Events posted to the channel. Valid values: `NEW_FINDING`, `SCAN_FAILURE`, `SCORE_DROP`.",
+							Required:            true,
+							ElementType:         types.StringType,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.ValueStringsAre(stringvalidator.OneOf(
+									string(SlackChannelEventNewFinding),
+									string(SlackChannelEventScanFailure),
+									string(SlackChannelEventScoreDrop),
+								)),
+							},
+						},
+						"space_ids": schema.SetAttribute{
+							MarkdownDescription: "Only post alerts for assets in these spaces.",
+							Optional:            true,
+							ElementType:         types.StringType,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+						},
+						"workspace_mrns": schema.SetAttribute{
+							MarkdownDescription: "Only post alerts for assets in these workspaces.",
+							Optional:            true,
+							ElementType:         types.StringType,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *integrationSlackResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data integrationSlackResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+
+	resp.Diagnostics.Append(validateIntegrationSlackResourceModel(ctx, &data)...)
+}
+
+func validateIntegrationSlackResourceModel(ctx context.Context, data *integrationSlackResourceModel) (diagnostics diag.Diagnostics) {
+	channelIds := map[string]bool{}
+	for i, channel := range data.Channels {
+		channelPath := path.Root("channel").AtListIndex(i)
+
+		if !channel.ChannelId.IsUnknown() {
+			if channelIds[channel.ChannelId.ValueString()] {
+				diagnostics.AddAttributeError(
+					channelPath.AtName("channel_id"),
+					"InvalidAttributeError",
+					fmt.Sprintf("Channel %s is configured more than once. Merge the event types of the channel blocks.", channel.ChannelId.ValueString()),
+				)
+			}
+			channelIds[channel.ChannelId.ValueString()] = true
+		}
+
+		workspaceMrns := []string{}
+		if channel.WorkspaceMrns.IsUnknown() {
+			continue
+		}
+		diagnostics.Append(channel.WorkspaceMrns.ElementsAs(ctx, &workspaceMrns, true)...)
+		for _, workspaceMrn := range workspaceMrns {
+			if m, err := mrn.Parse(workspaceMrn); err != nil || m.Type != "workspaces" {
+				diagnostics.AddAttributeError(
+					channelPath.AtName("workspace_mrns"),
+					"InvalidAttributeError",
+					fmt.Sprintf("%q is not a workspace MRN, such as //captain.api.mondoo.app/spaces/hungry-poet-123456/workspaces/production.", workspaceMrn),
+				)
+			}
+		}
+	}
+	return
 }
 
 func (r *integrationSlackResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -126,16 +331,20 @@ func (r *integrationSlackResource) Create(ctx context.Context, req resource.Crea
 	}
 	ctx = tflog.SetField(ctx, "space_mrn", space.MRN())
 
+	opts, diags := data.GetConfigurationOptions(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Do GraphQL request to API to create the resource.
 	tflog.Debug(ctx, "Creating integration")
-	integration, err := r.client.CreateIntegration(ctx,
+	integration, err := r.client.CreateIntegrationWithOptions(ctx,
 		space.MRN(),
 		data.Name.ValueString(),
 		mondoov1.ClientIntegrationTypeHostedSlack,
-		mondoov1.ClientIntegrationConfigurationInput{
-			SlackConfigurationOptions: &mondoov1.SlackConfigurationOptionsInput{
-				SlackToken: mondoov1.NewStringPtr(mondoov1.String(data.SlackToken.ValueString())),
-			},
+		ClientIntegrationConfigurationInput{
+			SlackConfigurationOptions: opts,
 		})
 	if err != nil {
 		resp.Diagnostics.
@@ -176,30 +385,70 @@ func (r *integrationSlackResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	// Read API call logic
+	integration, err := r.client.GetClientIntegration(ctx, data.Mrn.ValueString())
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to read Slack integration. Got error: %s", err),
+			)
+		return
+	}
+
+	// detect drift on the channel routing of managed channels, the token is a secret and not returned by the API
+	data.Name = types.StringValue(integration.Name)
+	if len(data.Channels) > 0 {
+		payload, err := r.client.GetSlackChannels(ctx, data.Mrn.ValueString())
+		if err != nil {
+			resp.Diagnostics.
+				AddError("Client Error",
+					fmt.Sprintf("Unable to read Slack integration. Got error: %s", err),
+				)
+			return
+		}
+		channels, diags := slackChannelsFromPayload(ctx, payload)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Channels = channels
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *integrationSlackResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data integrationSlackResourceModel
+	var data, state integrationSlackResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and prior state data into the models
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	slackOpts, diags := data.GetConfigurationOptions(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if slackOpts.Channels == nil && len(state.Channels) > 0 {
+		// the channels were removed from the configuration
+		slackOpts.Channels = &[]SlackChannelInput{}
+	}
+
 	// Do GraphQL request to API to update the resource.
-	opts := mondoov1.ClientIntegrationConfigurationInput{
-		SlackConfigurationOptions: &mondoov1.SlackConfigurationOptionsInput{
-			SlackToken: mondoov1.NewStringPtr(mondoov1.String(data.SlackToken.ValueString())),
-		},
+	opts := ClientIntegrationConfigurationInput{
+		SlackConfigurationOptions: slackOpts,
 	}
 
 	// Do GraphQL request to API to update the resource.
-	_, err := r.client.UpdateIntegration(ctx,
+	_, err := r.client.UpdateIntegrationWithOptions(ctx,
 		data.Mrn.ValueString(),
 		data.Name.ValueString(),
 		mondoov1.ClientIntegrationTypeHostedSlack,
@@ -239,17 +488,18 @@ func (r *integrationSlackResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *integrationSlackResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	integration, ok := r.client.ImportIntegration(ctx, req, resp)
+	integration, spaceID, ok := r.client.ImportSpaceIntegration(ctx, req, resp)
 	if !ok {
 		return
 	}
 
+	// channels are only managed once they are configured, so the imported integration keeps its channels
 	model := integrationSlackResourceModel{
 		Mrn:        types.StringValue(integration.Mrn),
 		Name:       types.StringValue(integration.Name),
-		SpaceID:    types.StringValue(integration.SpaceID()),
+		SpaceID:    types.StringValue(spaceID),
 		SlackToken: types.StringPointerValue(nil),
 	}
 
-	resp.State.Set(ctx, &model)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 }
@@ -0,0 +1,573 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	mondoov1 "go.mondoo.com/mondoo-go"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = (*integrationWebhookResource)(nil)
+var _ resource.ResourceWithImportState = (*integrationWebhookResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*integrationWebhookResource)(nil)
+
+const (
+	// webhookSignatureHeader carries the HMAC-SHA256 signature of the request body.
+	webhookSignatureHeader = "X-Mondoo-Signature"
+	// webhookTestEventType is the type of the event sent by send_test_event.
+	webhookTestEventType = "com.mondoo.integration.test"
+)
+
+// webhookHTTPClient sends the test events of send_test_event.
+var webhookHTTPClient = &http.Client{Timeout: 10 * time.Second}
+
+func NewIntegrationWebhookResource() resource.Resource {
+	return &integrationWebhookResource{}
+}
+
+type integrationWebhookResource struct {
+	client *ExtendedGqlClient
+}
+
+type integrationWebhookResourceModel struct {
+	// scope
+	SpaceID types.String `tfsdk:"space_id"`
+
+	// integration details
+	Mrn           types.String `tfsdk:"mrn"`
+	Name          types.String `tfsdk:"name"`
+	Url           types.String `tfsdk:"url"`
+	Headers       types.Map    `tfsdk:"headers"`
+	Events        types.Set    `tfsdk:"events"`
+	PayloadFormat types.String `tfsdk:"payload_format"`
+	SendTestEvent types.Bool   `tfsdk:"send_test_event"`
+
+	// credentials
+	Secret types.String `tfsdk:"secret"`
+}
+
+func (m integrationWebhookResourceModel) GetConfigurationOptions(ctx context.Context) (*WebhookConfigurationOptionsInput, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+	opts := &WebhookConfigurationOptionsInput{
+		Url:           mondoov1.String(m.Url.ValueString()),
+		Headers:       []WebhookHeaderInput{},
+		Events:        []WebhookEvent{},
+		PayloadFormat: WebhookPayloadFormat(m.PayloadFormat.ValueString()),
+	}
+
+	headers := map[string]string{}
+	diagnostics.Append(m.Headers.ElementsAs(ctx, &headers, false)...)
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		opts.Headers = append(opts.Headers, WebhookHeaderInput{
+			Name:  mondoov1.String(name),
+			Value: mondoov1.String(headers[name]),
+		})
+	}
+
+	events := []string{}
+	diagnostics.Append(m.Events.ElementsAs(ctx, &events, false)...)
+	sort.Strings(events)
+	for _, event := range events {
+		opts.Events = append(opts.Events, WebhookEvent(event))
+	}
+
+	if !m.Secret.IsNull() {
+		opts.Secret = mondoov1.NewStringPtr(mondoov1.String(m.Secret.ValueString()))
+	}
+	return opts, diagnostics
+}
+
+// webhookHeadersFromPayload returns the headers of a webhook integration. The API only returns
+// the header names, values are kept from the prior state and new headers get an empty value.
+func webhookHeadersFromPayload(ctx context.Context, prior types.Map, headers []WebhookHeader) (types.Map, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+	if len(headers) == 0 && prior.IsNull() {
+		return types.MapNull(types.StringType), diagnostics
+	}
+
+	priorValues := map[string]string{}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		diagnostics.Append(prior.ElementsAs(ctx, &priorValues, false)...)
+	}
+
+	values := make(map[string]string, len(headers))
+	for _, header := range headers {
+		values[header.Name] = priorValues[header.Name]
+	}
+	m, diags := types.MapValueFrom(ctx, types.StringType, values)
+	diagnostics.Append(diags...)
+	return m, diagnostics
+}
+
+// webhookPayloadFormat returns the payload format of a webhook integration, integrations
+// created before payload formats existed report none and send JSON.
+func webhookPayloadFormat(format string) types.String {
+	if format == "" {
+		format = string(WebhookPayloadFormatJSON)
+	}
+	return types.StringValue(format)
+}
+
+func (r *integrationWebhookResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration_webhook"
+}
+
+func (r *integrationWebhookResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Send Mondoo notifications to an HTTP endpoint, such as an internal event bus.\n\n" +
+			"When a `secret` is set, requests are signed with an HMAC-SHA256 signature of the body in the `X-Mondoo-Signature` header (`sha256=<hex>`).",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "Mondoo space identifier. If there is no space ID, the provider space is used.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"mrn": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Integration identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the integration.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(250),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "URL the notifications are sent to with a `POST` request.",
+				Required:            true,
+			},
+			"headers": schema.MapAttribute{
+				MarkdownDescription: "Additional HTTP headers sent with every request, such as an authorization header.",
+				Optional:            true,
+				Sensitive:           true,
+				ElementType:         types.StringType,
+			},
+			"events": schema.SetAttribute{
+				MarkdownDescription: "Events to send. Valid values: `NEW_CRITICAL_FINDING`, `SCAN_FAILURE`, `SCORE_DROP`.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(
+						string(WebhookEventNewCriticalFinding),
+						string(WebhookEventScanFailure),
+						string(WebhookEventScoreDrop),
+					)),
+				},
+			},
+			"payload_format": schema.StringAttribute{
+				MarkdownDescription: "Format of the request body, either `JSON` or `CLOUDEVENTS` (CloudEvents 1.0 in structured mode). Defaults to `JSON`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(WebhookPayloadFormatJSON)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(WebhookPayloadFormatJSON),
+						string(WebhookPayloadFormatCloudEvents),
+					),
+				},
+			},
+			"send_test_event": schema.BoolAttribute{
+				MarkdownDescription: "Send a signed test event to the URL before the integration is created or updated, to verify that the endpoint accepts the requests. The test event is sent by the machine running Terraform. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"secret": schema.StringAttribute{
+				MarkdownDescription: "Secret used to sign the requests with HMAC-SHA256.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(16),
+				},
+			},
+		},
+	}
+}
+
+func (r *integrationWebhookResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ExtendedGqlClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client. Got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *integrationWebhookResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data integrationWebhookResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateIntegrationWebhookResourceModel(&data)...)
+}
+
+func validateIntegrationWebhookResourceModel(data *integrationWebhookResourceModel) (diagnostics diag.Diagnostics) {
+	if data.Url.IsUnknown() || data.Url.IsNull() {
+		return
+	}
+
+	u, err := url.Parse(data.Url.ValueString())
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		diagnostics.AddError(
+			"InvalidAttributeError",
+			fmt.Sprintf("Attribute url must be an absolute http or https URL, got %q.", data.Url.ValueString()),
+		)
+	}
+
+	for name := range data.Headers.Elements() {
+		if http.CanonicalHeaderKey(name) == webhookSignatureHeader {
+			diagnostics.AddError(
+				"InvalidAttributeError",
+				fmt.Sprintf("Attribute headers must not set %s, the header is set from the secret.", webhookSignatureHeader),
+			)
+		}
+	}
+	return
+}
+
+// newWebhookTestEvent renders the body of a test event in the given payload format
+// and returns it together with its content type.
+func newWebhookTestEvent(format WebhookPayloadFormat, source, name string, now time.Time) ([]byte, string, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, "", err
+	}
+
+	data := map[string]string{
+		"integration": name,
+		"message":     "Test event from the Mondoo Terraform provider",
+	}
+
+	if format == WebhookPayloadFormatCloudEvents {
+		body, err := json.Marshal(map[string]any{
+			"specversion":     "1.0",
+			"id":              hex.EncodeToString(id),
+			"source":          source,
+			"type":            webhookTestEventType,
+			"time":            now.UTC().Format(time.RFC3339),
+			"datacontenttype": "application/json",
+			"data":            data,
+		})
+		return body, "application/cloudevents+json", err
+	}
+
+	body, err := json.Marshal(map[string]any{
+		"id":    hex.EncodeToString(id),
+		"type":  webhookTestEventType,
+		"time":  now.UTC().Format(time.RFC3339),
+		"scope": source,
+		"data":  data,
+	})
+	return body, "application/json", err
+}
+
+// signWebhookPayload returns the value of the signature header for the body.
+func signWebhookPayload(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// sendWebhookTestEvent sends a test event to the configured URL and fails if the
+// endpoint does not answer with a 2xx status code.
+func sendWebhookTestEvent(ctx context.Context, client *http.Client, opts *WebhookConfigurationOptionsInput, source, name string) error {
+	body, contentType, err := newWebhookTestEvent(opts.PayloadFormat, source, name, time.Now())
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, string(opts.Url), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for _, header := range opts.Headers {
+		req.Header.Set(string(header.Name), string(header.Value))
+	}
+	req.Header.Set("Content-Type", contentType)
+	if opts.Secret != nil {
+		req.Header.Set(webhookSignatureHeader, signWebhookPayload(string(*opts.Secret), body))
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("endpoint responded with %s", resp.Status)
+	}
+	return nil
+}
+
+func (r *integrationWebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data integrationWebhookResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Compute and validate the space
+	space, err := r.client.ComputeSpace(data.SpaceID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+		return
+	}
+	ctx = tflog.SetField(ctx, "space_mrn", space.MRN())
+
+	opts, diags := data.GetConfigurationOptions(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.SendTestEvent.ValueBool() {
+		tflog.Debug(ctx, "Sending webhook test event")
+		if err := sendWebhookTestEvent(ctx, webhookHTTPClient, opts, space.MRN(), data.Name.ValueString()); err != nil {
+			resp.Diagnostics.
+				AddError("Webhook Test Event Failed",
+					fmt.Sprintf("Unable to send test event to %s. Got error: %s", data.Url.ValueString(), err),
+				)
+			return
+		}
+	}
+
+	// Do GraphQL request to API to create the resource.
+	tflog.Debug(ctx, "Creating integration")
+	integration, err := r.client.CreateIntegrationWithOptions(ctx,
+		space.MRN(),
+		data.Name.ValueString(),
+		ClientIntegrationTypeWebhook,
+		ClientIntegrationConfigurationInput{
+			WebhookConfigurationOptions: opts,
+		})
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to create webhook integration. Got error: %s", err),
+			)
+		return
+	}
+
+	// Save space mrn into the Terraform state.
+	data.Mrn = types.StringValue(string(integration.Mrn))
+	data.Name = types.StringValue(string(integration.Name))
+	data.SpaceID = types.StringValue(space.ID())
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *integrationWebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data integrationWebhookResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	integration, err := r.client.GetClientIntegration(ctx, data.Mrn.ValueString())
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to read webhook integration. Got error: %s", err),
+			)
+		return
+	}
+
+	// detect drift on the delivery settings, the secret is not returned by the API
+	webhook, err := r.client.GetWebhookConfigurationOptions(ctx, data.Mrn.ValueString())
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to read webhook integration. Got error: %s", err),
+			)
+		return
+	}
+	events, diags := types.SetValueFrom(ctx, types.StringType, webhook.Events)
+	resp.Diagnostics.Append(diags...)
+	headers, diags := webhookHeadersFromPayload(ctx, data.Headers, webhook.Headers)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Name = types.StringValue(integration.Name)
+	data.Url = types.StringValue(webhook.Url)
+	data.Headers = headers
+	data.Events = events
+	data.PayloadFormat = webhookPayloadFormat(webhook.PayloadFormat)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *integrationWebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data integrationWebhookResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts, diags := data.GetConfigurationOptions(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.SendTestEvent.ValueBool() {
+		tflog.Debug(ctx, "Sending webhook test event")
+		space := Space(data.SpaceID.ValueString())
+		if err := sendWebhookTestEvent(ctx, webhookHTTPClient, opts, space.MRN(), data.Name.ValueString()); err != nil {
+			resp.Diagnostics.
+				AddError("Webhook Test Event Failed",
+					fmt.Sprintf("Unable to send test event to %s. Got error: %s", data.Url.ValueString(), err),
+				)
+			return
+		}
+	}
+
+	// Do GraphQL request to API to update the resource.
+	_, err := r.client.UpdateIntegrationWithOptions(ctx,
+		data.Mrn.ValueString(),
+		data.Name.ValueString(),
+		ClientIntegrationTypeWebhook,
+		ClientIntegrationConfigurationInput{
+			WebhookConfigurationOptions: opts,
+		},
+	)
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to update webhook integration. Got error: %s", err),
+			)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *integrationWebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data integrationWebhookResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Do GraphQL request to API to update the resource.
+	_, err := r.client.DeleteIntegration(ctx, data.Mrn.ValueString())
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to delete webhook integration. Got error: %s", err),
+			)
+		return
+	}
+}
+
+func (r *integrationWebhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	integration, spaceID, ok := r.client.ImportSpaceIntegration(ctx, req, resp)
+	if !ok {
+		return
+	}
+
+	webhook, err := r.client.GetWebhookConfigurationOptions(ctx, integration.Mrn)
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to import webhook integration. Got error: %s", err),
+			)
+		return
+	}
+	eventSet, diags := types.SetValueFrom(ctx, types.StringType, webhook.Events)
+	resp.Diagnostics.Append(diags...)
+	headers, diags := webhookHeadersFromPayload(ctx, types.MapNull(types.StringType), webhook.Headers)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model := integrationWebhookResourceModel{
+		Mrn:           types.StringValue(integration.Mrn),
+		Name:          types.StringValue(integration.Name),
+		SpaceID:       types.StringValue(spaceID),
+		Url:           types.StringValue(webhook.Url),
+		Events:        eventSet,
+		Headers:       headers,
+		PayloadFormat: webhookPayloadFormat(webhook.PayloadFormat),
+		SendTestEvent: types.BoolValue(false),
+		// the secret is not returned by the API
+		Secret: types.StringPointerValue(nil),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
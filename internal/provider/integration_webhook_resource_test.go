@@ -0,0 +1,319 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	mondoov1 "go.mondoo.com/mondoo-go"
+)
+
+// webhookReceiver is a local HTTP endpoint that records the webhook requests it receives.
+type webhookReceiver struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	status   int
+	requests []webhookRequest
+}
+
+type webhookRequest struct {
+	Header http.Header
+	Body   []byte
+}
+
+func newWebhookReceiver(t *testing.T, status int) *webhookReceiver {
+	receiver := &webhookReceiver{status: status}
+	receiver.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		receiver.mu.Lock()
+		receiver.requests = append(receiver.requests, webhookRequest{Header: r.Header.Clone(), Body: body})
+		receiver.mu.Unlock()
+		w.WriteHeader(receiver.status)
+	}))
+	t.Cleanup(receiver.Close)
+	return receiver
+}
+
+func (r *webhookReceiver) Requests() []webhookRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]webhookRequest{}, r.requests...)
+}
+
+func TestAccWebhookResource(t *testing.T) {
+	receiver := newWebhookReceiver(t, http.StatusAccepted)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccWebhookResourceConfig(accSpace.ID(), "one", receiver.URL, "JSON"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mondoo_integration_webhook.test", "name", "one"),
+					resource.TestCheckResourceAttr("mondoo_integration_webhook.test", "space_id", accSpace.ID()),
+					resource.TestCheckResourceAttr("mondoo_integration_webhook.test", "url", receiver.URL),
+					resource.TestCheckResourceAttr("mondoo_integration_webhook.test", "events.#", "2"),
+					resource.TestCheckResourceAttr("mondoo_integration_webhook.test", "payload_format", "JSON"),
+					func(*terraform.State) error {
+						if len(receiver.Requests()) != 1 {
+							return fmt.Errorf("expected 1 test event, got %d", len(receiver.Requests()))
+						}
+						return nil
+					},
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccWebhookResourceConfig(accSpace.ID(), "two", receiver.URL, "CLOUDEVENTS"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mondoo_integration_webhook.test", "name", "two"),
+					resource.TestCheckResourceAttr("mondoo_integration_webhook.test", "payload_format", "CLOUDEVENTS"),
+					func(*terraform.State) error {
+						requests := receiver.Requests()
+						if len(requests) != 2 {
+							return fmt.Errorf("expected 2 test events, got %d", len(requests))
+						}
+						if ct := requests[1].Header.Get("Content-Type"); ct != "application/cloudevents+json" {
+							return fmt.Errorf("expected a CloudEvents test event, got content type %q", ct)
+						}
+						return nil
+					},
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: "mondoo_integration_webhook.test",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["mondoo_integration_webhook.test"].Primary.Attributes["mrn"], nil
+				},
+				ImportStateVerifyIdentifierAttribute: "mrn",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIgnore:              []string{"headers", "secret", "send_test_event"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccWebhookResourceConfig(spaceID, intName, url, payloadFormat string) string {
+	return fmt.Sprintf(`
+resource "mondoo_integration_webhook" "test" {
+  space_id       = %[1]q
+  name           = %[2]q
+  url            = %[3]q
+  payload_format = %[4]q
+  events         = ["NEW_CRITICAL_FINDING", "SCORE_DROP"]
+
+  headers = {
+    Authorization = "Bearer abcd1234567890"
+  }
+  secret = "0123456789abcdef0123456789abcdef"
+
+  send_test_event = true
+}
+`, spaceID, intName, url, payloadFormat)
+}
+
+func TestSendWebhookTestEvent(t *testing.T) {
+	secret := mondoov1.String("0123456789abcdef0123456789abcdef")
+
+	tests := []struct {
+		name        string
+		format      WebhookPayloadFormat
+		contentType string
+		eventFields []string
+	}{
+		{
+			name:        "json",
+			format:      WebhookPayloadFormatJSON,
+			contentType: "application/json",
+			eventFields: []string{"id", "type", "time", "scope", "data"},
+		},
+		{
+			name:        "cloudevents",
+			format:      WebhookPayloadFormatCloudEvents,
+			contentType: "application/cloudevents+json",
+			eventFields: []string{"specversion", "id", "source", "type", "time", "datacontenttype", "data"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			receiver := newWebhookReceiver(t, http.StatusOK)
+			opts := &WebhookConfigurationOptionsInput{
+				Url:           mondoov1.String(receiver.URL),
+				Headers:       []WebhookHeaderInput{{Name: "Authorization", Value: "Bearer abcd1234567890"}},
+				Secret:        &secret,
+				Events:        []WebhookEvent{WebhookEventScanFailure},
+				PayloadFormat: tt.format,
+			}
+
+			err := sendWebhookTestEvent(context.Background(), receiver.Client(), opts, "//captain.api.mondoo.app/spaces/hungry-poet-123456", "events")
+			require.NoError(t, err)
+
+			requests := receiver.Requests()
+			require.Len(t, requests, 1)
+			request := requests[0]
+			assert.Equal(t, tt.contentType, request.Header.Get("Content-Type"))
+			assert.Equal(t, "Bearer abcd1234567890", request.Header.Get("Authorization"))
+			assert.Equal(t, signWebhookPayload(string(secret), request.Body), request.Header.Get(webhookSignatureHeader))
+
+			event := map[string]any{}
+			require.NoError(t, json.Unmarshal(request.Body, &event))
+			for _, field := range tt.eventFields {
+				assert.Contains(t, event, field)
+			}
+			assert.Equal(t, webhookTestEventType, event["type"])
+		})
+	}
+
+	t.Run("unsigned", func(t *testing.T) {
+		receiver := newWebhookReceiver(t, http.StatusNoContent)
+		opts := &WebhookConfigurationOptionsInput{
+			Url:           mondoov1.String(receiver.URL),
+			PayloadFormat: WebhookPayloadFormatJSON,
+		}
+
+		require.NoError(t, sendWebhookTestEvent(context.Background(), receiver.Client(), opts, "", "events"))
+		requests := receiver.Requests()
+		require.Len(t, requests, 1)
+		assert.Empty(t, requests[0].Header.Get(webhookSignatureHeader))
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		receiver := newWebhookReceiver(t, http.StatusUnauthorized)
+		opts := &WebhookConfigurationOptionsInput{
+			Url:           mondoov1.String(receiver.URL),
+			PayloadFormat: WebhookPayloadFormatJSON,
+		}
+
+		err := sendWebhookTestEvent(context.Background(), receiver.Client(), opts, "", "events")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "401")
+	})
+}
+
+func TestSignWebhookPayload(t *testing.T) {
+	// echo -n '{"type":"com.mondoo.integration.test"}' | openssl dgst -sha256 -hmac 0123456789abcdef
+	assert.Equal(t,
+		"sha256=0d46bd12287c2e75a46ce71d33c51e47985e83e49602071a7d44b867963be47e",
+		signWebhookPayload("0123456789abcdef", []byte(`{"type":"com.mondoo.integration.test"}`)),
+	)
+}
+
+func TestValidateIntegrationWebhookResourceModel(t *testing.T) {
+	headers := func(names ...string) types.Map {
+		elems := map[string]attr.Value{}
+		for _, name := range names {
+			elems[name] = types.StringValue("value")
+		}
+		return types.MapValueMust(types.StringType, elems)
+	}
+
+	tests := []struct {
+		name    string
+		url     types.String
+		headers types.Map
+		wantErr bool
+	}{
+		{
+			name:    "https url",
+			url:     types.StringValue("https://events.example.com/mondoo"),
+			headers: types.MapNull(types.StringType),
+		},
+		{
+			name:    "http url with headers",
+			url:     types.StringValue("http://localhost:8080/hook"),
+			headers: headers("Authorization", "X-Team"),
+		},
+		{
+			name:    "unknown url",
+			url:     types.StringUnknown(),
+			headers: types.MapNull(types.StringType),
+		},
+		{
+			name:    "relative url",
+			url:     types.StringValue("/mondoo"),
+			headers: types.MapNull(types.StringType),
+			wantErr: true,
+		},
+		{
+			name:    "unsupported scheme",
+			url:     types.StringValue("ftp://events.example.com"),
+			headers: types.MapNull(types.StringType),
+			wantErr: true,
+		},
+		{
+			name:    "signature header",
+			url:     types.StringValue("https://events.example.com/mondoo"),
+			headers: headers("x-mondoo-signature"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diagnostics := validateIntegrationWebhookResourceModel(&integrationWebhookResourceModel{
+				Url:     tt.url,
+				Headers: tt.headers,
+			})
+			if !tt.wantErr {
+				assert.False(t, diagnostics.HasError())
+				return
+			}
+			require.Len(t, diagnostics, 1)
+			assert.Equal(t, "InvalidAttributeError", diagnostics[0].Summary())
+		})
+	}
+}
+
+func TestWebhookHeadersFromPayload(t *testing.T) {
+	ctx := context.Background()
+	prior := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"Authorization": types.StringValue("Bearer token"),
+		"X-Removed":     types.StringValue("value"),
+	})
+
+	headers, diags := webhookHeadersFromPayload(ctx, prior, []WebhookHeader{{Name: "Authorization"}, {Name: "X-Added"}})
+	require.False(t, diags.HasError())
+	assert.Equal(t, types.MapValueMust(types.StringType, map[string]attr.Value{
+		"Authorization": types.StringValue("Bearer token"),
+		"X-Added":       types.StringValue(""),
+	}), headers)
+
+	headers, diags = webhookHeadersFromPayload(ctx, types.MapNull(types.StringType), nil)
+	require.False(t, diags.HasError())
+	assert.True(t, headers.IsNull())
+
+	headers, diags = webhookHeadersFromPayload(ctx, prior, nil)
+	require.False(t, diags.HasError())
+	assert.Empty(t, headers.Elements())
+	assert.False(t, headers.IsNull())
+}
+
+func TestWebhookPayloadFormat(t *testing.T) {
+	assert.Equal(t, types.StringValue("JSON"), webhookPayloadFormat(""))
+	assert.Equal(t, types.StringValue("CLOUDEVENTS"), webhookPayloadFormat("CLOUDEVENTS"))
+}
@@ -303,7 +303,7 @@ func (r *integrationZendeskResource) Delete(ctx context.Context, req resource.De
 }
 
 func (r *integrationZendeskResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	integration, ok := r.client.ImportIntegration(ctx, req, resp)
+	integration, spaceID, ok := r.client.ImportSpaceIntegration(ctx, req, resp)
 	if !ok {
 		return
 	}
@@ -319,7 +319,7 @@ func (r *integrationZendeskResource) ImportState(ctx context.Context, req resour
 	model := integrationZendeskResourceModel{
 		Mrn:          types.StringValue(integration.Mrn),
 		Name:         types.StringValue(integration.Name),
-		SpaceID:      types.StringValue(integration.SpaceID()),
+		SpaceID:      types.StringValue(spaceID),
 		Subdomain:    types.StringValue(integration.ConfigurationOptions.ZendeskConfigurationOptions.Subdomain),
 		Email:        types.StringValue(integration.ConfigurationOptions.ZendeskConfigurationOptions.Email),
 		AutoClose:    types.BoolValue(integration.ConfigurationOptions.ZendeskConfigurationOptions.AutoCloseTickets),
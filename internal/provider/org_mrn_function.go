@@ -0,0 +1,54 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"go.mondoo.com/terraform-provider-mondoo/internal/mrn"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = (*orgMrnFunction)(nil)
+
+func NewOrgMrnFunction() function.Function {
+	return &orgMrnFunction{}
+}
+
+type orgMrnFunction struct{}
+
+func (f *orgMrnFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "org_mrn"
+}
+
+func (f *orgMrnFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Build an organization MRN",
+		MarkdownDescription: "Returns the Mondoo Resource Name (MRN) of the organization with the given identifier.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "id",
+				MarkdownDescription: "The organization identifier, e.g. `reverent-ride-275852`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *orgMrnFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &id))
+	if resp.Error != nil {
+		return
+	}
+
+	if funcErr := validateMrnID(0, id); funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, mrn.Organization(id)))
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -35,6 +36,10 @@ type organizationResourceModel struct {
 	Description types.String `tfsdk:"description"`
 	Company     types.String `tfsdk:"company"`
 	Annotations types.Map    `tfsdk:"annotations"`
+
+	// destroy safeguards
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+	ForceDestroy       types.Bool `tfsdk:"force_destroy"`
 }
 
 func (r *organizationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -82,6 +87,18 @@ func (r *organizationResource) Schema(ctx context.Context, req resource.SchemaRe
 				Optional:            true,
 				ElementType:         types.StringType,
 			},
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "Whether Terraform is prevented from destroying the organization. Set it to `false` and apply before destroying the organization. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"force_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Whether to destroy the organization even if its spaces still have assets or integrations. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 		},
 	}
 }
@@ -152,6 +169,8 @@ func (r *organizationResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Read API call logic
+	data.DeletionProtection = types.BoolValue(data.DeletionProtection.ValueBool())
+	data.ForceDestroy = types.BoolValue(data.ForceDestroy.ValueBool())
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -202,6 +221,39 @@ func (r *organizationResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	if data.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError("Deletion Protection Enabled",
+			fmt.Sprintf("Organization %s has deletion_protection enabled. Set deletion_protection = false and apply before destroying it.", data.OrgId.ValueString()),
+		)
+		return
+	}
+
+	if !data.ForceDestroy.ValueBool() {
+		orgPayload, err := r.client.GetOrganization(ctx, data.OrgMrn.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to retrieve organization", err.Error())
+			return
+		}
+		spaceMrns, err := orgPayload.Spaces(ctx, r.client)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to list spaces of organization", err.Error())
+			return
+		}
+		contents, err := r.client.GetSpacesContents(ctx, spaceMrns)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to check the contents of spaces", err.Error())
+			return
+		}
+		for i, spaceMrn := range spaceMrns {
+			if !contents[i].IsEmpty() {
+				resp.Diagnostics.AddError("Organization Is Not Empty",
+					fmt.Sprintf("Space %s of organization %s still has %s. Remove them first or set force_destroy = true and apply to delete the organization with everything in it.", spaceMrn, data.OrgId.ValueString(), contents[i]),
+				)
+				return
+			}
+		}
+	}
+
 	// Delete API call logic
 	err := r.client.DeleteOrganization(ctx, data.OrgMrn.ValueString())
 	if err != nil {
@@ -211,8 +263,7 @@ func (r *organizationResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *organizationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	mrn := "//captain.api.mondoo.app/organizations/" + req.ID
-	orgPayload, err := r.client.GetOrganization(ctx, mrn)
+	orgPayload, err := r.client.GetOrganization(ctx, orgPrefix+req.ID)
 	if err != nil {
 		resp.Diagnostics.
 			AddError("Client Error",
@@ -228,6 +279,9 @@ func (r *organizationResource) ImportState(ctx context.Context, req resource.Imp
 		Description: types.StringValue(orgPayload.Description),
 		Company:     types.StringValue(orgPayload.Company),
 		Annotations: flattenAnnotations(orgPayload.Annotations),
+
+		DeletionProtection: types.BoolValue(false),
+		ForceDestroy:       types.BoolValue(false),
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 }
@@ -0,0 +1,355 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	mondoov1 "go.mondoo.com/mondoo-go"
+	"go.mondoo.com/terraform-provider-mondoo/internal/mondoovalidator"
+)
+
+var (
+	_ resource.Resource                   = (*organizationSettingsResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*organizationSettingsResource)(nil)
+	_ resource.ResourceWithImportState    = (*organizationSettingsResource)(nil)
+)
+
+func NewOrganizationSettingsResource() resource.Resource {
+	return &organizationSettingsResource{}
+}
+
+type organizationSettingsResource struct {
+	client *ExtendedGqlClient
+}
+
+type organizationSettingsResourceModel struct {
+	// scope
+	OrgID types.String `tfsdk:"org_id"`
+
+	// sign-in
+	AllowedEmailDomains types.List  `tfsdk:"allowed_email_domains"`
+	RequireSso          types.Bool  `tfsdk:"require_sso"`
+	RequireMfa          types.Bool  `tfsdk:"require_mfa"`
+	SessionTimeout      types.Int32 `tfsdk:"session_timeout"`
+	IdleTimeout         types.Int32 `tfsdk:"idle_timeout"`
+
+	// new spaces
+	DefaultSpaceSettings types.Object `tfsdk:"default_space_settings"`
+}
+
+var emailDomainRegexp = regexp.MustCompile(`^([a-z0-9]([a-z0-9-]*[a-z0-9])?\.)+[a-z]{2,}$`)
+
+func (r *organizationSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_settings"
+}
+
+func (r *organizationSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	defaultSpaceSettings := spaceSettingsSchemaAttribute()
+	defaultSpaceSettings.MarkdownDescription = "Settings that new spaces of the organization start with. Existing spaces are not changed."
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manages the settings of a Mondoo organization.
+
+Settings that are not configured keep their current value. Destroying the resource leaves the settings as they are.`,
+		Attributes: map[string]schema.Attribute{
+			"org_id": schema.StringAttribute{
+				MarkdownDescription: "Mondoo organization identifier.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					mondoovalidator.Id(),
+				},
+			},
+			"allowed_email_domains": schema.ListAttribute{
+				MarkdownDescription: "Email domains of the users that can be invited into the organization, like `example.com`. An empty list allows all domains.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(emailDomainRegexp, "must be a lowercase domain name like example.com"),
+					),
+				},
+			},
+			"require_sso": schema.BoolAttribute{
+				MarkdownDescription: "Whether members must sign in through the identity provider of the organization, see `mondoo_organization_sso`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"require_mfa": schema.BoolAttribute{
+				MarkdownDescription: "Whether members must use multi-factor authentication when they sign in with a password.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"session_timeout": schema.Int32Attribute{
+				MarkdownDescription: "Maximum duration of a session in minutes, after which members must sign in again.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(15),
+				},
+			},
+			"idle_timeout": schema.Int32Attribute{
+				MarkdownDescription: "Duration of inactivity in minutes, after which a session ends. Must not be longer than `session_timeout`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(5),
+				},
+			},
+			"default_space_settings": defaultSpaceSettings,
+		},
+	}
+}
+
+func (r *organizationSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ExtendedGqlClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client. Got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *organizationSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data organizationSettingsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateOrganizationSettingsResourceModel(&data)...)
+}
+
+func validateOrganizationSettingsResourceModel(data *organizationSettingsResourceModel) (diagnostics diag.Diagnostics) {
+	// values may be unknown during validation, they will be checked once they are known
+	if data.SessionTimeout.IsNull() || data.SessionTimeout.IsUnknown() ||
+		data.IdleTimeout.IsNull() || data.IdleTimeout.IsUnknown() {
+		return
+	}
+
+	if data.IdleTimeout.ValueInt32() > data.SessionTimeout.ValueInt32() {
+		diagnostics.AddAttributeError(
+			path.Root("idle_timeout"),
+			"InvalidTimeoutError",
+			fmt.Sprintf("idle_timeout (%d) must not be longer than session_timeout (%d).",
+				data.IdleTimeout.ValueInt32(), data.SessionTimeout.ValueInt32()),
+		)
+	}
+	return
+}
+
+// settingsInput builds the update of the organization settings. Settings that are
+// unknown are not configured and keep their current value.
+func (m organizationSettingsResourceModel) settingsInput(ctx context.Context) (UpdateOrganizationSettingsInput, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	input := UpdateOrganizationSettingsInput{
+		OrgMrn: mondoov1.String(orgPrefix + m.OrgID.ValueString()),
+	}
+
+	if !m.AllowedEmailDomains.IsNull() && !m.AllowedEmailDomains.IsUnknown() {
+		// an empty list allows all domains, it must not be sent as null
+		domains := append([]mondoov1.String{}, ConvertSliceStrings(m.AllowedEmailDomains)...)
+		input.AllowedEmailDomains = &domains
+	}
+	if !m.RequireSso.IsNull() && !m.RequireSso.IsUnknown() {
+		input.RequireSso = mondoov1.NewBooleanPtr(mondoov1.Boolean(m.RequireSso.ValueBool()))
+	}
+	if !m.RequireMfa.IsNull() && !m.RequireMfa.IsUnknown() {
+		input.RequireMfa = mondoov1.NewBooleanPtr(mondoov1.Boolean(m.RequireMfa.ValueBool()))
+	}
+	if !m.SessionTimeout.IsNull() && !m.SessionTimeout.IsUnknown() {
+		input.SessionTimeout = mondoov1.NewIntPtr(mondoov1.Int(m.SessionTimeout.ValueInt32()))
+	}
+	if !m.IdleTimeout.IsNull() && !m.IdleTimeout.IsUnknown() {
+		input.IdleTimeout = mondoov1.NewIntPtr(mondoov1.Int(m.IdleTimeout.ValueInt32()))
+	}
+	if !m.DefaultSpaceSettings.IsNull() && !m.DefaultSpaceSettings.IsUnknown() {
+		settings, d := ObjectToSpaceSettingsInput(ctx, m.DefaultSpaceSettings)
+		diags.Append(d...)
+		input.DefaultSpaceSettings = ExpandSpaceSettings(settings)
+	}
+
+	return input, diags
+}
+
+// setSettings stores the organization settings returned by the API in the model.
+func (m *organizationSettingsResourceModel) setSettings(ctx context.Context, payload OrganizationSettingsPayload) (diags diag.Diagnostics) {
+	m.AllowedEmailDomains = ConvertListValue(payload.AllowedEmailDomains)
+	m.RequireSso = types.BoolValue(payload.RequireSso)
+	m.RequireMfa = types.BoolValue(payload.RequireMfa)
+	m.SessionTimeout = types.Int32Value(payload.SessionTimeout)
+	m.IdleTimeout = types.Int32Value(payload.IdleTimeout)
+	m.DefaultSpaceSettings, diags = SpaceSettingsInputToObject(ctx, FlattenSpaceSettingsInput(payload.DefaultSpaceSettings))
+	return
+}
+
+func (r *organizationSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data organizationSettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, diags := data.settingsInput(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = tflog.SetField(ctx, "org_mrn", string(input.OrgMrn))
+
+	// Do GraphQL request to API to create the resource
+	tflog.Debug(ctx, "Updating organization settings")
+	payload, err := r.client.UpdateOrganizationSettings(ctx, input)
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to update organization settings. Got error: %s", err),
+			)
+		return
+	}
+
+	resp.Diagnostics.Append(data.setSettings(ctx, payload)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *organizationSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data organizationSettingsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Do GraphQL request to API to read the resource
+	payload, err := r.client.GetOrganizationSettings(ctx, orgPrefix+data.OrgID.ValueString())
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to retrieve organization settings. Got error: %s", err),
+			)
+		return
+	}
+
+	resp.Diagnostics.Append(data.setSettings(ctx, payload)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *organizationSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data organizationSettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, diags := data.settingsInput(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = tflog.SetField(ctx, "org_mrn", string(input.OrgMrn))
+
+	// Do GraphQL request to API to update the resource
+	tflog.Debug(ctx, "Updating organization settings")
+	payload, err := r.client.UpdateOrganizationSettings(ctx, input)
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to update organization settings. Got error: %s", err),
+			)
+		return
+	}
+
+	resp.Diagnostics.Append(data.setSettings(ctx, payload)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *organizationSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The settings belong to the organization, they are left as they are.
+	tflog.Debug(ctx, "Removing organization settings from state")
+}
+
+func (r *organizationSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	payload, err := r.client.GetOrganizationSettings(ctx, orgPrefix+req.ID)
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to retrieve organization settings. Got error: %s", err),
+			)
+		return
+	}
+
+	data := organizationSettingsResourceModel{
+		OrgID: types.StringValue(req.ID),
+	}
+	resp.Diagnostics.Append(data.setSettings(ctx, payload)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
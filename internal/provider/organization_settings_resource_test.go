@@ -0,0 +1,160 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	mondoov1 "go.mondoo.com/mondoo-go"
+)
+
+func TestAccOrganizationSettingsResource(t *testing.T) {
+	orgID, err := getOrgId()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccOrganizationSettingsResourceConfig(orgID, 720, 60),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mondoo_organization_settings.test", "org_id", orgID),
+					resource.TestCheckResourceAttr("mondoo_organization_settings.test", "allowed_email_domains.#", "1"),
+					resource.TestCheckResourceAttr("mondoo_organization_settings.test", "require_mfa", "true"),
+					resource.TestCheckResourceAttr("mondoo_organization_settings.test", "session_timeout", "720"),
+					resource.TestCheckResourceAttr("mondoo_organization_settings.test", "idle_timeout", "60"),
+					resource.TestCheckResourceAttr("mondoo_organization_settings.test", "default_space_settings.garbage_collect_assets_configuration.after_days", "30"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "mondoo_organization_settings.test",
+				ImportState:                          true,
+				ImportStateId:                        orgID,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "org_id",
+			},
+			// Update and Read testing
+			{
+				Config: testAccOrganizationSettingsResourceConfig(orgID, 480, 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mondoo_organization_settings.test", "session_timeout", "480"),
+					resource.TestCheckResourceAttr("mondoo_organization_settings.test", "idle_timeout", "30"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationSettingsResourceValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccOrganizationSettingsResourceConfig("my-org", 60, 120),
+				ExpectError: regexp.MustCompile("must not be longer than session_timeout"),
+			},
+			{
+				Config: `
+resource "mondoo_organization_settings" "test" {
+  org_id                = "my-org"
+  allowed_email_domains = ["@example.com"]
+}
+`,
+				ExpectError: regexp.MustCompile("must be a lowercase domain name"),
+			},
+		},
+	})
+}
+
+func testAccOrganizationSettingsResourceConfig(orgID string, sessionTimeout, idleTimeout int) string {
+	return fmt.Sprintf(`
+resource "mondoo_organization_settings" "test" {
+  org_id                = %[1]q
+  allowed_email_domains = ["example.com"]
+  require_mfa           = true
+  session_timeout       = %[2]d
+  idle_timeout          = %[3]d
+
+  default_space_settings = {
+    garbage_collect_assets_configuration = {
+      enabled    = true
+      after_days = 30
+    }
+  }
+}
+`, orgID, sessionTimeout, idleTimeout)
+}
+
+func TestValidateOrganizationSettingsResourceModel(t *testing.T) {
+	model := func(sessionTimeout, idleTimeout types.Int32) *organizationSettingsResourceModel {
+		return &organizationSettingsResourceModel{
+			SessionTimeout: sessionTimeout,
+			IdleTimeout:    idleTimeout,
+		}
+	}
+
+	assert.False(t, validateOrganizationSettingsResourceModel(model(types.Int32Value(60), types.Int32Value(60))).HasError())
+	assert.True(t, validateOrganizationSettingsResourceModel(model(types.Int32Value(60), types.Int32Value(61))).HasError())
+	assert.False(t, validateOrganizationSettingsResourceModel(model(types.Int32Null(), types.Int32Value(61))).HasError())
+	assert.False(t, validateOrganizationSettingsResourceModel(model(types.Int32Value(60), types.Int32Unknown())).HasError())
+}
+
+func TestOrganizationSettingsInput(t *testing.T) {
+	ctx := context.Background()
+
+	data := organizationSettingsResourceModel{
+		OrgID:                types.StringValue("my-org"),
+		AllowedEmailDomains:  ConvertListValue([]string{}),
+		RequireSso:           types.BoolUnknown(),
+		RequireMfa:           types.BoolValue(true),
+		SessionTimeout:       types.Int32Value(720),
+		IdleTimeout:          types.Int32Null(),
+		DefaultSpaceSettings: types.ObjectUnknown(SpaceSettingsInputAttrTypes()),
+	}
+	input, diags := data.settingsInput(ctx)
+	require.False(t, diags.HasError())
+
+	assert.Equal(t, mondoov1.String("//captain.api.mondoo.app/organizations/my-org"), input.OrgMrn)
+	require.NotNil(t, input.AllowedEmailDomains)
+	assert.Empty(t, *input.AllowedEmailDomains)
+	assert.NotNil(t, *input.AllowedEmailDomains, "an empty list allows all domains")
+	assert.Nil(t, input.RequireSso)
+	assert.Equal(t, mondoov1.Boolean(true), *input.RequireMfa)
+	assert.Equal(t, mondoov1.Int(720), *input.SessionTimeout)
+	assert.Nil(t, input.IdleTimeout)
+	assert.Nil(t, input.DefaultSpaceSettings)
+}
+
+func TestOrganizationSettingsSetSettings(t *testing.T) {
+	ctx := context.Background()
+
+	data := organizationSettingsResourceModel{OrgID: types.StringValue("my-org")}
+	diags := data.setSettings(ctx, OrganizationSettingsPayload{
+		AllowedEmailDomains: []string{"example.com"},
+		RequireMfa:          true,
+		SessionTimeout:      720,
+		IdleTimeout:         60,
+	})
+	require.False(t, diags.HasError())
+
+	assert.Equal(t, ConvertListValue([]string{"example.com"}), data.AllowedEmailDomains)
+	assert.False(t, data.RequireSso.ValueBool())
+	assert.True(t, data.RequireMfa.ValueBool())
+	assert.Equal(t, int32(720), data.SessionTimeout.ValueInt32())
+	assert.Equal(t, int32(60), data.IdleTimeout.ValueInt32())
+	assert.False(t, data.DefaultSpaceSettings.IsNull())
+}
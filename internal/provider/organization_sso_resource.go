@@ -0,0 +1,760 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	mondoov1 "go.mondoo.com/mondoo-go"
+	"go.mondoo.com/terraform-provider-mondoo/internal/mondoovalidator"
+)
+
+var (
+	_ resource.Resource                = (*organizationSsoResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*organizationSsoResource)(nil)
+	_ resource.ResourceWithImportState = (*organizationSsoResource)(nil)
+)
+
+func NewOrganizationSsoResource() resource.Resource {
+	return &organizationSsoResource{}
+}
+
+type organizationSsoResource struct {
+	client *ExtendedGqlClient
+}
+
+type organizationSsoResourceModel struct {
+	// scope
+	OrgID types.String `tfsdk:"org_id"`
+
+	// identity provider
+	Enabled          types.Bool                            `tfsdk:"enabled"`
+	Saml             *organizationSsoSamlModel             `tfsdk:"saml"`
+	Oidc             *organizationSsoOidcModel             `tfsdk:"oidc"`
+	AttributeMapping *organizationSsoAttributeMappingModel `tfsdk:"attribute_mapping"`
+
+	// details to configure the identity provider with
+	ServiceProviderEntityID types.String `tfsdk:"service_provider_entity_id"`
+	AcsUrl                  types.String `tfsdk:"acs_url"`
+	RedirectUrl             types.String `tfsdk:"redirect_url"`
+
+	// SCIM provisioning
+	Scim               *organizationSsoScimModel `tfsdk:"scim"`
+	ScimBaseUrl        types.String              `tfsdk:"scim_base_url"`
+	ScimToken          types.String              `tfsdk:"scim_token"`
+	ScimTokenCreatedAt types.String              `tfsdk:"scim_token_created_at"`
+}
+
+type organizationSsoSamlModel struct {
+	MetadataUrl  types.String `tfsdk:"metadata_url"`
+	MetadataXml  types.String `tfsdk:"metadata_xml"`
+	Certificates types.List   `tfsdk:"certificates"`
+}
+
+type organizationSsoOidcModel struct {
+	Issuer       types.String `tfsdk:"issuer"`
+	ClientID     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	Scopes       types.List   `tfsdk:"scopes"`
+}
+
+type organizationSsoAttributeMappingModel struct {
+	Email     types.String `tfsdk:"email"`
+	FirstName types.String `tfsdk:"first_name"`
+	LastName  types.String `tfsdk:"last_name"`
+	Groups    types.String `tfsdk:"groups"`
+}
+
+type organizationSsoScimModel struct {
+	TokenRotationDays types.Int32 `tfsdk:"token_rotation_days"`
+}
+
+var httpsUrlRegexp = regexp.MustCompile(`^https://\S+$`)
+
+func (r *organizationSsoResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_sso"
+}
+
+// claimAttribute returns the schema of an attribute that names the claim a user detail is read from.
+func claimAttribute(description, defaultClaim string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("%s Defaults to `%s`.", description, defaultClaim),
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString(defaultClaim),
+	}
+}
+
+func (r *organizationSsoResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Connects a Mondoo organization to a SAML or OIDC identity provider for single sign-on and SCIM provisioning.
+
+Configure the identity provider with ` + "`service_provider_entity_id`" + ` and ` + "`acs_url`" + ` for SAML, or ` + "`redirect_url`" + ` for OIDC. Use ` + "`mondoo_scim_group_mapping`" + ` and ` + "`mondoo_team_external_group_mapping`" + ` to map the groups of the identity provider.`,
+		Attributes: map[string]schema.Attribute{
+			"org_id": schema.StringAttribute{
+				MarkdownDescription: "Mondoo organization identifier.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					mondoovalidator.Id(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether members can sign in through the identity provider. Disable it to set up the connection before members use it. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"saml": schema.SingleNestedAttribute{
+				MarkdownDescription: "SAML 2.0 identity provider. Conflicts with `oidc`.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"metadata_url": schema.StringAttribute{
+						MarkdownDescription: "URL of the SAML metadata of the identity provider. Conflicts with `metadata_xml`.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(httpsUrlRegexp, "must be an HTTPS URL"),
+							stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("metadata_xml")),
+						},
+					},
+					"metadata_xml": schema.StringAttribute{
+						MarkdownDescription: "SAML metadata document of the identity provider. Conflicts with `metadata_url`.",
+						Optional:            true,
+						Validators: []validator.String{
+							NewSamlMetadataValidator(),
+						},
+					},
+					"certificates": schema.ListAttribute{
+						MarkdownDescription: "PEM encoded signing certificates that are trusted in addition to those in the metadata, e.g. while the identity provider rolls over its certificate.",
+						Optional:            true,
+						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(NewPemCertificateValidator()),
+						},
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.ExactlyOneOf(path.MatchRoot("oidc")),
+				},
+			},
+			"oidc": schema.SingleNestedAttribute{
+				MarkdownDescription: "OpenID Connect identity provider. Conflicts with `saml`.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"issuer": schema.StringAttribute{
+						MarkdownDescription: "Issuer URL of the identity provider, used to discover its configuration.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(httpsUrlRegexp, "must be an HTTPS URL"),
+						},
+					},
+					"client_id": schema.StringAttribute{
+						MarkdownDescription: "Client ID of the Mondoo application in the identity provider.",
+						Required:            true,
+					},
+					"client_secret": schema.StringAttribute{
+						MarkdownDescription: "Client secret of the Mondoo application in the identity provider.",
+						Required:            true,
+						Sensitive:           true,
+					},
+					"scopes": schema.ListAttribute{
+						MarkdownDescription: "Additional scopes to request, besides `openid`, `email` and `profile`.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+				},
+			},
+			"attribute_mapping": schema.SingleNestedAttribute{
+				MarkdownDescription: "Claims or SAML attributes that the details of a user are read from.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"email":      claimAttribute("Claim with the email address.", "email"),
+					"first_name": claimAttribute("Claim with the first name.", "given_name"),
+					"last_name":  claimAttribute("Claim with the last name.", "family_name"),
+					"groups":     claimAttribute("Claim with the groups.", "groups"),
+				},
+			},
+			"service_provider_entity_id": schema.StringAttribute{
+				MarkdownDescription: "SAML entity ID of Mondoo, to configure the identity provider with.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"acs_url": schema.StringAttribute{
+				MarkdownDescription: "SAML assertion consumer service URL of Mondoo, to configure the identity provider with.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"redirect_url": schema.StringAttribute{
+				MarkdownDescription: "OIDC redirect URL of Mondoo, to configure the identity provider with.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scim": schema.SingleNestedAttribute{
+				MarkdownDescription: "SCIM 2.0 provisioning of users and groups by the identity provider. Provisioning is enabled when it is set.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"token_rotation_days": schema.Int32Attribute{
+						MarkdownDescription: "Number of days after which the next apply rotates the SCIM token. The previous token is revoked, so the identity provider must be updated with the new token. If not set, the token is not rotated.",
+						Optional:            true,
+						Validators: []validator.Int32{
+							int32validator.AtLeast(1),
+						},
+					},
+				},
+			},
+			"scim_base_url": schema.StringAttribute{
+				MarkdownDescription: "SCIM base URL of the organization, to configure the identity provider with.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scim_token": schema.StringAttribute{
+				MarkdownDescription: "SCIM token, to configure the identity provider with. The token can't be read back, so it's empty after an import until it's rotated.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scim_token_created_at": schema.StringAttribute{
+				MarkdownDescription: "Time the SCIM token was created, in RFC 3339 format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *organizationSsoResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ExtendedGqlClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client. Got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// idpMetadata is the part of the SAML metadata of an identity provider that is needed to trust it.
+type idpMetadata struct {
+	XMLName          xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:metadata EntityDescriptor"`
+	EntityID         string   `xml:"entityID,attr"`
+	IDPSSODescriptor *struct {
+		KeyDescriptors []struct {
+			Use          string   `xml:"use,attr"`
+			Certificates []string `xml:"KeyInfo>X509Data>X509Certificate"`
+		} `xml:"KeyDescriptor"`
+		SingleSignOnServices []struct {
+			Binding  string `xml:"Binding,attr"`
+			Location string `xml:"Location,attr"`
+		} `xml:"SingleSignOnService"`
+	} `xml:"urn:oasis:names:tc:SAML:2.0:metadata IDPSSODescriptor"`
+}
+
+// parseIdpMetadata parses the SAML metadata of an identity provider and checks that it
+// has everything to sign in with it.
+func parseIdpMetadata(data string) (*idpMetadata, error) {
+	metadata := &idpMetadata{}
+	if err := xml.Unmarshal([]byte(data), metadata); err != nil {
+		return nil, fmt.Errorf("invalid SAML metadata: %w", err)
+	}
+
+	if metadata.EntityID == "" {
+		return nil, errors.New("SAML metadata has no entityID")
+	}
+	if metadata.IDPSSODescriptor == nil {
+		return nil, errors.New("SAML metadata does not describe an identity provider")
+	}
+	if len(metadata.IDPSSODescriptor.SingleSignOnServices) == 0 {
+		return nil, errors.New("SAML metadata has no single sign-on service")
+	}
+
+	signingCertificates := 0
+	for _, key := range metadata.IDPSSODescriptor.KeyDescriptors {
+		for _, certificate := range key.Certificates {
+			der, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(certificate), ""))
+			if err != nil {
+				return nil, fmt.Errorf("invalid certificate in SAML metadata: %w", err)
+			}
+			if _, err := x509.ParseCertificate(der); err != nil {
+				return nil, fmt.Errorf("invalid certificate in SAML metadata: %w", err)
+			}
+			if key.Use == "" || key.Use == "signing" {
+				signingCertificates++
+			}
+		}
+	}
+	if signingCertificates == 0 {
+		return nil, errors.New("SAML metadata has no signing certificate")
+	}
+
+	return metadata, nil
+}
+
+// parsePemCertificate parses a PEM encoded X.509 certificate.
+func parsePemCertificate(data string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.New("no PEM encoded certificate found")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// SamlMetadataValidator ensures that a string is the SAML metadata of an identity provider.
+type SamlMetadataValidator struct{}
+
+// NewSamlMetadataValidator is a convenience function for creating an instance of the validator.
+func NewSamlMetadataValidator() validator.String {
+	return &SamlMetadataValidator{}
+}
+
+// ValidateString performs the validation for the "metadata_xml" attribute.
+func (v SamlMetadataValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := parseIdpMetadata(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid SAML Metadata", err.Error())
+	}
+}
+
+// Description returns a plain-text description of the validator's purpose.
+func (v SamlMetadataValidator) Description(ctx context.Context) string {
+	return "value must be the SAML metadata of an identity provider"
+}
+
+// MarkdownDescription returns a markdown-formatted description of the validator's purpose.
+func (v SamlMetadataValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be the SAML metadata of an identity provider"
+}
+
+// PemCertificateValidator ensures that a string is a PEM encoded X.509 certificate.
+type PemCertificateValidator struct{}
+
+// NewPemCertificateValidator is a convenience function for creating an instance of the validator.
+func NewPemCertificateValidator() validator.String {
+	return &PemCertificateValidator{}
+}
+
+// ValidateString performs the validation for each certificate.
+func (v PemCertificateValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := parsePemCertificate(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Certificate", err.Error())
+	}
+}
+
+// Description returns a plain-text description of the validator's purpose.
+func (v PemCertificateValidator) Description(ctx context.Context) string {
+	return "value must be a PEM encoded X.509 certificate"
+}
+
+// MarkdownDescription returns a markdown-formatted description of the validator's purpose.
+func (v PemCertificateValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a PEM encoded X.509 certificate"
+}
+
+// scimTokenRotationDue returns whether a SCIM token that was created at createdAt must be rotated.
+func scimTokenRotationDue(createdAt types.String, rotationDays types.Int32, now time.Time) bool {
+	if createdAt.IsNull() || createdAt.IsUnknown() || rotationDays.IsNull() || rotationDays.IsUnknown() {
+		return false
+	}
+
+	created, err := time.Parse(time.RFC3339, createdAt.ValueString())
+	if err != nil {
+		return false
+	}
+	return !now.Before(created.AddDate(0, 0, int(rotationDays.ValueInt32())))
+}
+
+// ssoInput builds the configuration of the identity provider.
+func (m organizationSsoResourceModel) ssoInput() SetOrganizationSsoInput {
+	input := SetOrganizationSsoInput{
+		OrgMrn:      mondoov1.String(orgPrefix + m.OrgID.ValueString()),
+		Enabled:     mondoov1.Boolean(m.Enabled.ValueBool()),
+		ScimEnabled: mondoov1.Boolean(m.Scim != nil),
+	}
+
+	if m.Saml != nil {
+		input.Saml = &SamlIdentityProviderInput{
+			MetadataUrl: (*mondoov1.String)(m.Saml.MetadataUrl.ValueStringPointer()),
+			MetadataXml: (*mondoov1.String)(m.Saml.MetadataXml.ValueStringPointer()),
+		}
+		if !m.Saml.Certificates.IsNull() {
+			input.Saml.Certificates = ToPtr(ConvertSliceStrings(m.Saml.Certificates))
+		}
+	}
+	if m.Oidc != nil {
+		input.Oidc = &OidcIdentityProviderInput{
+			Issuer:       mondoov1.String(m.Oidc.Issuer.ValueString()),
+			ClientId:     mondoov1.String(m.Oidc.ClientID.ValueString()),
+			ClientSecret: mondoov1.String(m.Oidc.ClientSecret.ValueString()),
+		}
+		if !m.Oidc.Scopes.IsNull() {
+			input.Oidc.Scopes = ToPtr(ConvertSliceStrings(m.Oidc.Scopes))
+		}
+	}
+	if m.AttributeMapping != nil {
+		input.AttributeMapping = &SsoAttributeMappingInput{
+			Email:     mondoov1.String(m.AttributeMapping.Email.ValueString()),
+			FirstName: mondoov1.String(m.AttributeMapping.FirstName.ValueString()),
+			LastName:  mondoov1.String(m.AttributeMapping.LastName.ValueString()),
+			Groups:    mondoov1.String(m.AttributeMapping.Groups.ValueString()),
+		}
+	}
+
+	return input
+}
+
+// optionalString returns a null value for empty strings, which the API returns for unset values.
+func optionalString(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+// optionalList returns a null value for empty lists, unless the list is configured as empty.
+func optionalList(list []string, configured types.List) types.List {
+	if len(list) == 0 && configured.IsNull() {
+		return types.ListNull(types.StringType)
+	}
+	return ConvertListValue(list)
+}
+
+// setSso stores the identity provider returned by the API in the model. Secrets are
+// not returned by the API, they are kept as they are.
+func (m *organizationSsoResourceModel) setSso(payload OrganizationSsoPayload) {
+	m.Enabled = types.BoolValue(payload.Enabled)
+
+	if payload.Saml == nil {
+		m.Saml = nil
+	} else {
+		if m.Saml == nil {
+			m.Saml = &organizationSsoSamlModel{Certificates: types.ListNull(types.StringType)}
+		}
+		m.Saml.MetadataUrl = optionalString(payload.Saml.MetadataUrl)
+		m.Saml.MetadataXml = optionalString(payload.Saml.MetadataXml)
+		m.Saml.Certificates = optionalList(payload.Saml.Certificates, m.Saml.Certificates)
+	}
+
+	if payload.Oidc == nil {
+		m.Oidc = nil
+	} else {
+		if m.Oidc == nil {
+			m.Oidc = &organizationSsoOidcModel{
+				ClientSecret: types.StringNull(),
+				Scopes:       types.ListNull(types.StringType),
+			}
+		}
+		m.Oidc.Issuer = types.StringValue(payload.Oidc.Issuer)
+		m.Oidc.ClientID = types.StringValue(payload.Oidc.ClientId)
+		m.Oidc.Scopes = optionalList(payload.Oidc.Scopes, m.Oidc.Scopes)
+	}
+
+	if m.AttributeMapping != nil {
+		m.AttributeMapping.Email = types.StringValue(payload.AttributeMapping.Email)
+		m.AttributeMapping.FirstName = types.StringValue(payload.AttributeMapping.FirstName)
+		m.AttributeMapping.LastName = types.StringValue(payload.AttributeMapping.LastName)
+		m.AttributeMapping.Groups = types.StringValue(payload.AttributeMapping.Groups)
+	}
+
+	m.ServiceProviderEntityID = types.StringValue(payload.ServiceProviderEntityId)
+	m.AcsUrl = types.StringValue(payload.AcsUrl)
+	m.RedirectUrl = types.StringValue(payload.RedirectUrl)
+
+	if !payload.ScimEnabled {
+		m.Scim = nil
+		m.ScimBaseUrl = types.StringNull()
+		m.ScimToken = types.StringNull()
+		m.ScimTokenCreatedAt = types.StringNull()
+		return
+	}
+	if m.Scim == nil {
+		m.Scim = &organizationSsoScimModel{TokenRotationDays: types.Int32Null()}
+	}
+	m.ScimBaseUrl = types.StringValue(payload.ScimBaseUrl)
+	if m.ScimTokenCreatedAt.ValueString() != payload.ScimTokenCreatedAt {
+		// the token was rotated outside of Terraform, the token in the state is revoked
+		m.ScimToken = types.StringNull()
+	}
+	m.ScimTokenCreatedAt = optionalString(payload.ScimTokenCreatedAt)
+	if m.ScimToken.IsUnknown() {
+		m.ScimToken = types.StringNull()
+	}
+}
+
+// ModifyPlan plans a new SCIM token when provisioning gets enabled or when the token must be rotated.
+func (r *organizationSsoResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan organizationSsoResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case plan.Scim == nil:
+		plan.ScimBaseUrl = types.StringNull()
+		plan.ScimToken = types.StringNull()
+		plan.ScimTokenCreatedAt = types.StringNull()
+	case req.State.Raw.IsNull():
+		// a new token is created with the resource
+	default:
+		var state organizationSsoResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if state.Scim == nil || scimTokenRotationDue(state.ScimTokenCreatedAt, plan.Scim.TokenRotationDays, time.Now()) {
+			tflog.Debug(ctx, "Planning a new SCIM token")
+			plan.ScimBaseUrl = types.StringUnknown()
+			plan.ScimToken = types.StringUnknown()
+			plan.ScimTokenCreatedAt = types.StringUnknown()
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// apply configures the identity provider and reports whether a SCIM token is planned.
+func (r *organizationSsoResource) apply(ctx context.Context, data *organizationSsoResourceModel) (bool, error) {
+	input := data.ssoInput()
+	ctx = tflog.SetField(ctx, "org_mrn", string(input.OrgMrn))
+
+	tflog.Debug(ctx, "Configuring identity provider")
+	payload, err := r.client.SetOrganizationSso(ctx, input)
+	if err != nil {
+		return false, err
+	}
+
+	rotate := data.Scim != nil && data.ScimToken.IsUnknown()
+	data.setSso(payload)
+	return rotate, nil
+}
+
+// rotateScimToken creates a new SCIM token, the previous token is revoked.
+func (r *organizationSsoResource) rotateScimToken(ctx context.Context, data *organizationSsoResourceModel) error {
+	orgMrn := orgPrefix + data.OrgID.ValueString()
+	ctx = tflog.SetField(ctx, "org_mrn", orgMrn)
+
+	tflog.Debug(ctx, "Creating SCIM token")
+	token, err := r.client.RotateScimToken(ctx, orgMrn)
+	if err != nil {
+		return err
+	}
+	data.ScimToken = types.StringValue(token.Token)
+	data.ScimTokenCreatedAt = types.StringValue(token.CreatedAt)
+	return nil
+}
+
+func (r *organizationSsoResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data organizationSsoResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Do GraphQL request to API to create the resource
+	rotate, err := r.apply(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to configure single sign-on. Got error: %s", err),
+			)
+		return
+	}
+
+	if rotate {
+		// Save the identity provider before creating the token, if the token can't be created
+		// the resource is tainted and replaced with the next apply instead of being untracked
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err := r.rotateScimToken(ctx, &data); err != nil {
+			resp.Diagnostics.
+				AddError("Client Error",
+					fmt.Sprintf("Unable to create SCIM token. Got error: %s", err),
+				)
+			return
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *organizationSsoResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data organizationSsoResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Do GraphQL request to API to read the resource
+	payload, err := r.client.GetOrganizationSso(ctx, orgPrefix+data.OrgID.ValueString())
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to retrieve single sign-on configuration. Got error: %s", err),
+			)
+		return
+	}
+	if payload == nil {
+		// the identity provider was removed outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.setSso(*payload)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *organizationSsoResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data organizationSsoResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Do GraphQL request to API to update the resource
+	rotate, err := r.apply(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to configure single sign-on. Got error: %s", err),
+			)
+		return
+	}
+
+	// If the token can't be created the prior state is kept, so the token is planned again
+	if rotate {
+		if err := r.rotateScimToken(ctx, &data); err != nil {
+			resp.Diagnostics.
+				AddError("Client Error",
+					fmt.Sprintf("Unable to create SCIM token. Got error: %s", err),
+				)
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *organizationSsoResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data organizationSsoResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Do GraphQL request to API to delete the resource
+	tflog.Debug(ctx, "Removing identity provider")
+	err := r.client.DeleteOrganizationSso(ctx, orgPrefix+data.OrgID.ValueString())
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to remove single sign-on configuration. Got error: %s", err),
+			)
+		return
+	}
+}
+
+func (r *organizationSsoResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	payload, err := r.client.GetOrganizationSso(ctx, orgPrefix+req.ID)
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to retrieve single sign-on configuration. Got error: %s", err),
+			)
+		return
+	}
+	if payload == nil {
+		resp.Diagnostics.AddError("Import Error",
+			fmt.Sprintf("Organization %s has no identity provider configured.", req.ID),
+		)
+		return
+	}
+
+	data := organizationSsoResourceModel{
+		OrgID:              types.StringValue(req.ID),
+		ScimToken:          types.StringNull(),
+		ScimTokenCreatedAt: types.StringNull(),
+	}
+	data.setSso(*payload)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
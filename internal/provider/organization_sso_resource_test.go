@@ -0,0 +1,272 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	mondoov1 "go.mondoo.com/mondoo-go"
+)
+
+func TestAccOrganizationSsoResource(t *testing.T) {
+	orgID, err := getOrgId()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccOrganizationSsoResourceSamlConfig(orgID, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mondoo_organization_sso.test", "enabled", "false"),
+					resource.TestCheckResourceAttrSet("mondoo_organization_sso.test", "saml.metadata_xml"),
+					resource.TestCheckResourceAttr("mondoo_organization_sso.test", "attribute_mapping.email", "mail"),
+					resource.TestCheckResourceAttr("mondoo_organization_sso.test", "attribute_mapping.groups", "groups"),
+					resource.TestCheckResourceAttrSet("mondoo_organization_sso.test", "acs_url"),
+					resource.TestCheckResourceAttrSet("mondoo_organization_sso.test", "scim_base_url"),
+					resource.TestCheckResourceAttrSet("mondoo_organization_sso.test", "scim_token"),
+					resource.TestCheckResourceAttrSet("mondoo_organization_sso.test", "scim_token_created_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "mondoo_organization_sso.test",
+				ImportState:                          true,
+				ImportStateId:                        orgID,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "org_id",
+				ImportStateVerifyIgnore:              []string{"attribute_mapping", "scim_token"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccOrganizationSsoResourceSamlConfig(orgID, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mondoo_organization_sso.test", "enabled", "true"),
+				),
+			},
+			// Switch to OIDC without SCIM provisioning
+			{
+				Config: testAccOrganizationSsoResourceOidcConfig(orgID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("mondoo_organization_sso.test", "saml"),
+					resource.TestCheckResourceAttr("mondoo_organization_sso.test", "oidc.issuer", "https://idp.example.com"),
+					resource.TestCheckResourceAttrSet("mondoo_organization_sso.test", "redirect_url"),
+					resource.TestCheckNoResourceAttr("mondoo_organization_sso.test", "scim_token"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationSsoResourceValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "mondoo_organization_sso" "test" {
+  org_id = "my-org"
+}
+`,
+				ExpectError: regexp.MustCompile("saml|oidc"),
+			},
+			{
+				Config: `
+resource "mondoo_organization_sso" "test" {
+  org_id = "my-org"
+
+  saml = {
+    metadata_xml = "<md:EntityDescriptor xmlns:md=\"urn:oasis:names:tc:SAML:2.0:metadata\"/>"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid SAML Metadata"),
+			},
+			{
+				Config: `
+resource "mondoo_organization_sso" "test" {
+  org_id = "my-org"
+
+  saml = {
+    metadata_url = "https://idp.example.com/saml/metadata"
+    certificates = ["not a certificate"]
+  }
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Certificate"),
+			},
+		},
+	})
+}
+
+func testAccOrganizationSsoResourceSamlConfig(orgID string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "mondoo_organization_sso" "test" {
+  org_id  = %[1]q
+  enabled = %[2]t
+
+  saml = {
+    metadata_xml = file("./testdata/idp_metadata.xml")
+    certificates = [file("./testdata/idp_certificate.pem")]
+  }
+
+  attribute_mapping = {
+    email = "mail"
+  }
+
+  scim = {
+    token_rotation_days = 90
+  }
+}
+`, orgID, enabled)
+}
+
+func testAccOrganizationSsoResourceOidcConfig(orgID string) string {
+	return fmt.Sprintf(`
+resource "mondoo_organization_sso" "test" {
+  org_id = %[1]q
+
+  oidc = {
+    issuer        = "https://idp.example.com"
+    client_id     = "mondoo"
+    client_secret = "fake-secret"
+  }
+}
+`, orgID)
+}
+
+func TestParseIdpMetadata(t *testing.T) {
+	data, err := os.ReadFile("./testdata/idp_metadata.xml")
+	require.NoError(t, err)
+	metadata := string(data)
+
+	parsed, err := parseIdpMetadata(metadata)
+	require.NoError(t, err)
+	assert.Equal(t, "https://idp.example.com/saml/metadata", parsed.EntityID)
+	require.Len(t, parsed.IDPSSODescriptor.SingleSignOnServices, 2)
+	assert.Equal(t, "https://idp.example.com/saml/sso", parsed.IDPSSODescriptor.SingleSignOnServices[0].Location)
+
+	_, err = parseIdpMetadata("not xml")
+	assert.ErrorContains(t, err, "invalid SAML metadata")
+
+	_, err = parseIdpMetadata(strings.Replace(metadata, `entityID="https://idp.example.com/saml/metadata"`, "", 1))
+	assert.ErrorContains(t, err, "no entityID")
+
+	_, err = parseIdpMetadata(strings.ReplaceAll(metadata, "IDPSSODescriptor", "SPSSODescriptor"))
+	assert.ErrorContains(t, err, "does not describe an identity provider")
+
+	_, err = parseIdpMetadata(regexp.MustCompile(`(?s)<md:SingleSignOnService[^>]*/>`).ReplaceAllString(metadata, ""))
+	assert.ErrorContains(t, err, "no single sign-on service")
+
+	_, err = parseIdpMetadata(regexp.MustCompile(`(?s)<ds:X509Certificate>.*</ds:X509Certificate>`).ReplaceAllString(metadata, "<ds:X509Certificate>bm90IGEgY2VydGlmaWNhdGU=</ds:X509Certificate>"))
+	assert.ErrorContains(t, err, "invalid certificate")
+
+	_, err = parseIdpMetadata(strings.Replace(metadata, `use="signing"`, `use="encryption"`, 1))
+	assert.ErrorContains(t, err, "no signing certificate")
+}
+
+func TestParsePemCertificate(t *testing.T) {
+	data, err := os.ReadFile("./testdata/idp_certificate.pem")
+	require.NoError(t, err)
+
+	certificate, err := parsePemCertificate(string(data))
+	require.NoError(t, err)
+	assert.Equal(t, "idp.example.com", certificate.Subject.CommonName)
+
+	_, err = parsePemCertificate("not a certificate")
+	assert.Error(t, err)
+}
+
+func TestScimTokenRotationDue(t *testing.T) {
+	createdAt := types.StringValue("2026-01-01T00:00:00Z")
+	now := time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	assert.True(t, scimTokenRotationDue(createdAt, types.Int32Value(30), now))
+	assert.False(t, scimTokenRotationDue(createdAt, types.Int32Value(31), now))
+	assert.False(t, scimTokenRotationDue(createdAt, types.Int32Null(), now))
+	assert.False(t, scimTokenRotationDue(types.StringNull(), types.Int32Value(1), now))
+	assert.False(t, scimTokenRotationDue(types.StringValue("yesterday"), types.Int32Value(1), now))
+}
+
+func TestOrganizationSsoInput(t *testing.T) {
+	data := organizationSsoResourceModel{
+		OrgID:   types.StringValue("my-org"),
+		Enabled: types.BoolValue(true),
+		Oidc: &organizationSsoOidcModel{
+			Issuer:       types.StringValue("https://idp.example.com"),
+			ClientID:     types.StringValue("mondoo"),
+			ClientSecret: types.StringValue("secret"),
+			Scopes:       types.ListNull(types.StringType),
+		},
+		Scim: &organizationSsoScimModel{TokenRotationDays: types.Int32Null()},
+	}
+
+	input := data.ssoInput()
+	assert.Equal(t, mondoov1.String("//captain.api.mondoo.app/organizations/my-org"), input.OrgMrn)
+	assert.True(t, bool(input.ScimEnabled))
+	assert.Nil(t, input.Saml)
+	require.NotNil(t, input.Oidc)
+	assert.Equal(t, mondoov1.String("secret"), input.Oidc.ClientSecret)
+	assert.Nil(t, input.Oidc.Scopes)
+	assert.Nil(t, input.AttributeMapping)
+}
+
+func TestOrganizationSsoSetSso(t *testing.T) {
+	data := organizationSsoResourceModel{
+		OrgID: types.StringValue("my-org"),
+		Oidc: &organizationSsoOidcModel{
+			Issuer:       types.StringValue("https://idp.example.com"),
+			ClientID:     types.StringValue("mondoo"),
+			ClientSecret: types.StringValue("secret"),
+			Scopes:       types.ListNull(types.StringType),
+		},
+		Scim:               &organizationSsoScimModel{TokenRotationDays: types.Int32Value(90)},
+		ScimToken:          types.StringValue("token"),
+		ScimTokenCreatedAt: types.StringValue("2026-01-01T00:00:00Z"),
+	}
+
+	payload := OrganizationSsoPayload{
+		Enabled:            true,
+		ScimEnabled:        true,
+		ScimBaseUrl:        "https://api.mondoo.com/scim/v2/my-org",
+		ScimTokenCreatedAt: "2026-01-01T00:00:00Z",
+	}
+	payload.Oidc = &struct {
+		Issuer   string
+		ClientId string
+		Scopes   []string
+	}{Issuer: "https://idp.example.com", ClientId: "mondoo"}
+
+	data.setSso(payload)
+	assert.Nil(t, data.Saml)
+	assert.Equal(t, "secret", data.Oidc.ClientSecret.ValueString(), "the secret is kept")
+	assert.True(t, data.Oidc.Scopes.IsNull())
+	assert.Equal(t, "token", data.ScimToken.ValueString())
+
+	// the token was rotated outside of Terraform
+	payload.ScimTokenCreatedAt = "2026-02-01T00:00:00Z"
+	data.setSso(payload)
+	assert.True(t, data.ScimToken.IsNull())
+	assert.Equal(t, "2026-02-01T00:00:00Z", data.ScimTokenCreatedAt.ValueString())
+
+	// provisioning was disabled outside of Terraform
+	payload.ScimEnabled = false
+	data.setSso(payload)
+	assert.Nil(t, data.Scim)
+	assert.True(t, data.ScimTokenCreatedAt.IsNull())
+}
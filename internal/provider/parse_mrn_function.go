@@ -0,0 +1,92 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.mondoo.com/terraform-provider-mondoo/internal/mrn"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = (*parseMrnFunction)(nil)
+
+var parseMrnReturnAttrTypes = map[string]attr.Type{
+	"service":   types.StringType,
+	"type":      types.StringType,
+	"org_id":    types.StringType,
+	"space_id":  types.StringType,
+	"id":        types.StringType,
+	"scope_mrn": types.StringType,
+}
+
+func NewParseMrnFunction() function.Function {
+	return &parseMrnFunction{}
+}
+
+type parseMrnFunction struct{}
+
+type parseMrnFunctionModel struct {
+	Service  types.String `tfsdk:"service"`
+	Type     types.String `tfsdk:"type"`
+	OrgID    types.String `tfsdk:"org_id"`
+	SpaceID  types.String `tfsdk:"space_id"`
+	ID       types.String `tfsdk:"id"`
+	ScopeMrn types.String `tfsdk:"scope_mrn"`
+}
+
+func (f *parseMrnFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_mrn"
+}
+
+func (f *parseMrnFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse an MRN into its components",
+		MarkdownDescription: "Parses a Mondoo Resource Name (MRN) and returns an object with the following attributes:\n\n" +
+			"- `service`: The API domain that owns the resource, e.g. `captain.api.mondoo.app`.\n" +
+			"- `type`: The collection of the resource, e.g. `spaces`, `integrations` or `policies`.\n" +
+			"- `org_id`: The organization identifier, if the MRN contains one.\n" +
+			"- `space_id`: The space identifier, if the MRN contains one.\n" +
+			"- `id`: The identifier of the resource itself.\n" +
+			"- `scope_mrn`: The MRN of the space or organization the resource belongs to, or `//platform.api.mondoo.app`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "mrn",
+				MarkdownDescription: "The MRN to parse, e.g. `//integration.api.mondoo.app/spaces/hungry-poet-123456/integrations/2Abd08lk860`.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: parseMrnReturnAttrTypes,
+		},
+	}
+}
+
+func (f *parseMrnFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	parsed, err := mrn.Parse(input)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result := parseMrnFunctionModel{
+		Service:  types.StringValue(parsed.Service),
+		Type:     types.StringValue(parsed.Type),
+		OrgID:    types.StringValue(parsed.OrgID),
+		SpaceID:  types.StringValue(parsed.SpaceID),
+		ID:       types.StringValue(parsed.ID),
+		ScopeMrn: types.StringValue(parsed.ScopeMrn()),
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, &result))
+}
@@ -0,0 +1,60 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestParseMrnFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+locals {
+  parsed = provider::mondoo::parse_mrn("//integration.api.mondoo.app/spaces/hungry-poet-123456/integrations/2Abd08lk860")
+}
+
+output "type" {
+  value = local.parsed.type
+}
+
+output "space_id" {
+  value = local.parsed.space_id
+}
+
+output "id" {
+  value = local.parsed.id
+}
+
+output "scope_mrn" {
+  value = local.parsed.scope_mrn
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("type", "integrations"),
+					resource.TestCheckOutput("space_id", "hungry-poet-123456"),
+					resource.TestCheckOutput("id", "2Abd08lk860"),
+					resource.TestCheckOutput("scope_mrn", "//captain.api.mondoo.app/spaces/hungry-poet-123456"),
+				),
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::mondoo::parse_mrn("not-an-mrn")
+}
+`,
+				ExpectError: regexp.MustCompile(`must start with //`),
+			},
+		},
+	})
+}
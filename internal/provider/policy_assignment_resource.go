@@ -88,6 +88,19 @@ func (r *policyAssignmentResource) Schema(_ context.Context, req resource.Schema
 	}
 }
 
+// policyStateFromAPI converts the action of an active policy into the state of a policy
+// assignment. Policies that are not assigned have no action and are disabled.
+func policyStateFromAPI(action string) string {
+	switch action {
+	case "ACTIVE":
+		return "enabled"
+	case "IGNORE":
+		return "preview"
+	default:
+		return "disabled"
+	}
+}
+
 func (r *policyAssignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -213,20 +226,7 @@ func (r *policyAssignmentResource) Read(ctx context.Context, req resource.ReadRe
 	configuredState := data.State.ValueString()
 	allMatch := true
 	for _, mrn := range policyMrns {
-		action, found := policyActions[mrn]
-		var actualState string
-		if !found {
-			actualState = "disabled"
-		} else {
-			switch action {
-			case "ACTIVE":
-				actualState = "enabled"
-			case "IGNORE":
-				actualState = "preview"
-			default:
-				actualState = "disabled"
-			}
-		}
+		actualState := policyStateFromAPI(policyActions[mrn])
 		if actualState != configuredState {
 			allMatch = false
 			// Report the actual state of this policy so Terraform sees the drift
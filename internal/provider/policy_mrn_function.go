@@ -0,0 +1,64 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"go.mondoo.com/terraform-provider-mondoo/internal/mrn"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = (*policyMrnFunction)(nil)
+
+func NewPolicyMrnFunction() function.Function {
+	return &policyMrnFunction{}
+}
+
+type policyMrnFunction struct{}
+
+func (f *policyMrnFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "policy_mrn"
+}
+
+func (f *policyMrnFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Build a policy MRN",
+		MarkdownDescription: "Returns the Mondoo Resource Name (MRN) of a policy. Use the `mondoo` namespace for policies maintained by Mondoo, or a space ID or MRN for custom policies uploaded to that space.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "namespace",
+				MarkdownDescription: "Either `mondoo` or the ID or MRN of the space that owns the policy.",
+			},
+			function.StringParameter{
+				Name:                "uid",
+				MarkdownDescription: "The policy UID as defined in the policy bundle, e.g. `mondoo-aws-security`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *policyMrnFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var namespace, uid string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &namespace, &uid))
+	if resp.Error != nil {
+		return
+	}
+
+	if funcErr := validateMrnID(1, uid); funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	policyMrn, err := mrn.Policy(namespace, uid)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, policyMrn))
+}
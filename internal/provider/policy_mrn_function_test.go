@@ -0,0 +1,46 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestPolicyMrnFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "public" {
+  value = provider::mondoo::policy_mrn("mondoo", "mondoo-aws-security")
+}
+
+output "custom" {
+  value = provider::mondoo::policy_mrn("hungry-poet-123456", "my-custom-policy")
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("public", "//policy.api.mondoo.app/policies/mondoo-aws-security"),
+					resource.TestCheckOutput("custom", "//policy.api.mondoo.app/spaces/hungry-poet-123456/policies/my-custom-policy"),
+				),
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::mondoo::policy_mrn("//captain.api.mondoo.app/organizations/my-org", "my-custom-policy")
+}
+`,
+				ExpectError: regexp.MustCompile(`invalid policy namespace`),
+			},
+		},
+	})
+}
@@ -11,8 +11,11 @@ import (
 	"os"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -27,6 +30,7 @@ import (
 
 // Ensure MondooProvider satisfies various provider interfaces.
 var _ provider.Provider = &MondooProvider{}
+var _ provider.ProviderWithFunctions = &MondooProvider{}
 
 // MondooProvider defines the provider implementation.
 type MondooProvider struct {
@@ -42,6 +46,8 @@ type MondooProviderModel struct {
 	Space       types.String `tfsdk:"space"`
 	Region      types.String `tfsdk:"region"`
 	Endpoint    types.String `tfsdk:"endpoint"`
+
+	Oidc *MondooProviderOidcModel `tfsdk:"oidc"`
 }
 
 func (p *MondooProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -72,6 +78,41 @@ func (p *MondooProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 				Optional:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"oidc": schema.SingleNestedBlock{
+				MarkdownDescription: "Authenticate with an OIDC token of the environment, like a CI job, through workload identity federation. " +
+					"The token is exchanged for a Mondoo credential, which is renewed when it expires. Requires a `mondoo_iam_workload_identity_binding` that trusts the token. Conflicts with `credentials`.",
+				Attributes: map[string]schema.Attribute{
+					"issuer": schema.StringAttribute{
+						MarkdownDescription: "Issuer of the token, e.g. `https://gitlab.com`. Defaults to the GitHub Actions issuer if `github_actions` is set.",
+						Optional:            true,
+					},
+					"audience": schema.StringAttribute{
+						MarkdownDescription: "Audience of the token, one of the allowed audiences of the workload identity binding.",
+						Optional:            true,
+					},
+					"universe_domain": schema.StringAttribute{
+						MarkdownDescription: "Mondoo API that exchanges the token. Defaults to the API of the configured endpoint or region.",
+						Optional:            true,
+					},
+					"token_env": schema.StringAttribute{
+						MarkdownDescription: "Name of the environment variable that contains the token, e.g. `CI_JOB_JWT_V2` or a GitLab `id_tokens` variable.",
+						Optional:            true,
+					},
+					"token_file": schema.StringAttribute{
+						MarkdownDescription: "Path of a file that contains the token. The file is read again when the credential is renewed, so it can be a token that is rotated, like a Kubernetes projected service account token.",
+						Optional:            true,
+					},
+					"github_actions": schema.BoolAttribute{
+						MarkdownDescription: "Request the token from the GitHub Actions runner. The workflow needs the `id-token: write` permission.",
+						Optional:            true,
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(path.MatchRoot("credentials")),
+				},
+			},
+		},
 	}
 }
 
@@ -85,17 +126,28 @@ func (p *MondooProvider) Configure(ctx context.Context, req provider.ConfigureRe
 
 	// Client configuration for data sources and resources
 	opts := []option.ClientOption{}
+	var oidc *oidcConfig
 
 	// set the credentials to communicate with Mondoo Platform
 	// 1. via MONDOO_CONFIG_BASE64
 	// 2. via MONDOO_CONFIG_PATH
 	// 3. via MONDOO_API_TOKEN
-	// 4. via `credentials` field
-	// 5. via default Mondoo CLI configuration file
+	// 4. via `oidc` block, the token is exchanged once the endpoint is known
+	// 5. via `credentials` field
+	// 6. via default Mondoo CLI configuration file
 	configBase64 := os.Getenv("MONDOO_CONFIG_BASE64")
 	configPath := os.Getenv("MONDOO_CONFIG_PATH")
 	token := os.Getenv("MONDOO_API_TOKEN")
 
+	if data.Oidc != nil {
+		if env := oidcOverriddenBy(os.Getenv); env != "" {
+			resp.Diagnostics.AddAttributeWarning(path.Root("oidc"),
+				"OIDC configuration is ignored",
+				env+" is set and takes precedence over the oidc block. Unset it to authenticate with the OIDC token.",
+			)
+		}
+	}
+
 	if configBase64 != "" {
 		// extract Base64 encoded string
 		data, err := base64.StdEncoding.DecodeString(configBase64)
@@ -124,6 +176,14 @@ func (p *MondooProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	} else if token != "" {
 		opts = append(opts, option.WithAPIToken(token))
 		ctx = tflog.SetField(ctx, "env_api_token", true)
+	} else if data.Oidc != nil {
+		conf, err := newOidcConfig(data.Oidc, data)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("oidc"), "Invalid OIDC configuration", err.Error())
+			return
+		}
+		oidc = &conf
+		ctx = tflog.SetField(ctx, "oidc", true)
 	} else if data.Credentials.ValueString() != "" {
 		opts = append(opts, option.WithServiceAccount([]byte(data.Credentials.ValueString())))
 		ctx = tflog.SetField(ctx, "field_credentials", true)
@@ -182,24 +242,40 @@ func (p *MondooProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		ctx = tflog.SetField(ctx, "field_region", true)
 	}
 
-	space := data.Space.ValueString()
-	if space != "" {
-		ctx = tflog.SetField(ctx, "provider_space", space)
-	}
-
-	tflog.Debug(ctx, "Creating Mondoo client")
-	client, err := mondoov1.NewClient(opts...)
+	space, err := SpaceFrom(data.Space.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to create Mondoo client",
-			err.Error(),
-		)
+		resp.Diagnostics.AddAttributeError(path.Root("space"), "Invalid Configuration", err.Error())
 		return
 	}
+	if space != "" {
+		ctx = tflog.SetField(ctx, "provider_space", space.ID())
+	}
 
 	// The extended GraphQL client allows us to pass additional information to
 	// resources and data sources, things like the Mondoo space
-	extendedClient := &ExtendedGqlClient{client, SpaceFrom(space)}
+	extendedClient := &ExtendedGqlClient{space: space}
+
+	tflog.Debug(ctx, "Creating Mondoo client")
+	if oidc != nil {
+		// exchange the first token right away to report a misconfiguration early
+		extendedClient.oidc = newOidcCredentials(*oidc, opts)
+		client, err := extendedClient.oidc.current(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to exchange external token (WIF)", err.Error())
+			return
+		}
+		extendedClient.Client = client
+	} else {
+		client, err := mondoov1.NewClient(opts...)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to create Mondoo client",
+				err.Error(),
+			)
+			return
+		}
+		extendedClient.Client = client
+	}
 	resp.DataSourceData = extendedClient
 	resp.ResourceData = extendedClient
 }
@@ -207,7 +283,10 @@ func (p *MondooProvider) Configure(ctx context.Context, req provider.ConfigureRe
 func (p *MondooProvider) Resources(_ context.Context) []func() resource.Resource {
 	return append(autoGeneratedResources, []func() resource.Resource{
 		NewSpaceResource,
+		NewSpaceBaselineResource,
+		NewSpaceCloneResource,
 		NewServiceAccountResource,
+		NewServiceAccountKeyResource,
 		NewRegistrationTokenResource,
 		NewCustomPolicyResource,
 		NewPolicyAssignmentResource,
@@ -216,10 +295,15 @@ func (p *MondooProvider) Resources(_ context.Context) []func() resource.Resource
 		NewScimGroupMappingResource,
 		NewFrameworkAssignmentResource,
 		NewCustomFrameworkResource,
+		NewFrameworkResource,
+		NewFrameworkControlExceptionResource,
+		NewFrameworkEvidenceResource,
 		NewExceptionResource,
 		NewIAMWorkloadIdentityBindingResource,
 		NewWorkspaceResource,
 		NewOrganizationResource,
+		NewOrganizationSettingsResource,
+		NewOrganizationSsoResource,
 		NewTeamResource,
 		NewTeamExternalGroupMappingResource,
 		NewResourceContactsResource,
@@ -232,17 +316,34 @@ func (p *MondooProvider) Resources(_ context.Context) []func() resource.Resource
 		NewIntegrationMsIntuneResource,
 		NewAssetRoutingTableResource,
 		NewAssetRoutingRuleResource,
+		NewCaseRoutingRuleResource,
+		NewCaseResource,
 		NewIntegrationAuditLogExportResource,
+		NewIntegrationWebhookResource,
+		NewIntegrationResource,
+		NewIntegrationK8sResource,
 	}...)
 }
 
 func (p *MondooProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{
+	return append(autoGeneratedDataSources, []func() datasource.DataSource{
 		NewOrganizationDataSource,
 		NewSpaceDataSource,
 		NewPoliciesDataSource,
 		NewAssetsDataSource,
 		NewFrameworksDataSource,
+		NewCasesDataSource,
+		NewServiceAccountDataSource,
+		NewServiceAccountsDataSource,
+	}...)
+}
+
+func (p *MondooProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewSpaceMrnFunction,
+		NewOrgMrnFunction,
+		NewPolicyMrnFunction,
+		NewParseMrnFunction,
 	}
 }
 
@@ -5,7 +5,10 @@
 
 package provider
 
-import "github.com/hashicorp/terraform-plugin-framework/resource"
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
 
 var autoGeneratedResources = []func() resource.Resource{
 	NewIntegrationAwsResource,
@@ -20,7 +23,9 @@ var autoGeneratedResources = []func() resource.Resource{
 	NewIntegrationJiraResource,
 	NewIntegrationMsDefenderResource,
 	NewIntegrationMs365Resource,
+	NewIntegrationMsTeamsResource,
 	NewIntegrationOciTenantResource,
+	NewIntegrationPagerDutyResource,
 	NewIntegrationSentinelOneResource,
 	NewIntegrationShodanResource,
 	NewIntegrationSlackResource,
@@ -30,3 +35,9 @@ var autoGeneratedResources = []func() resource.Resource{
 	NewIntegrationGoogleWorkspaceResource,
 	NewIntegrationOktaResource,
 }
+
+var autoGeneratedDataSources = []func() datasource.DataSource{
+	NewIntegrationAzureDevopsDataSource,
+	NewIntegrationGoogleWorkspaceDataSource,
+	NewIntegrationOktaDataSource,
+}
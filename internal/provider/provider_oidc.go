@@ -0,0 +1,292 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	mondoov1 "go.mondoo.com/mondoo-go"
+	"go.mondoo.com/mondoo-go/option"
+)
+
+const (
+	defaultUniverseDomain = "https://api.mondoo.com"
+
+	// refresh the exchanged credential shortly before its certificate expires
+	oidcRefreshMargin = time.Minute
+)
+
+// MondooProviderOidcModel describes the `oidc` block of the provider.
+type MondooProviderOidcModel struct {
+	Issuer         types.String `tfsdk:"issuer"`
+	Audience       types.String `tfsdk:"audience"`
+	UniverseDomain types.String `tfsdk:"universe_domain"`
+	TokenEnv       types.String `tfsdk:"token_env"`
+	TokenFile      types.String `tfsdk:"token_file"`
+	GitHubActions  types.Bool   `tfsdk:"github_actions"`
+}
+
+// oidcConfig holds everything needed to fetch an OIDC token and exchange it for a Mondoo credential.
+type oidcConfig struct {
+	Issuer         string
+	Audience       string
+	UniverseDomain string
+	TokenEnv       string
+	TokenFile      string
+	GitHubActions  bool
+}
+
+// newOidcConfig validates the `oidc` block and fills in the defaults.
+func newOidcConfig(m *MondooProviderOidcModel, data MondooProviderModel) (oidcConfig, error) {
+	conf := oidcConfig{
+		Issuer:         m.Issuer.ValueString(),
+		Audience:       m.Audience.ValueString(),
+		UniverseDomain: m.UniverseDomain.ValueString(),
+		TokenEnv:       m.TokenEnv.ValueString(),
+		TokenFile:      m.TokenFile.ValueString(),
+		GitHubActions:  m.GitHubActions.ValueBool(),
+	}
+
+	sources := 0
+	for _, set := range []bool{conf.TokenEnv != "", conf.TokenFile != "", conf.GitHubActions} {
+		if set {
+			sources++
+		}
+	}
+	if sources != 1 {
+		return conf, errors.New("exactly one of token_env, token_file or github_actions must be set")
+	}
+
+	if conf.Issuer == "" && conf.GitHubActions {
+		conf.Issuer = githubActionsIssuer
+	}
+	if conf.Issuer == "" {
+		return conf, errors.New("issuer must be set")
+	}
+	if conf.Audience == "" {
+		return conf, errors.New("audience must be set")
+	}
+
+	if conf.UniverseDomain == "" {
+		conf.UniverseDomain = universeDomain(os.Getenv("MONDOO_API_ENDPOINT"), data.Endpoint.ValueString(), data.Region.ValueString())
+	}
+	return conf, nil
+}
+
+// universeDomain derives the Mondoo API that exchanges the token from the configured endpoint or region.
+func universeDomain(envEndpoint, endpoint, region string) string {
+	for _, e := range []string{envEndpoint, endpoint} {
+		if e != "" {
+			return strings.TrimSuffix(strings.TrimSuffix(e, "/"), "/query")
+		}
+	}
+	if region != "" {
+		return "https://" + region + ".api.mondoo.com"
+	}
+	return defaultUniverseDomain
+}
+
+// token fetches a fresh OIDC token from the configured source.
+func (conf oidcConfig) token(ctx context.Context) (string, error) {
+	switch {
+	case conf.TokenEnv != "":
+		token := strings.TrimSpace(os.Getenv(conf.TokenEnv))
+		if token == "" {
+			return "", fmt.Errorf("environment variable %s is empty", conf.TokenEnv)
+		}
+		return token, nil
+	case conf.TokenFile != "":
+		content, err := os.ReadFile(conf.TokenFile)
+		if err != nil {
+			return "", err
+		}
+		token := strings.TrimSpace(string(content))
+		if token == "" {
+			return "", fmt.Errorf("token file %s is empty", conf.TokenFile)
+		}
+		return token, nil
+	case conf.GitHubActions:
+		return githubActionsToken(ctx, conf.Audience)
+	}
+	return "", errors.New("no token source configured")
+}
+
+// githubActionsToken requests an OIDC token for the audience from the GitHub Actions runner. The
+// workflow needs the `id-token: write` permission.
+func githubActionsToken(ctx context.Context, audience string) (string, error) {
+	requestURL := os.Getenv("ACTIONS_ID_TOKEN_REQUEST_URL")
+	requestToken := os.Getenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN")
+	if requestURL == "" || requestToken == "" {
+		return "", errors.New("ACTIONS_ID_TOKEN_REQUEST_URL and ACTIONS_ID_TOKEN_REQUEST_TOKEN are not set, make sure the workflow has the id-token: write permission")
+	}
+
+	u, err := url.Parse(requestURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid ACTIONS_ID_TOKEN_REQUEST_URL: %w", err)
+	}
+	query := u.Query()
+	query.Set("audience", audience)
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+requestToken)
+	req.Header.Set("Accept", "application/json")
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("unable to request GitHub Actions token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unable to request GitHub Actions token, got status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var payload struct {
+		Value string `json:"value"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return "", fmt.Errorf("unable to parse GitHub Actions token: %w", err)
+	}
+	if payload.Value == "" {
+		return "", errors.New("GitHub Actions returned an empty token")
+	}
+	return payload.Value, nil
+}
+
+// oidcCredentials exchanges OIDC tokens for Mondoo credentials and keeps a client that uses
+// the current credential, so that long applies outlive a single credential.
+type oidcCredentials struct {
+	conf oidcConfig
+	// options of the client other than the credential, like the endpoint
+	opts []option.ClientOption
+	// exchange is replaced in tests
+	exchange func(config *wif) ([]byte, error)
+
+	mu        sync.Mutex
+	client    *mondoov1.Client
+	expiresAt time.Time
+}
+
+func newOidcCredentials(conf oidcConfig, opts []option.ClientOption) *oidcCredentials {
+	return &oidcCredentials{
+		conf:     conf,
+		opts:     opts,
+		exchange: serviceAccountFromWIFConfig,
+	}
+}
+
+// oidcOverriddenBy returns the environment variable with credentials that takes precedence over
+// the `oidc` block, or an empty string if none is set.
+func oidcOverriddenBy(getenv func(string) string) string {
+	for _, env := range []string{"MONDOO_CONFIG_BASE64", "MONDOO_CONFIG_PATH", "MONDOO_API_TOKEN"} {
+		if getenv(env) != "" {
+			return env
+		}
+	}
+	return ""
+}
+
+// credentialExpiresAt returns when the certificate of an exchanged credential expires, or the
+// zero time if it's unknown.
+func credentialExpiresAt(serviceAccount []byte) time.Time {
+	var credential struct {
+		Certificate string `json:"certificate"`
+	}
+	if err := json.Unmarshal(serviceAccount, &credential); err != nil {
+		return time.Time{}
+	}
+	block, _ := pem.Decode([]byte(credential.Certificate))
+	if block == nil {
+		return time.Time{}
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}
+	}
+	return cert.NotAfter
+}
+
+// refresh fetches a new token and replaces the client, unless another request already replaced
+// the stale client. It must be called with the lock held.
+func (o *oidcCredentials) refresh(ctx context.Context, stale *mondoov1.Client) (*mondoov1.Client, error) {
+	if o.client != nil && o.client != stale {
+		return o.client, nil
+	}
+
+	tflog.Debug(ctx, "Exchanging OIDC token for Mondoo credentials", map[string]interface{}{
+		"issuer":   o.conf.Issuer,
+		"audience": o.conf.Audience,
+	})
+	token, err := o.conf.token(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch OIDC token: %w", err)
+	}
+	serviceAccount, err := o.exchange(&wif{
+		UniverseDomain: o.conf.UniverseDomain,
+		Audience:       o.conf.Audience,
+		IssuerURI:      o.conf.Issuer,
+		JWTToken:       token,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to exchange OIDC token: %w", err)
+	}
+
+	client, err := mondoov1.NewClient(append(o.opts, option.WithServiceAccount(serviceAccount))...)
+	if err != nil {
+		return nil, err
+	}
+	o.client = client
+	o.expiresAt = credentialExpiresAt(serviceAccount)
+	return client, nil
+}
+
+// current returns the client with the current credential, refreshing it before it expires.
+func (o *oidcCredentials) current(ctx context.Context) (*mondoov1.Client, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.client != nil && (o.expiresAt.IsZero() || time.Now().Add(oidcRefreshMargin).Before(o.expiresAt)) {
+		return o.client, nil
+	}
+	return o.refresh(ctx, o.client)
+}
+
+// renew replaces a client whose credential was rejected.
+func (o *oidcCredentials) renew(ctx context.Context, stale *mondoov1.Client) (*mondoov1.Client, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.refresh(ctx, stale)
+}
+
+// isUnauthenticatedError returns whether the API rejected the credential of a request.
+func isUnauthenticatedError(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := err.Error()
+	return strings.Contains(msg, "code = Unauthenticated") || strings.Contains(msg, "401 Unauthorized")
+}
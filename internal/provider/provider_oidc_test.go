@@ -0,0 +1,230 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOidcConfig(t *testing.T) {
+	t.Setenv("MONDOO_API_ENDPOINT", "")
+
+	t.Run("github actions defaults the issuer", func(t *testing.T) {
+		conf, err := newOidcConfig(&MondooProviderOidcModel{
+			Audience:      types.StringValue("mondoo"),
+			GitHubActions: types.BoolValue(true),
+		}, MondooProviderModel{Region: types.StringValue("eu")})
+		require.NoError(t, err)
+		assert.Equal(t, githubActionsIssuer, conf.Issuer)
+		assert.Equal(t, "https://eu.api.mondoo.com", conf.UniverseDomain)
+	})
+
+	t.Run("token env with issuer", func(t *testing.T) {
+		conf, err := newOidcConfig(&MondooProviderOidcModel{
+			Issuer:         types.StringValue("https://gitlab.com"),
+			Audience:       types.StringValue("mondoo"),
+			UniverseDomain: types.StringValue("https://api.example.com"),
+			TokenEnv:       types.StringValue("MONDOO_ID_TOKEN"),
+		}, MondooProviderModel{})
+		require.NoError(t, err)
+		assert.Equal(t, "https://gitlab.com", conf.Issuer)
+		assert.Equal(t, "https://api.example.com", conf.UniverseDomain)
+	})
+
+	t.Run("requires exactly one token source", func(t *testing.T) {
+		_, err := newOidcConfig(&MondooProviderOidcModel{
+			Issuer:   types.StringValue("https://gitlab.com"),
+			Audience: types.StringValue("mondoo"),
+		}, MondooProviderModel{})
+		assert.Error(t, err)
+
+		_, err = newOidcConfig(&MondooProviderOidcModel{
+			Audience:      types.StringValue("mondoo"),
+			TokenEnv:      types.StringValue("MONDOO_ID_TOKEN"),
+			GitHubActions: types.BoolValue(true),
+		}, MondooProviderModel{})
+		assert.Error(t, err)
+	})
+
+	t.Run("requires issuer and audience", func(t *testing.T) {
+		_, err := newOidcConfig(&MondooProviderOidcModel{
+			Audience: types.StringValue("mondoo"),
+			TokenEnv: types.StringValue("MONDOO_ID_TOKEN"),
+		}, MondooProviderModel{})
+		assert.Error(t, err)
+
+		_, err = newOidcConfig(&MondooProviderOidcModel{
+			GitHubActions: types.BoolValue(true),
+		}, MondooProviderModel{})
+		assert.Error(t, err)
+	})
+}
+
+func TestUniverseDomain(t *testing.T) {
+	assert.Equal(t, "https://api.mondoo.com", universeDomain("", "", ""))
+	assert.Equal(t, "https://us.api.mondoo.com", universeDomain("", "", "us"))
+	assert.Equal(t, "https://api.example.com", universeDomain("", "https://api.example.com/query", "eu"))
+	assert.Equal(t, "https://env.example.com", universeDomain("https://env.example.com/", "https://api.example.com", ""))
+}
+
+func TestOidcConfigToken(t *testing.T) {
+	t.Setenv("MONDOO_ID_TOKEN", " env-token\n")
+	token, err := oidcConfig{TokenEnv: "MONDOO_ID_TOKEN"}.token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "env-token", token)
+
+	t.Setenv("MONDOO_ID_TOKEN", "")
+	_, err = oidcConfig{TokenEnv: "MONDOO_ID_TOKEN"}.token(context.Background())
+	assert.Error(t, err)
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("file-token\n"), 0o600))
+	token, err = oidcConfig{TokenFile: tokenFile}.token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "file-token", token)
+
+	_, err = oidcConfig{TokenFile: filepath.Join(t.TempDir(), "missing")}.token(context.Background())
+	assert.Error(t, err)
+}
+
+func TestGitHubActionsToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer request-token", r.Header.Get("Authorization"))
+		assert.Equal(t, "mondoo", r.URL.Query().Get("audience"))
+		assert.Equal(t, "value", r.URL.Query().Get("api-version"))
+		_, _ = w.Write([]byte(`{"value":"github-token"}`))
+	}))
+	defer server.Close()
+
+	t.Setenv("ACTIONS_ID_TOKEN_REQUEST_URL", server.URL+"?api-version=value")
+	t.Setenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN", "request-token")
+	token, err := githubActionsToken(context.Background(), "mondoo")
+	require.NoError(t, err)
+	assert.Equal(t, "github-token", token)
+
+	t.Setenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN", "")
+	_, err = githubActionsToken(context.Background(), "mondoo")
+	assert.Error(t, err)
+}
+
+func testServiceAccount(t *testing.T, notAfter time.Time) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	privateKey, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	serviceAccount, err := json.Marshal(map[string]string{
+		"mrn":         "//agents.api.mondoo.app/organizations/my-org/serviceaccounts/test",
+		"scope_mrn":   "//captain.api.mondoo.app/organizations/my-org",
+		"private_key": string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateKey})),
+		"certificate": string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+	})
+	require.NoError(t, err)
+	return serviceAccount
+}
+
+func TestCredentialExpiresAt(t *testing.T) {
+	notAfter := time.Now().Add(time.Hour).Truncate(time.Second).UTC()
+	assert.Equal(t, notAfter, credentialExpiresAt(testServiceAccount(t, notAfter)).UTC())
+	assert.True(t, credentialExpiresAt([]byte(`{"certificate":"invalid"}`)).IsZero())
+	assert.True(t, credentialExpiresAt([]byte(`invalid`)).IsZero())
+}
+
+func TestOidcCredentials(t *testing.T) {
+	t.Setenv("MONDOO_ID_TOKEN", "id-token")
+
+	exchanges := 0
+	notAfter := time.Now().Add(time.Hour)
+	creds := newOidcCredentials(oidcConfig{
+		Issuer:         "https://gitlab.com",
+		Audience:       "mondoo",
+		UniverseDomain: "https://api.mondoo.com",
+		TokenEnv:       "MONDOO_ID_TOKEN",
+	}, nil)
+	creds.exchange = func(config *wif) ([]byte, error) {
+		exchanges++
+		assert.Equal(t, "https://api.mondoo.com", config.UniverseDomain)
+		assert.Equal(t, "mondoo", config.Audience)
+		assert.Equal(t, "https://gitlab.com", config.IssuerURI)
+		assert.Equal(t, "id-token", config.JWTToken)
+		return testServiceAccount(t, notAfter), nil
+	}
+
+	first, err := creds.current(context.Background())
+	require.NoError(t, err)
+	client, err := creds.current(context.Background())
+	require.NoError(t, err)
+	assert.Same(t, first, client)
+	assert.Equal(t, 1, exchanges)
+
+	// a rejected credential is replaced once, later callers with the same stale client reuse it
+	renewed, err := creds.renew(context.Background(), first)
+	require.NoError(t, err)
+	assert.NotSame(t, first, renewed)
+	client, err = creds.renew(context.Background(), first)
+	require.NoError(t, err)
+	assert.Same(t, renewed, client)
+	assert.Equal(t, 2, exchanges)
+
+	// credentials that are about to expire are refreshed
+	creds.expiresAt = time.Now().Add(oidcRefreshMargin / 2)
+	client, err = creds.current(context.Background())
+	require.NoError(t, err)
+	assert.NotSame(t, renewed, client)
+	assert.Equal(t, 3, exchanges)
+
+	creds.expiresAt = time.Now()
+	creds.exchange = func(*wif) ([]byte, error) {
+		return nil, errors.New("token expired")
+	}
+	_, err = creds.current(context.Background())
+	assert.ErrorContains(t, err, "token expired")
+}
+
+func TestOidcOverriddenBy(t *testing.T) {
+	env := map[string]string{}
+	getenv := func(key string) string { return env[key] }
+
+	assert.Empty(t, oidcOverriddenBy(getenv))
+	env["MONDOO_API_TOKEN"] = "token"
+	assert.Equal(t, "MONDOO_API_TOKEN", oidcOverriddenBy(getenv))
+	env["MONDOO_CONFIG_PATH"] = "/etc/opt/mondoo/mondoo.yml"
+	assert.Equal(t, "MONDOO_CONFIG_PATH", oidcOverriddenBy(getenv))
+	env["MONDOO_CONFIG_BASE64"] = "e30="
+	assert.Equal(t, "MONDOO_CONFIG_BASE64", oidcOverriddenBy(getenv))
+}
+
+func TestIsUnauthenticatedError(t *testing.T) {
+	assert.False(t, isUnauthenticatedError(nil))
+	assert.False(t, isUnauthenticatedError(errors.New("not found")))
+	assert.True(t, isUnauthenticatedError(errors.New("rpc error: code = Unauthenticated desc = certificate expired")))
+	assert.True(t, isUnauthenticatedError(errors.New("non-200 OK status code: 401 Unauthorized body: \"\"")))
+}
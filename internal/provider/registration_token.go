@@ -5,11 +5,16 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -19,6 +24,8 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &RegistrationTokenResource{}
+var _ resource.ResourceWithValidateConfig = &RegistrationTokenResource{}
+var _ resource.ResourceWithModifyPlan = &RegistrationTokenResource{}
 
 func NewRegistrationTokenResource() resource.Resource {
 	return &RegistrationTokenResource{}
@@ -37,14 +44,29 @@ type RegistrationTokenResourceModel struct {
 	SpaceID types.String `tfsdk:"space_id"`
 
 	// registration token details
-	Description  types.String `tfsdk:"description"`
-	NoExpiration types.Bool   `tfsdk:"no_expiration"`
-	ExpiresIn    types.String `tfsdk:"expires_in"`
+	Description  types.String                    `tfsdk:"description"`
+	NoExpiration types.Bool                      `tfsdk:"no_expiration"`
+	ExpiresIn    types.String                    `tfsdk:"expires_in"`
+	Labels       types.Map                       `tfsdk:"labels"`
+	Annotations  types.Map                       `tfsdk:"annotations"`
+	Rotation     *RegistrationTokenRotationModel `tfsdk:"rotation"`
 
 	// output
-	ExpiresAt types.String `tfsdk:"expires_at"`
-	Revoked   types.Bool   `tfsdk:"revoked"`
-	Result    types.String `tfsdk:"result"`
+	ExpiresAt  types.String `tfsdk:"expires_at"`
+	Revoked    types.Bool   `tfsdk:"revoked"`
+	Result     types.String `tfsdk:"result"`
+	UsageCount types.Int64  `tfsdk:"usage_count"`
+	LastUsedAt types.String `tfsdk:"last_used_at"`
+
+	// previous token, valid during the grace period of a rotation
+	PreviousMrn        types.String `tfsdk:"previous_mrn"`
+	PreviousValidUntil types.String `tfsdk:"previous_valid_until"`
+	PreviousUsageCount types.Int64  `tfsdk:"previous_usage_count"`
+}
+
+type RegistrationTokenRotationModel struct {
+	RotateBefore types.String `tfsdk:"rotate_before"`
+	GracePeriod  types.String `tfsdk:"grace_period"`
 }
 
 func (r *RegistrationTokenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -86,20 +108,89 @@ func (r *RegistrationTokenResource) Schema(ctx context.Context, req resource.Sch
 				MarkdownDescription: "The duration after which the token will expire. Format: 1h, 1d, 1w, 1m, 1y",
 				Optional:            true,
 			},
+			"labels": schema.MapAttribute{
+				MarkdownDescription: "Labels applied to the assets that register with the token.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"annotations": schema.MapAttribute{
+				MarkdownDescription: "Default annotations applied to the assets that register with the token. Annotations set on the asset take precedence.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
 			"revoked": schema.BoolAttribute{
 				MarkdownDescription: "If set to true, the token is revoked.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"expires_at": schema.StringAttribute{
 				MarkdownDescription: "The date and time when the token will expire.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"result": schema.StringAttribute{
 				Description: "The generated token.",
 				Computed:    true,
 				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"usage_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of assets that registered with the token.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_used_at": schema.StringAttribute{
+				MarkdownDescription: "The date and time when an asset last registered with the token.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"previous_mrn": schema.StringAttribute{
+				MarkdownDescription: "The Mondoo Resource Name (MRN) of the token that was replaced by the last rotation, while it's still valid.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"previous_valid_until": schema.StringAttribute{
+				MarkdownDescription: "The date and time until which the previous token stays valid.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"previous_usage_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of assets that registered with the previous token.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"rotation": schema.SingleNestedBlock{
+				MarkdownDescription: "Rotates the token before it expires, without a gap for agents that still use the previous token. Requires `expires_in`.",
+				Attributes: map[string]schema.Attribute{
+					"rotate_before": schema.StringAttribute{
+						MarkdownDescription: "The duration before `expires_at` from which the next apply replaces the token with a new one. Format: 1h, 30m",
+						Required:            true,
+					},
+					"grace_period": schema.StringAttribute{
+						MarkdownDescription: "The duration for which the previous token stays valid after a rotation, at most until it expires. Use `0s` to revoke it right away. Format: 1h, 30m",
+						Required:            true,
+					},
+				},
 			},
 		},
 	}
@@ -125,89 +216,205 @@ func (r *RegistrationTokenResource) Configure(ctx context.Context, req resource.
 	r.client = client
 }
 
-func (r *RegistrationTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+func (r *RegistrationTokenResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	var data RegistrationTokenResourceModel
 
-	// Read Terraform plan data into the model
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Do GraphQL request to API to create the resource
-	description := data.Description.ValueString()
+	resp.Diagnostics.Append(validateRegistrationTokenResourceModel(&data)...)
+}
 
-	// Compute and validate the space
-	space, err := r.client.ComputeSpace(data.SpaceID)
-	if err != nil {
-		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+// validateRegistrationTokenResourceModel checks the durations of the token and its rotation.
+func validateRegistrationTokenResourceModel(data *RegistrationTokenResourceModel) (diagnostics diag.Diagnostics) {
+	expiresIn, ok := parseDurationAttribute(path.Root("expires_in"), data.ExpiresIn, &diagnostics)
+	if data.Rotation == nil {
 		return
 	}
-	ctx = tflog.SetField(ctx, "space_mrn", space.MRN())
 
-	var expires_in *mondoov1.Int
-	if !data.ExpiresIn.IsNull() {
-		duration, err := time.ParseDuration(data.ExpiresIn.ValueString())
+	rotateBefore, rotateBeforeOk := parseDurationAttribute(path.Root("rotation").AtName("rotate_before"), data.Rotation.RotateBefore, &diagnostics)
+	parseDurationAttribute(path.Root("rotation").AtName("grace_period"), data.Rotation.GracePeriod, &diagnostics)
+
+	if data.ExpiresIn.IsNull() {
+		diagnostics.AddAttributeError(
+			path.Root("rotation"),
+			"Missing Expiration",
+			"rotation requires expires_in, a token without expiration is never rotated",
+		)
+		return
+	}
+
+	if ok && rotateBeforeOk && rotateBefore >= expiresIn {
+		diagnostics.AddAttributeError(
+			path.Root("rotation").AtName("rotate_before"),
+			"Invalid Rotation",
+			fmt.Sprintf("rotate_before must be shorter than expires_in (%s), otherwise the token is rotated on every apply", expiresIn),
+		)
+	}
+	return
+}
+
+// parseDurationAttribute parses a configured duration, it returns false if the duration is not known.
+func parseDurationAttribute(attributePath path.Path, value types.String, diagnostics *diag.Diagnostics) (time.Duration, bool) {
+	if value.IsNull() || value.IsUnknown() {
+		return 0, false
+	}
+
+	duration, err := time.ParseDuration(value.ValueString())
+	if err != nil || duration < 0 {
+		diagnostics.AddAttributeError(
+			attributePath,
+			"Invalid Duration",
+			fmt.Sprintf("%s must be a positive duration such as 24h, got: %s", attributePath, value.ValueString()),
+		)
+		return 0, false
+	}
+	return duration, true
+}
+
+// registrationTokenRotationDue returns whether a token that expires at expiresAt must be rotated.
+func registrationTokenRotationDue(expiresAt types.String, rotation *RegistrationTokenRotationModel, now time.Time) bool {
+	if rotation == nil || expiresAt.IsNull() || expiresAt.IsUnknown() {
+		return false
+	}
+
+	expires, err := time.Parse(time.RFC3339, expiresAt.ValueString())
+	if err != nil {
+		return false
+	}
+	rotateBefore, err := time.ParseDuration(rotation.RotateBefore.ValueString())
+	if err != nil {
+		return false
+	}
+	return !now.Before(expires.Add(-rotateBefore))
+}
+
+// previousTokenValidUntil returns until when a token that expires at expiresAt stays valid
+// after it was rotated at now.
+func previousTokenValidUntil(expiresAt types.String, gracePeriod types.String, now time.Time) time.Time {
+	grace, err := time.ParseDuration(gracePeriod.ValueString())
+	if err != nil {
+		grace = 0
+	}
+	validUntil := now.Add(grace)
+
+	expires, err := time.Parse(time.RFC3339, expiresAt.ValueString())
+	if err == nil && expires.Before(validUntil) {
+		return expires
+	}
+	return validUntil
+}
+
+// registrationTokenInput builds the input to generate a token in the given space.
+func (m RegistrationTokenResourceModel) registrationTokenInput(spaceMrn string) (RegistrationTokenInput, error) {
+	var expiresIn *mondoov1.Int
+	if !m.ExpiresIn.IsNull() {
+		duration, err := time.ParseDuration(m.ExpiresIn.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Invalide expires_in value: "+data.ExpiresIn.ValueString(),
-				"Invalide expires_in value: "+data.ExpiresIn.ValueString(),
-			)
-			return
+			return RegistrationTokenInput{}, fmt.Errorf("invalid expires_in value: %s", m.ExpiresIn.ValueString())
 		}
-		expires_in = mondoov1.NewIntPtr(mondoov1.Int(duration.Seconds()))
+		expiresIn = mondoov1.NewIntPtr(mondoov1.Int(duration.Seconds()))
 	}
 
 	var noExpiration *mondoov1.Boolean
-	if !data.NoExpiration.IsNull() {
-		noExpiration = mondoov1.NewBooleanPtr(mondoov1.Boolean(data.NoExpiration.ValueBool()))
+	if !m.NoExpiration.IsNull() {
+		noExpiration = mondoov1.NewBooleanPtr(mondoov1.Boolean(m.NoExpiration.ValueBool()))
 	}
 
-	if expires_in != nil && noExpiration != nil {
-		resp.Diagnostics.AddError(
-			"Either expires_in or no_expiration must be set",
-			"Either expires_in or no_expiration must be set",
-		)
-		return
+	if expiresIn != nil && noExpiration != nil {
+		return RegistrationTokenInput{}, errors.New("either expires_in or no_expiration must be set")
 	}
 
-	registrationTokenInput := mondoov1.RegistrationTokenInput{
-		Description:  mondoov1.NewStringPtr(mondoov1.String(description)),
-		ScopeMrn:     mondoov1.NewStringPtr(mondoov1.String(space.MRN())),
-		ExpiresIn:    expires_in,
+	return RegistrationTokenInput{
+		Description:  mondoov1.NewStringPtr(mondoov1.String(m.Description.ValueString())),
+		ScopeMrn:     mondoov1.NewStringPtr(mondoov1.String(spaceMrn)),
+		ExpiresIn:    expiresIn,
 		NoExpiration: noExpiration,
+		Labels:       expandAnnotations(m.Labels),
+		Annotations:  expandAnnotations(m.Annotations),
+	}, nil
+}
+
+// setToken stores a token read from the API, the token itself can't be read back.
+func (m *RegistrationTokenResourceModel) setToken(payload RegistrationTokenPayload) {
+	m.Revoked = types.BoolValue(bool(payload.Revoked))
+	m.ExpiresAt = types.StringValue(string(payload.ExpiresAt))
+	m.UsageCount = types.Int64Value(int64(payload.UsageCount))
+	m.LastUsedAt = optionalString(string(payload.LastUsedAt))
+
+	// keep empty maps as they are configured
+	if labels := flattenAnnotations(payload.Labels); !labels.IsNull() || len(m.Labels.Elements()) != 0 {
+		m.Labels = labels
+	}
+	if annotations := flattenAnnotations(payload.Annotations); !annotations.IsNull() || len(m.Annotations.Elements()) != 0 {
+		m.Annotations = annotations
 	}
+}
 
-	tflog.Trace(ctx, "RegistrationTokenInput", map[string]interface{}{
-		"input": fmt.Sprintf("%+v", registrationTokenInput),
-	})
+// clearPrevious forgets the previous token once it's no longer valid.
+func (m *RegistrationTokenResourceModel) clearPrevious() {
+	m.PreviousMrn = types.StringNull()
+	m.PreviousValidUntil = types.StringNull()
+	m.PreviousUsageCount = types.Int64Null()
+}
+
+// generate creates a new token and stores it in the model.
+func (r *RegistrationTokenResource) generate(ctx context.Context, data *RegistrationTokenResourceModel) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	// Compute and validate the space
+	space, err := r.client.ComputeSpace(data.SpaceID)
+	if err != nil {
+		diagnostics.AddError("Invalid Configuration", err.Error())
+		return diagnostics
+	}
+	ctx = tflog.SetField(ctx, "space_mrn", space.MRN())
 
-	var generateRegistrationToken struct {
-		RegistrationToken struct {
-			Mrn         mondoov1.String
-			Description mondoov1.String
-			Token       mondoov1.String
-			Revoked     mondoov1.Boolean
-			ExpiresAt   mondoov1.String
-		} `graphql:"generateRegistrationToken(input: $input)"`
+	input, err := data.registrationTokenInput(space.MRN())
+	if err != nil {
+		diagnostics.AddError("Invalid Configuration", err.Error())
+		return diagnostics
 	}
 
-	err = r.client.Mutate(ctx, &generateRegistrationToken, registrationTokenInput, nil)
+	token, err := r.client.GenerateRegistrationToken(ctx, input)
 	if err != nil {
-		resp.Diagnostics.
+		diagnostics.
 			AddError("Client Error",
 				fmt.Sprintf("Unable to create registration token. Got error: %s", err),
 			)
+		return diagnostics
+	}
+
+	data.SpaceID = types.StringValue(space.ID())
+	data.Mrn = types.StringValue(string(token.Mrn))
+	data.Result = types.StringValue(string(token.Token))
+	data.Revoked = types.BoolValue(bool(token.Revoked))
+	data.ExpiresAt = types.StringValue(string(token.ExpiresAt))
+	data.UsageCount = types.Int64Value(0)
+	data.LastUsedAt = types.StringNull()
+	return diagnostics
+}
+
+func (r *RegistrationTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RegistrationTokenResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Save space mrn into the Terraform state.
-	data.Description = types.StringValue(description)
-	data.Mrn = types.StringValue(string(generateRegistrationToken.RegistrationToken.Mrn))
-	data.Result = types.StringValue(string(generateRegistrationToken.RegistrationToken.Token))
-	data.Revoked = types.BoolValue(bool(generateRegistrationToken.RegistrationToken.Revoked))
-	data.ExpiresAt = types.StringValue(string(generateRegistrationToken.RegistrationToken.ExpiresAt))
+	// Do GraphQL request to API to create the resource
+	resp.Diagnostics.Append(r.generate(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.clearPrevious()
 
 	// Write logs using the tflog package
 	tflog.Trace(ctx, "created a token resource")
@@ -226,22 +433,160 @@ func (r *RegistrationTokenResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
-	// nothing to do here, we already have the data in the state
+	// Do GraphQL request to API to get the resource.
+	token, err := r.client.GetRegistrationToken(ctx, data.Mrn.ValueString())
+	if isNotFoundError(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to read registration token. Got error: %s", err),
+			)
+		return
+	}
+	data.setToken(token)
+
+	if !data.PreviousMrn.IsNull() {
+		previous, err := r.client.GetRegistrationToken(ctx, data.PreviousMrn.ValueString())
+		validUntil, _ := time.Parse(time.RFC3339, data.PreviousValidUntil.ValueString())
+		switch {
+		case isNotFoundError(err), err == nil && bool(previous.Revoked), !time.Now().Before(validUntil):
+			tflog.Debug(ctx, "The previous registration token is no longer valid")
+			data.clearPrevious()
+		case err != nil:
+			resp.Diagnostics.
+				AddError("Client Error",
+					fmt.Sprintf("Unable to read previous registration token. Got error: %s", err),
+				)
+			return
+		default:
+			data.PreviousUsageCount = types.Int64Value(int64(previous.UsageCount))
+		}
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// ModifyPlan plans a new token when the current one expires within rotate_before.
+func (r *RegistrationTokenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state RegistrationTokenResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !registrationTokenRotationDue(state.ExpiresAt, plan.Rotation, time.Now()) {
+		return
+	}
+
+	tflog.Debug(ctx, "Planning a new registration token")
+	plan.Mrn = types.StringUnknown()
+	plan.Result = types.StringUnknown()
+	plan.Revoked = types.BoolUnknown()
+	plan.ExpiresAt = types.StringUnknown()
+	plan.UsageCount = types.Int64Unknown()
+	plan.LastUsedAt = types.StringUnknown()
+	plan.PreviousMrn = types.StringUnknown()
+	plan.PreviousValidUntil = types.StringUnknown()
+	plan.PreviousUsageCount = types.Int64Unknown()
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// rotate replaces the token in state with a new one. The replaced token stays valid
+// for the grace period, a token that was still in its grace period is revoked.
+func (r *RegistrationTokenResource) rotate(ctx context.Context, data, state *RegistrationTokenResourceModel) diag.Diagnostics {
+	diagnostics := r.generate(ctx, data)
+	if diagnostics.HasError() {
+		return diagnostics
+	}
+	data.clearPrevious()
+
+	if !state.PreviousMrn.IsNull() {
+		tflog.Debug(ctx, "Revoking registration token", map[string]interface{}{"mrn": state.PreviousMrn.ValueString()})
+		if err := r.client.RevokeRegistrationToken(ctx, state.PreviousMrn.ValueString()); err != nil && !isNotFoundError(err) {
+			diagnostics.AddWarning("Client Error",
+				fmt.Sprintf("Unable to revoke previous registration token. Got error: %s", err),
+			)
+		}
+	}
+
+	now := time.Now()
+	validUntil := previousTokenValidUntil(state.ExpiresAt, data.Rotation.GracePeriod, now)
+	if !validUntil.After(now) {
+		tflog.Debug(ctx, "Revoking registration token", map[string]interface{}{"mrn": state.Mrn.ValueString()})
+		if err := r.client.RevokeRegistrationToken(ctx, state.Mrn.ValueString()); err != nil && !isNotFoundError(err) {
+			diagnostics.AddWarning("Client Error",
+				fmt.Sprintf("Unable to revoke replaced registration token. Got error: %s", err),
+			)
+		}
+		return diagnostics
+	}
+
+	err := r.client.UpdateRegistrationToken(ctx, UpdateRegistrationTokenInput{
+		Mrn:       mondoov1.String(state.Mrn.ValueString()),
+		ExpiresAt: mondoov1.NewStringPtr(mondoov1.String(validUntil.UTC().Format(time.RFC3339))),
+	})
+	if err != nil {
+		diagnostics.AddWarning("Client Error",
+			fmt.Sprintf("Unable to shorten the validity of the replaced registration token, it stays valid until %s. Got error: %s", state.ExpiresAt.ValueString(), err),
+		)
+		validUntil, _ = time.Parse(time.RFC3339, state.ExpiresAt.ValueString())
+	}
+	data.PreviousMrn = state.Mrn
+	data.PreviousValidUntil = types.StringValue(validUntil.UTC().Format(time.RFC3339))
+	data.PreviousUsageCount = state.UsageCount
+	return diagnostics
+}
+
 func (r *RegistrationTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data RegistrationTokenResourceModel
+	var data, state RegistrationTokenResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and prior state data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Do GraphQL request to API to update the resource
+	switch {
+	case data.Mrn.IsUnknown():
+		resp.Diagnostics.Append(r.rotate(ctx, &data, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	case !data.Labels.Equal(state.Labels) || !data.Annotations.Equal(state.Annotations):
+		input := UpdateRegistrationTokenInput{
+			Mrn:         mondoov1.String(data.Mrn.ValueString()),
+			Labels:      expandAnnotations(data.Labels),
+			Annotations: expandAnnotations(data.Annotations),
+		}
+		// send empty lists to remove labels and annotations
+		if input.Labels == nil {
+			input.Labels = &[]mondoov1.AnnotationInput{}
+		}
+		if input.Annotations == nil {
+			input.Annotations = &[]mondoov1.AnnotationInput{}
+		}
+		if err := r.client.UpdateRegistrationToken(ctx, input); err != nil {
+			resp.Diagnostics.
+				AddError("Client Error",
+					fmt.Sprintf("Unable to update registration token. Got error: %s", err),
+				)
+			return
+		}
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -256,40 +601,19 @@ func (r *RegistrationTokenResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
-	// Do GraphQL request to API to revoke the token.
-	var revokeMutation struct {
-		RevokeRegistrationTokenResponse struct {
-			RevokeRegistrationTokenSuccess struct {
-				Ok mondoov1.Boolean
-			} `graphql:"... on RevokeRegistrationTokenSuccess"`
-			RevokeRegistrationTokenFailure struct {
-				Message mondoov1.String
-				Code    mondoov1.String
-			} `graphql:"... on RevokeRegistrationTokenFailure"`
-		} `graphql:"revokeRegistrationToken(input: $input)"`
-	}
-
-	// Compute and validate the space
-	space, err := r.client.ComputeSpace(data.SpaceID)
-	if err != nil {
-		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
-		return
-	}
-	ctx = tflog.SetField(ctx, "space_mrn", space.MRN())
-
-	revokeInput := mondoov1.RevokeRegistrationTokenInput{
-		Mrn: mondoov1.String(space.MRN()),
-	}
-	tflog.Trace(ctx, "RevokeRegistrationTokenInput", map[string]interface{}{
-		"input": fmt.Sprintf("%+v", revokeInput),
-	})
-	err = r.client.Mutate(ctx, &revokeMutation, revokeInput, nil)
-	if err != nil {
-		resp.Diagnostics.
-			AddError("Client Error",
-				fmt.Sprintf("Unable to update token. Got error: %s", err),
-			)
-		return
+	// Do GraphQL request to API to revoke the token and the previous token.
+	for _, tokenMrn := range []types.String{data.Mrn, data.PreviousMrn} {
+		if tokenMrn.IsNull() {
+			continue
+		}
+		err := r.client.RevokeRegistrationToken(ctx, tokenMrn.ValueString())
+		if err != nil && !isNotFoundError(err) {
+			resp.Diagnostics.
+				AddError("Client Error",
+					fmt.Sprintf("Unable to revoke registration token. Got error: %s", err),
+				)
+			return
+		}
 	}
 }
 
@@ -5,9 +5,15 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	mondoov1 "go.mondoo.com/mondoo-go"
 )
 
 func TestAccRegistrationTokenResource(t *testing.T) {
@@ -40,6 +46,22 @@ func TestAccRegistrationTokenResource(t *testing.T) {
 					resource.TestCheckResourceAttr("mondoo_registration_token.test", "description", "one"),
 				),
 			},
+			// Labels, annotations and rotation
+			{
+				Config: testAccRegistrationTokenResourceRotationConfig(orgID, "prod"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mondoo_registration_token.rotated", "labels.env", "prod"),
+					resource.TestCheckResourceAttr("mondoo_registration_token.rotated", "annotations.owner", "platform"),
+					resource.TestCheckResourceAttr("mondoo_registration_token.rotated", "usage_count", "0"),
+					resource.TestCheckNoResourceAttr("mondoo_registration_token.rotated", "previous_mrn"),
+				),
+			},
+			{
+				Config: testAccRegistrationTokenResourceRotationConfig(orgID, "staging"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mondoo_registration_token.rotated", "labels.env", "staging"),
+				),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -59,3 +81,154 @@ resource "mondoo_registration_token" "test" {
 }
 `, resourceOrgID, configurableAttribute)
 }
+
+func testAccRegistrationTokenResourceRotationConfig(resourceOrgID, env string) string {
+	return fmt.Sprintf(`
+
+resource "mondoo_space" "test" {
+  org_id = %[1]q
+  name = "registration-token-test"
+}
+
+resource "mondoo_registration_token" "rotated" {
+  space_id   = mondoo_space.test.id
+  expires_in = "720h"
+
+  labels = {
+    env = %[2]q
+  }
+
+  annotations = {
+    owner = "platform"
+  }
+
+  rotation {
+    rotate_before = "168h"
+    grace_period  = "72h"
+  }
+}
+`, resourceOrgID, env)
+}
+
+func TestAccRegistrationTokenResourceValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "mondoo_registration_token" "test" {
+  no_expiration = true
+
+  rotation {
+    rotate_before = "24h"
+    grace_period  = "1h"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile("rotation requires expires_in"),
+			},
+			{
+				Config: `
+resource "mondoo_registration_token" "test" {
+  expires_in = "24h"
+
+  rotation {
+    rotate_before = "48h"
+    grace_period  = "1h"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile("rotate_before must be shorter than expires_in"),
+			},
+		},
+	})
+}
+
+func TestValidateRegistrationTokenResourceModel(t *testing.T) {
+	model := func(expiresIn, rotateBefore, gracePeriod types.String) *RegistrationTokenResourceModel {
+		return &RegistrationTokenResourceModel{
+			ExpiresIn: expiresIn,
+			Rotation: &RegistrationTokenRotationModel{
+				RotateBefore: rotateBefore,
+				GracePeriod:  gracePeriod,
+			},
+		}
+	}
+
+	assert.False(t, validateRegistrationTokenResourceModel(model(types.StringValue("720h"), types.StringValue("168h"), types.StringValue("72h"))).HasError())
+	assert.False(t, validateRegistrationTokenResourceModel(model(types.StringUnknown(), types.StringValue("168h"), types.StringValue("0s"))).HasError())
+	assert.True(t, validateRegistrationTokenResourceModel(model(types.StringNull(), types.StringValue("168h"), types.StringValue("72h"))).HasError())
+	assert.True(t, validateRegistrationTokenResourceModel(model(types.StringValue("24h"), types.StringValue("24h"), types.StringValue("1h"))).HasError())
+	assert.True(t, validateRegistrationTokenResourceModel(model(types.StringValue("720h"), types.StringValue("7d"), types.StringValue("1h"))).HasError())
+	assert.True(t, validateRegistrationTokenResourceModel(model(types.StringValue("720h"), types.StringValue("168h"), types.StringValue("-1h"))).HasError())
+
+	assert.False(t, validateRegistrationTokenResourceModel(&RegistrationTokenResourceModel{ExpiresIn: types.StringNull()}).HasError())
+	assert.True(t, validateRegistrationTokenResourceModel(&RegistrationTokenResourceModel{ExpiresIn: types.StringValue("1w")}).HasError())
+}
+
+func TestRegistrationTokenRotationDue(t *testing.T) {
+	expiresAt := types.StringValue("2026-02-01T00:00:00Z")
+	rotation := &RegistrationTokenRotationModel{
+		RotateBefore: types.StringValue("168h"),
+		GracePeriod:  types.StringValue("72h"),
+	}
+
+	assert.True(t, registrationTokenRotationDue(expiresAt, rotation, time.Date(2026, 1, 25, 0, 0, 0, 0, time.UTC)))
+	assert.True(t, registrationTokenRotationDue(expiresAt, rotation, time.Date(2026, 2, 2, 0, 0, 0, 0, time.UTC)))
+	assert.False(t, registrationTokenRotationDue(expiresAt, rotation, time.Date(2026, 1, 24, 23, 0, 0, 0, time.UTC)))
+	assert.False(t, registrationTokenRotationDue(expiresAt, nil, time.Date(2026, 2, 2, 0, 0, 0, 0, time.UTC)))
+	assert.False(t, registrationTokenRotationDue(types.StringNull(), rotation, time.Date(2026, 2, 2, 0, 0, 0, 0, time.UTC)))
+	assert.False(t, registrationTokenRotationDue(types.StringValue("never"), rotation, time.Date(2026, 2, 2, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestPreviousTokenValidUntil(t *testing.T) {
+	now := time.Date(2026, 1, 25, 0, 0, 0, 0, time.UTC)
+	expiresAt := types.StringValue("2026-02-01T00:00:00Z")
+
+	assert.Equal(t, now.Add(72*time.Hour), previousTokenValidUntil(expiresAt, types.StringValue("72h"), now))
+	assert.Equal(t, time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), previousTokenValidUntil(expiresAt, types.StringValue("240h"), now), "the token never outlives its expiration")
+	assert.Equal(t, now, previousTokenValidUntil(expiresAt, types.StringValue("0s"), now))
+	assert.Equal(t, now.Add(time.Hour), previousTokenValidUntil(types.StringNull(), types.StringValue("1h"), now))
+}
+
+func TestRegistrationTokenInput(t *testing.T) {
+	data := RegistrationTokenResourceModel{
+		Description:  types.StringValue("agents"),
+		ExpiresIn:    types.StringValue("720h"),
+		NoExpiration: types.BoolNull(),
+		Labels:       ConvertMapValue(map[string]string{"env": "prod"}),
+		Annotations:  types.MapNull(types.StringType),
+	}
+
+	input, err := data.registrationTokenInput("//captain.api.mondoo.app/spaces/my-space")
+	require.NoError(t, err)
+	assert.Equal(t, mondoov1.String("//captain.api.mondoo.app/spaces/my-space"), *input.ScopeMrn)
+	assert.Equal(t, mondoov1.Int(720*60*60), *input.ExpiresIn)
+	assert.Nil(t, input.NoExpiration)
+	require.NotNil(t, input.Labels)
+	assert.Equal(t, []mondoov1.AnnotationInput{{Key: "env", Value: "prod"}}, *input.Labels)
+	assert.Nil(t, input.Annotations)
+
+	data.NoExpiration = types.BoolValue(true)
+	_, err = data.registrationTokenInput("//captain.api.mondoo.app/spaces/my-space")
+	assert.Error(t, err)
+}
+
+func TestRegistrationTokenSetToken(t *testing.T) {
+	data := RegistrationTokenResourceModel{
+		Labels:      ConvertMapValue(map[string]string{}),
+		Annotations: ConvertMapValue(map[string]string{"owner": "platform"}),
+	}
+
+	data.setToken(RegistrationTokenPayload{
+		ExpiresAt:  "2026-02-01T00:00:00Z",
+		UsageCount: 12,
+		LastUsedAt: "2026-01-20T10:00:00Z",
+	})
+	assert.False(t, data.Revoked.ValueBool())
+	assert.Equal(t, int64(12), data.UsageCount.ValueInt64())
+	assert.Equal(t, "2026-01-20T10:00:00Z", data.LastUsedAt.ValueString())
+	assert.False(t, data.Labels.IsNull(), "an empty map is kept as configured")
+	assert.True(t, data.Annotations.IsNull(), "annotations were removed outside of Terraform")
+}
@@ -0,0 +1,186 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = (*serviceAccountDataSource)(nil)
+
+func NewServiceAccountDataSource() datasource.DataSource {
+	return &serviceAccountDataSource{}
+}
+
+type serviceAccountDataSource struct {
+	client *ExtendedGqlClient
+}
+
+type serviceAccountDataSourceModel struct {
+	SpaceID types.String `tfsdk:"space_id"`
+	OrgID   types.String `tfsdk:"org_id"`
+
+	ID          types.String                `tfsdk:"id"`
+	Mrn         types.String                `tfsdk:"mrn"`
+	Name        types.String                `tfsdk:"name"`
+	Description types.String                `tfsdk:"description"`
+	Roles       types.List                  `tfsdk:"roles"`
+	CreatedAt   types.String                `tfsdk:"created_at"`
+	LastUsedAt  types.String                `tfsdk:"last_used_at"`
+	Creator     *serviceAccountCreatorModel `tfsdk:"creator"`
+}
+
+// findServiceAccountByName returns the only service account with the given name.
+func findServiceAccountByName(accounts []ServiceAccountPayload, name string) (ServiceAccountPayload, error) {
+	found := []ServiceAccountPayload{}
+	for _, account := range accounts {
+		if account.Name == name {
+			found = append(found, account)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return ServiceAccountPayload{}, fmt.Errorf("no service account with name %q found", name)
+	case 1:
+		return found[0], nil
+	default:
+		return ServiceAccountPayload{}, fmt.Errorf("%d service accounts with name %q found, use mrn to select one", len(found), name)
+	}
+}
+
+func (d *serviceAccountDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_account"
+}
+
+func (d *serviceAccountDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := serviceAccountDataSourceAttributes()
+	for k, v := range serviceAccountScopeAttributes() {
+		attributes[k] = v
+	}
+	attributes["mrn"] = schema.StringAttribute{
+		Computed:            true,
+		Optional:            true,
+		MarkdownDescription: "Service account MRN",
+		Validators: []validator.String{
+			// Validate only this attribute or name is configured.
+			stringvalidator.ExactlyOneOf(path.Expressions{
+				path.MatchRoot("name"),
+			}...),
+		},
+	}
+	attributes["name"] = schema.StringAttribute{
+		Computed:            true,
+		Optional:            true,
+		MarkdownDescription: "Service account name, looked up in the space or organization",
+		Validators: []validator.String{
+			// Validate only this attribute or mrn is configured.
+			stringvalidator.ExactlyOneOf(path.Expressions{
+				path.MatchRoot("mrn"),
+			}...),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source to look up a service account by MRN or name, with its roles and when it was last used.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *serviceAccountDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ExtendedGqlClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *mondoov1.Client. Got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *serviceAccountDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data serviceAccountDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var account ServiceAccountPayload
+	if data.Mrn.ValueString() != "" {
+		ctx = tflog.SetField(ctx, "service_account_mrn", data.Mrn.ValueString())
+		tflog.Debug(ctx, "Fetching service account")
+
+		var err error
+		account, err = d.client.GetServiceAccount(ctx, data.Mrn.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("Unable to fetch service account. Got error: %s", err),
+			)
+			return
+		}
+	} else {
+		scopeMrn, err := serviceAccountScope(d.client, data.SpaceID, data.OrgID)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+			return
+		}
+		ctx = tflog.SetField(ctx, "scope_mrn", scopeMrn)
+		tflog.Debug(ctx, "Looking up service account by name")
+
+		accounts, err := d.client.ListServiceAccounts(ctx, scopeMrn)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("Unable to fetch service accounts. Got error: %s", err),
+			)
+			return
+		}
+		account, err = findServiceAccountByName(accounts, data.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name"), "Service Account Not Found", err.Error())
+			return
+		}
+		if data.OrgID.IsNull() {
+			space, err := SpaceFrom(scopeMrn)
+			if err != nil {
+				resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+				return
+			}
+			data.SpaceID = types.StringValue(space.ID())
+		}
+	}
+
+	model := newServiceAccountModel(account)
+	data.ID = model.ID
+	data.Mrn = model.Mrn
+	data.Name = model.Name
+	data.Description = model.Description
+	data.Roles = model.Roles
+	data.CreatedAt = model.CreatedAt
+	data.LastUsedAt = model.LastUsedAt
+	data.Creator = model.Creator
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
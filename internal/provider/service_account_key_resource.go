@@ -0,0 +1,331 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	mondoov1 "go.mondoo.com/mondoo-go"
+)
+
+var _ resource.Resource = (*serviceAccountKeyResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*serviceAccountKeyResource)(nil)
+
+func NewServiceAccountKeyResource() resource.Resource {
+	return &serviceAccountKeyResource{}
+}
+
+type serviceAccountKeyResource struct {
+	client *ExtendedGqlClient
+}
+
+type serviceAccountKeyResourceModel struct {
+	ServiceAccountMrn types.String `tfsdk:"service_account_mrn"`
+	Description       types.String `tfsdk:"description"`
+
+	// rotation triggers
+	RotationDays types.Int64 `tfsdk:"rotation_days"`
+	Keepers      types.Map   `tfsdk:"keepers"`
+
+	// output
+	Mrn        types.String `tfsdk:"mrn"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+	ExpiresAt  types.String `tfsdk:"expires_at"`
+	LastUsedAt types.String `tfsdk:"last_used_at"`
+	Credential types.String `tfsdk:"credential"`
+}
+
+func (r *serviceAccountKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_account_key"
+}
+
+func (r *serviceAccountKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Issue an additional key for an existing Mondoo service account.
+
+Replacing the key doesn't change the service account, so IAM bindings that reference it keep working. The key is revoked when the resource is destroyed. Use ` + "`create_before_destroy`" + ` to issue the new key before the old one is revoked.`,
+		Attributes: map[string]schema.Attribute{
+			"service_account_mrn": schema.StringAttribute{
+				MarkdownDescription: "The Mondoo Resource Name (MRN) of the service account to issue the key for.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the key.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rotation_days": schema.Int64Attribute{
+				MarkdownDescription: "Number of days after which the next apply replaces the key with a new one.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"keepers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that replace the key with a new one when they change.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"mrn": schema.StringAttribute{
+				MarkdownDescription: "The Mondoo Resource Name (MRN) of the key.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The date and time when the key was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "The date and time after which the key is rotated, `rotation_days` after it was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_used_at": schema.StringAttribute{
+				MarkdownDescription: "The date and time when the key was last used.",
+				Computed:            true,
+			},
+			"credential": schema.StringAttribute{
+				MarkdownDescription: "The service account credential of the key in JSON format, base64 encoded. This is the same content as the `credential` of `mondoo_service_account`.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *serviceAccountKeyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ExtendedGqlClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client. Got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// serviceAccountKeyExpiresAt returns when a key created at createdAt is rotated, or null if it's never rotated.
+func serviceAccountKeyExpiresAt(createdAt string, rotationDays types.Int64) (types.String, error) {
+	if rotationDays.IsNull() {
+		return types.StringNull(), nil
+	}
+
+	created, err := time.Parse(time.RFC3339, createdAt)
+	if err != nil {
+		return types.StringNull(), fmt.Errorf("invalid creation time %q: %w", createdAt, err)
+	}
+	expires := created.AddDate(0, 0, int(rotationDays.ValueInt64()))
+	return types.StringValue(expires.UTC().Format(time.RFC3339)), nil
+}
+
+// serviceAccountKeyNow returns the current time to decide whether a key is due for rotation.
+var serviceAccountKeyNow = time.Now
+
+// serviceAccountKeyRotationDue returns whether a key that expires at expiresAt must be replaced.
+func serviceAccountKeyRotationDue(expiresAt types.String, now time.Time) bool {
+	if expiresAt.IsNull() || expiresAt.IsUnknown() {
+		return false
+	}
+
+	expires, err := time.Parse(time.RFC3339, expiresAt.ValueString())
+	if err != nil {
+		return false
+	}
+	return !now.Before(expires)
+}
+
+func (r *serviceAccountKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data serviceAccountKeyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = tflog.SetField(ctx, "service_account_mrn", data.ServiceAccountMrn.ValueString())
+
+	// Do GraphQL request to API to create the resource
+	input := CreateServiceAccountKeyInput{
+		ServiceAccountMrn: mondoov1.String(data.ServiceAccountMrn.ValueString()),
+	}
+	if !data.Description.IsNull() {
+		input.Description = mondoov1.NewStringPtr(mondoov1.String(data.Description.ValueString()))
+	}
+
+	key, err := r.client.CreateServiceAccountKey(ctx, input)
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to create service account key. Got error: %s", err),
+			)
+		return
+	}
+
+	credential, err := serviceAccountCredential{
+		Mrn:         string(key.Mrn),
+		PrivateKey:  string(key.PrivateKey),
+		Certificate: string(key.Certificate),
+		ApiEndpoint: string(key.ApiEndpoint),
+		ScopeMrn:    string(key.ScopeMrn),
+		ParentMrn:   string(key.ScopeMrn),
+	}.encode()
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to encode service account key. Got error: %s", err),
+			)
+		return
+	}
+
+	expiresAt, err := serviceAccountKeyExpiresAt(string(key.CreatedAt), data.RotationDays)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	data.Mrn = types.StringValue(string(key.Mrn))
+	data.CreatedAt = types.StringValue(string(key.CreatedAt))
+	data.ExpiresAt = expiresAt
+	data.LastUsedAt = types.StringNull()
+	data.Credential = types.StringValue(credential)
+
+	// Write logs using the tflog package
+	tflog.Debug(ctx, "created a service account key resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *serviceAccountKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data serviceAccountKeyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Do GraphQL request to API to get the resource.
+	key, err := r.client.GetServiceAccountKey(ctx, data.Mrn.ValueString())
+	if isNotFoundError(err) || (err == nil && key.Revoked) {
+		tflog.Debug(ctx, "Service account key was revoked outside of Terraform", map[string]interface{}{"mrn": data.Mrn.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to read service account key. Got error: %s", err),
+			)
+		return
+	}
+
+	data.LastUsedAt = optionalString(key.LastUsedAt)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// ModifyPlan replaces the key once it's older than rotation_days. Terraform only replaces resources
+// because of attributes that change, so the expiry of the key is planned as unknown, like the
+// expiry of the new key.
+func (r *serviceAccountKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var state serviceAccountKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if serviceAccountKeyRotationDue(state.ExpiresAt, serviceAccountKeyNow()) {
+		tflog.Debug(ctx, "Service account key is due for rotation", map[string]interface{}{"expires_at": state.ExpiresAt.ValueString()})
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expires_at"), types.StringUnknown())...)
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("expires_at"))
+	}
+}
+
+func (r *serviceAccountKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data serviceAccountKeyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every configurable attribute requires a replacement, there is nothing to update
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *serviceAccountKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data serviceAccountKeyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Do GraphQL request to API to revoke the key.
+	err := r.client.RevokeServiceAccountKey(ctx, data.Mrn.ValueString())
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to revoke service account key. Got error: %s", err),
+			)
+		return
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccServiceAccountKeyResource(t *testing.T) {
+	orgID, err := getOrgId()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var keyMrn string
+	t.Cleanup(func() { serviceAccountKeyNow = time.Now })
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccServiceAccountKeyResourceConfig(orgID, "one"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("mondoo_service_account_key.test", "service_account_mrn", "mondoo_service_account.test", "mrn"),
+					resource.TestCheckResourceAttrSet("mondoo_service_account_key.test", "mrn"),
+					resource.TestCheckResourceAttrSet("mondoo_service_account_key.test", "created_at"),
+					resource.TestCheckResourceAttrSet("mondoo_service_account_key.test", "expires_at"),
+					resource.TestCheckResourceAttrSet("mondoo_service_account_key.test", "credential"),
+				),
+			},
+			// Changing a keeper issues a new key for the same service account
+			{
+				Config: testAccServiceAccountKeyResourceConfig(orgID, "two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mondoo_service_account_key.test", "keepers.version", "two"),
+					resource.TestCheckResourceAttrPair("mondoo_service_account_key.test", "service_account_mrn", "mondoo_service_account.test", "mrn"),
+					resource.TestCheckResourceAttrWith("mondoo_service_account_key.test", "mrn", func(value string) error {
+						keyMrn = value
+						return nil
+					}),
+				),
+			},
+			// An expired key is replaced by the next apply
+			{
+				PreConfig: func() {
+					serviceAccountKeyNow = func() time.Time { return time.Now().AddDate(0, 0, 31) }
+				},
+				Config: testAccServiceAccountKeyResourceConfig(orgID, "two"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("mondoo_service_account_key.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(*terraform.State) error {
+						// the new key expires in 30 days
+						serviceAccountKeyNow = time.Now
+						return nil
+					},
+					resource.TestCheckResourceAttrWith("mondoo_service_account_key.test", "mrn", func(value string) error {
+						if value == keyMrn {
+							return fmt.Errorf("expected the expired key %s to be replaced", keyMrn)
+						}
+						return nil
+					}),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccServiceAccountKeyResourceConfig(resourceOrgID, version string) string {
+	return fmt.Sprintf(`
+resource "mondoo_service_account" "test" {
+  org_id = %[1]q
+  name   = "service-account-key-test"
+}
+
+resource "mondoo_service_account_key" "test" {
+  service_account_mrn = mondoo_service_account.test.mrn
+  description         = "rotated by terraform"
+  rotation_days       = 30
+
+  keepers = {
+    version = %[2]q
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, resourceOrgID, version)
+}
+
+func TestServiceAccountKeyExpiresAt(t *testing.T) {
+	expiresAt, err := serviceAccountKeyExpiresAt("2026-01-31T10:00:00Z", types.Int64Value(30))
+	require.NoError(t, err)
+	assert.Equal(t, "2026-03-02T10:00:00Z", expiresAt.ValueString())
+
+	expiresAt, err = serviceAccountKeyExpiresAt("2026-01-31T10:00:00Z", types.Int64Null())
+	require.NoError(t, err)
+	assert.True(t, expiresAt.IsNull())
+
+	_, err = serviceAccountKeyExpiresAt("yesterday", types.Int64Value(30))
+	assert.Error(t, err)
+}
+
+func TestServiceAccountKeyRotationDue(t *testing.T) {
+	expiresAt := types.StringValue("2026-03-02T10:00:00Z")
+
+	assert.False(t, serviceAccountKeyRotationDue(expiresAt, time.Date(2026, 3, 2, 9, 59, 0, 0, time.UTC)))
+	assert.True(t, serviceAccountKeyRotationDue(expiresAt, time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)))
+	assert.True(t, serviceAccountKeyRotationDue(expiresAt, time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)))
+	assert.False(t, serviceAccountKeyRotationDue(types.StringNull(), time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)))
+	assert.False(t, serviceAccountKeyRotationDue(types.StringUnknown(), time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)))
+}
+
+// serviceAccountKeyPlan returns the state and plan of a key that is not changed by the configuration.
+func serviceAccountKeyPlan(t *testing.T, model serviceAccountKeyResourceModel) (tfsdk.State, tfsdk.Plan) {
+	ctx := context.Background()
+	schemaResp := &fwresource.SchemaResponse{}
+	(&serviceAccountKeyResource{}).Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	require.False(t, state.Set(ctx, &model).HasError())
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: state.Raw.Copy()}
+	return state, plan
+}
+
+func TestServiceAccountKeyModifyPlan(t *testing.T) {
+	ctx := context.Background()
+	t.Cleanup(func() { serviceAccountKeyNow = time.Now })
+	serviceAccountKeyNow = func() time.Time { return time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC) }
+
+	model := serviceAccountKeyResourceModel{
+		ServiceAccountMrn: types.StringValue("//agents.api.mondoo.app/organizations/my-org/serviceaccounts/1"),
+		RotationDays:      types.Int64Value(30),
+		Keepers:           types.MapNull(types.StringType),
+		Mrn:               types.StringValue("//agents.api.mondoo.app/organizations/my-org/serviceaccounts/1/keys/1"),
+		CreatedAt:         types.StringValue("2026-01-31T10:00:00Z"),
+		ExpiresAt:         types.StringValue("2026-03-02T10:00:00Z"),
+		Credential:        types.StringValue("credential"),
+	}
+
+	t.Run("expired key is replaced", func(t *testing.T) {
+		state, plan := serviceAccountKeyPlan(t, model)
+		resp := &fwresource.ModifyPlanResponse{Plan: plan}
+		(&serviceAccountKeyResource{}).ModifyPlan(ctx, fwresource.ModifyPlanRequest{State: state, Plan: plan}, resp)
+		require.False(t, resp.Diagnostics.HasError())
+
+		// the replacement is only planned if the attribute changes
+		assert.Equal(t, path.Paths{path.Root("expires_at")}, resp.RequiresReplace)
+		var expiresAt types.String
+		require.False(t, resp.Plan.GetAttribute(ctx, path.Root("expires_at"), &expiresAt).HasError())
+		assert.True(t, expiresAt.IsUnknown())
+	})
+
+	t.Run("key is kept until it expires", func(t *testing.T) {
+		valid := model
+		valid.ExpiresAt = types.StringValue("2026-03-02T10:00:01Z")
+		state, plan := serviceAccountKeyPlan(t, valid)
+		resp := &fwresource.ModifyPlanResponse{Plan: plan}
+		(&serviceAccountKeyResource{}).ModifyPlan(ctx, fwresource.ModifyPlanRequest{State: state, Plan: plan}, resp)
+		require.False(t, resp.Diagnostics.HasError())
+
+		assert.Empty(t, resp.RequiresReplace)
+		assert.True(t, resp.Plan.Raw.Equal(state.Raw))
+	})
+}
+
+func TestServiceAccountCredentialEncode(t *testing.T) {
+	encoded, err := serviceAccountCredential{
+		Mrn:      "//agents.api.mondoo.app/organizations/my-org/serviceaccounts/key-1",
+		ScopeMrn: "//captain.api.mondoo.app/organizations/my-org",
+	}.encode()
+	require.NoError(t, err)
+
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	require.NoError(t, err)
+	var credential map[string]string
+	require.NoError(t, json.Unmarshal(decoded, &credential))
+	assert.Equal(t, "//agents.api.mondoo.app/organizations/my-org/serviceaccounts/key-1", credential["mrn"])
+	assert.Equal(t, "//captain.api.mondoo.app/organizations/my-org", credential["scope_mrn"])
+	assert.NotContains(t, credential, "private_key")
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	mondoov1 "go.mondoo.com/mondoo-go"
+	"go.mondoo.com/terraform-provider-mondoo/internal/mrn"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -40,6 +41,15 @@ type serviceAccountCredential struct {
 	ParentMrn string `json:"parent_mrn,omitempty"`
 }
 
+// encode returns the credential in JSON format, base64 encoded.
+func (c serviceAccountCredential) encode() (string, error) {
+	jsonData, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(jsonData), nil
+}
+
 func NewServiceAccountResource() resource.Resource {
 	return &ServiceAccountResource{}
 }
@@ -153,7 +163,7 @@ func (r *ServiceAccountResource) Configure(ctx context.Context, req resource.Con
 
 func (r *ServiceAccountResource) getScope(ctx context.Context, data ServiceAccountResourceModel) string {
 	// default to platform level
-	scopeMrn := "//platform.api.mondoo.app"
+	scopeMrn := mrn.PlatformMrn
 	// Give presedence to the org id
 	if data.OrgID.ValueString() != "" {
 		scopeMrn = orgPrefix + data.OrgID.ValueString()
@@ -253,7 +263,7 @@ func (r *ServiceAccountResource) Create(ctx context.Context, req resource.Create
 		ParentMrn:   string(createMutation.CreateServiceAccount.ScopeMrn),
 	}
 
-	jsonData, err := json.Marshal(serviceAccount)
+	credential, err := serviceAccount.encode()
 	if err != nil {
 		resp.Diagnostics.
 			AddError("Client Error",
@@ -263,7 +273,7 @@ func (r *ServiceAccountResource) Create(ctx context.Context, req resource.Create
 	}
 
 	// set Base64 encoded credential
-	data.Credential = types.StringValue(base64.StdEncoding.EncodeToString(jsonData))
+	data.Credential = types.StringValue(credential)
 
 	// Write logs using the tflog package
 	tflog.Debug(ctx, "created a service account resource")
@@ -0,0 +1,273 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = (*serviceAccountsDataSource)(nil)
+
+func NewServiceAccountsDataSource() datasource.DataSource {
+	return &serviceAccountsDataSource{}
+}
+
+type serviceAccountsDataSource struct {
+	client *ExtendedGqlClient
+}
+
+type serviceAccountsDataSourceModel struct {
+	SpaceID         types.String          `tfsdk:"space_id"`
+	OrgID           types.String          `tfsdk:"org_id"`
+	UnusedDays      types.Int64           `tfsdk:"unused_days"`
+	ServiceAccounts []serviceAccountModel `tfsdk:"service_accounts"`
+}
+
+type serviceAccountModel struct {
+	ID          types.String                `tfsdk:"id"`
+	Mrn         types.String                `tfsdk:"mrn"`
+	Name        types.String                `tfsdk:"name"`
+	Description types.String                `tfsdk:"description"`
+	Roles       types.List                  `tfsdk:"roles"`
+	CreatedAt   types.String                `tfsdk:"created_at"`
+	LastUsedAt  types.String                `tfsdk:"last_used_at"`
+	Creator     *serviceAccountCreatorModel `tfsdk:"creator"`
+}
+
+type serviceAccountCreatorModel struct {
+	Mrn   types.String `tfsdk:"mrn"`
+	Name  types.String `tfsdk:"name"`
+	Email types.String `tfsdk:"email"`
+}
+
+func newServiceAccountModel(payload ServiceAccountPayload) serviceAccountModel {
+	roles := make([]string, len(payload.Roles))
+	for i, role := range payload.Roles {
+		roles[i] = role.Mrn
+	}
+
+	model := serviceAccountModel{
+		ID:          types.StringValue(payload.Id),
+		Mrn:         types.StringValue(payload.Mrn),
+		Name:        types.StringValue(payload.Name),
+		Description: types.StringValue(payload.Description),
+		Roles:       ConvertListValue(roles),
+		CreatedAt:   optionalString(payload.CreatedAt),
+		LastUsedAt:  optionalString(payload.LastUsedAt),
+	}
+	if payload.Creator.Mrn != "" {
+		model.Creator = &serviceAccountCreatorModel{
+			Mrn:   types.StringValue(payload.Creator.Mrn),
+			Name:  optionalString(payload.Creator.Name),
+			Email: optionalString(payload.Creator.Email),
+		}
+	}
+	return model
+}
+
+// serviceAccountUnused returns whether the service account wasn't used since the given time. Service
+// accounts that were never used count as unused once they were created before that time.
+func serviceAccountUnused(payload ServiceAccountPayload, since time.Time) bool {
+	lastActivity := payload.LastUsedAt
+	if lastActivity == "" {
+		lastActivity = payload.CreatedAt
+	}
+
+	t, err := time.Parse(time.RFC3339, lastActivity)
+	if err != nil {
+		return false
+	}
+	return t.Before(since)
+}
+
+// serviceAccountDataSourceAttributes returns the schema of the details of a service account.
+func serviceAccountDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Service account ID",
+		},
+		"mrn": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Service account MRN",
+		},
+		"name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Service account name",
+		},
+		"description": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Service account description",
+		},
+		"roles": schema.ListAttribute{
+			Computed:            true,
+			ElementType:         types.StringType,
+			MarkdownDescription: "MRNs of the roles assigned to the service account",
+		},
+		"created_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Timestamp of when the service account was created",
+		},
+		"last_used_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Timestamp of when the service account was last used, empty if it was never used",
+		},
+		"creator": schema.SingleNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: "User or service account that created the service account",
+			Attributes: map[string]schema.Attribute{
+				"mrn": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "Creator MRN",
+				},
+				"name": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "Creator name",
+				},
+				"email": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "Creator email, if the creator is a user",
+				},
+			},
+		},
+	}
+}
+
+// serviceAccountScopeAttributes returns the schema of the space or organization to look up service accounts in.
+func serviceAccountScopeAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"space_id": schema.StringAttribute{
+			Computed:            true,
+			Optional:            true,
+			MarkdownDescription: "Space ID. If neither `space_id` nor `org_id` is set, the provider space is used.",
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(path.MatchRoot("org_id")),
+			},
+		},
+		"org_id": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Organization ID",
+		},
+	}
+}
+
+// serviceAccountScope returns the MRN of the space or organization to look up service accounts in.
+func serviceAccountScope(client *ExtendedGqlClient, spaceID, orgID types.String) (string, error) {
+	if orgID.ValueString() != "" {
+		return orgPrefix + orgID.ValueString(), nil
+	}
+
+	space, err := client.ComputeSpace(spaceID)
+	if err != nil {
+		return "", err
+	}
+	return space.MRN(), nil
+}
+
+func (d *serviceAccountsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_accounts"
+}
+
+func (d *serviceAccountsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := serviceAccountScopeAttributes()
+	attributes["unused_days"] = schema.Int64Attribute{
+		Optional:            true,
+		MarkdownDescription: "Only return service accounts that weren't used in the given number of days. Service accounts that were never used are returned once they're older than that.",
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+	}
+	attributes["service_accounts"] = schema.ListNestedAttribute{
+		Computed:            true,
+		MarkdownDescription: "List of service accounts",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: serviceAccountDataSourceAttributes(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source to return the service accounts of a space or organization, with their roles and when they were last used.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *serviceAccountsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ExtendedGqlClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *mondoov1.Client. Got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *serviceAccountsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data serviceAccountsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scopeMrn, err := serviceAccountScope(d.client, data.SpaceID, data.OrgID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+		return
+	}
+	ctx = tflog.SetField(ctx, "scope_mrn", scopeMrn)
+
+	tflog.Debug(ctx, "Fetching service accounts")
+	accounts, err := d.client.ListServiceAccounts(ctx, scopeMrn)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to fetch service accounts. Got error: %s", err),
+		)
+		return
+	}
+
+	if data.OrgID.IsNull() {
+		space, err := SpaceFrom(scopeMrn)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+			return
+		}
+		data.SpaceID = types.StringValue(space.ID())
+	}
+	data.ServiceAccounts = []serviceAccountModel{}
+	for _, account := range accounts {
+		if !data.UnusedDays.IsNull() {
+			since := time.Now().AddDate(0, 0, -int(data.UnusedDays.ValueInt64()))
+			if !serviceAccountUnused(account, since) {
+				continue
+			}
+		}
+		data.ServiceAccounts = append(data.ServiceAccounts, newServiceAccountModel(account))
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,125 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccServiceAccountsDataSource(t *testing.T) {
+	orgID, err := getOrgId()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccServiceAccountsDataSourceConfig(orgID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.mondoo_service_accounts.space", "service_accounts.#", "1"),
+					resource.TestCheckResourceAttr("data.mondoo_service_accounts.space", "service_accounts.0.name", "data-source-test"),
+					resource.TestCheckResourceAttr("data.mondoo_service_accounts.space", "service_accounts.0.roles.0", "//iam.api.mondoo.app/roles/viewer"),
+					resource.TestCheckResourceAttrSet("data.mondoo_service_accounts.space", "service_accounts.0.created_at"),
+					resource.TestCheckResourceAttr("data.mondoo_service_accounts.unused", "service_accounts.#", "0"),
+					resource.TestCheckResourceAttrPair("data.mondoo_service_account.by_name", "mrn", "mondoo_service_account.test", "mrn"),
+					resource.TestCheckResourceAttr("data.mondoo_service_account.by_mrn", "name", "data-source-test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccServiceAccountsDataSourceConfig(orgID string) string {
+	return fmt.Sprintf(`
+resource "mondoo_space" "test" {
+  org_id = %[1]q
+  name   = "service-accounts-test"
+}
+
+resource "mondoo_service_account" "test" {
+  space_id = mondoo_space.test.id
+  name     = "data-source-test"
+}
+
+data "mondoo_service_accounts" "space" {
+  space_id   = mondoo_space.test.id
+  depends_on = [mondoo_service_account.test]
+}
+
+data "mondoo_service_accounts" "unused" {
+  space_id    = mondoo_space.test.id
+  unused_days = 30
+  depends_on  = [mondoo_service_account.test]
+}
+
+data "mondoo_service_account" "by_name" {
+  space_id   = mondoo_space.test.id
+  name       = "data-source-test"
+  depends_on = [mondoo_service_account.test]
+}
+
+data "mondoo_service_account" "by_mrn" {
+  mrn = mondoo_service_account.test.mrn
+}
+`, orgID)
+}
+
+func TestServiceAccountUnused(t *testing.T) {
+	since := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.True(t, serviceAccountUnused(ServiceAccountPayload{CreatedAt: "2025-06-01T00:00:00Z", LastUsedAt: "2025-12-01T00:00:00Z"}, since))
+	assert.False(t, serviceAccountUnused(ServiceAccountPayload{CreatedAt: "2025-06-01T00:00:00Z", LastUsedAt: "2026-01-02T00:00:00Z"}, since))
+	assert.True(t, serviceAccountUnused(ServiceAccountPayload{CreatedAt: "2025-06-01T00:00:00Z"}, since), "never used")
+	assert.False(t, serviceAccountUnused(ServiceAccountPayload{CreatedAt: "2026-01-02T00:00:00Z"}, since), "recently created")
+	assert.False(t, serviceAccountUnused(ServiceAccountPayload{}, since))
+}
+
+func TestFindServiceAccountByName(t *testing.T) {
+	accounts := []ServiceAccountPayload{
+		{Mrn: "//agents.api.mondoo.app/spaces/my-space/serviceaccounts/1", Name: "ci"},
+		{Mrn: "//agents.api.mondoo.app/spaces/my-space/serviceaccounts/2", Name: "scanner"},
+		{Mrn: "//agents.api.mondoo.app/spaces/my-space/serviceaccounts/3", Name: "scanner"},
+	}
+
+	account, err := findServiceAccountByName(accounts, "ci")
+	require.NoError(t, err)
+	assert.Equal(t, "//agents.api.mondoo.app/spaces/my-space/serviceaccounts/1", account.Mrn)
+
+	_, err = findServiceAccountByName(accounts, "scanner")
+	assert.ErrorContains(t, err, "2 service accounts")
+
+	_, err = findServiceAccountByName(accounts, "deploy")
+	assert.ErrorContains(t, err, "no service account")
+}
+
+func TestNewServiceAccountModel(t *testing.T) {
+	payload := ServiceAccountPayload{
+		Mrn:       "//agents.api.mondoo.app/spaces/my-space/serviceaccounts/1",
+		Name:      "ci",
+		CreatedAt: "2025-06-01T00:00:00Z",
+		Roles:     []struct{ Mrn string }{{Mrn: "//iam.api.mondoo.app/roles/viewer"}},
+	}
+
+	model := newServiceAccountModel(payload)
+	assert.Equal(t, "ci", model.Name.ValueString())
+	assert.Len(t, model.Roles.Elements(), 1)
+	assert.True(t, model.LastUsedAt.IsNull())
+	assert.Nil(t, model.Creator)
+
+	payload.Creator.Mrn = "//captain.api.mondoo.app/users/1"
+	payload.Creator.Email = "jane@example.com"
+	model = newServiceAccountModel(payload)
+	require.NotNil(t, model.Creator)
+	assert.Equal(t, "jane@example.com", model.Creator.Email.ValueString())
+	assert.True(t, model.Creator.Name.IsNull())
+}
@@ -3,31 +3,39 @@
 
 package provider
 
-import "strings"
+import (
+	"fmt"
+	"strings"
 
-const spacePrefix = "//captain.api.mondoo.app/spaces/"
+	"go.mondoo.com/terraform-provider-mondoo/internal/mrn"
+)
+
+const spacePrefix = mrn.SpacePrefix
 
 // Helper type to handle both, space id and space mrn, interchangeably.
 type Space string
 
-// SpaceFrom receives either a space id or a space mrn and returns a `Space`.
-func SpaceFrom(space string) Space {
-	if strings.HasPrefix(space, spacePrefix) {
-		// MRN
-		spaceID := strings.Split(space, "/")[4]
-		// Using this index is safe since we check for a prefix that has 4 slashes
-		return Space(spaceID)
+// SpaceFrom receives either a space id or a space mrn and returns a `Space`. It returns an
+// error for MRNs that don't belong to a space.
+func SpaceFrom(space string) (Space, error) {
+	if !strings.HasPrefix(space, "//") {
+		// ID
+		return Space(space), nil
+	}
+	// MRN
+	m, err := mrn.Parse(space)
+	if err != nil {
+		return "", err
 	}
-	// ID
-	return Space(space)
+	if m.Service != mrn.CaptainService || m.SpaceID == "" {
+		return "", fmt.Errorf("invalid space MRN %q: must start with %s", space, spacePrefix)
+	}
+	return Space(m.SpaceID), nil
 }
 
 func (s Space) ID() string {
 	return string(s)
 }
 func (s Space) MRN() string {
-	if s == "" {
-		return ""
-	}
-	return spacePrefix + string(s)
+	return mrn.Space(string(s))
 }
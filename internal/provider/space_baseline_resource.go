@@ -0,0 +1,679 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	mondoov1 "go.mondoo.com/mondoo-go"
+)
+
+var (
+	_ resource.Resource                   = (*spaceBaselineResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*spaceBaselineResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*spaceBaselineResource)(nil)
+)
+
+// Kinds of baseline items that can drift.
+const (
+	baselineDriftPolicy       = "policy"
+	baselineDriftFramework    = "framework"
+	baselineDriftSpaceSetting = "space_setting"
+)
+
+func NewSpaceBaselineResource() resource.Resource {
+	return &spaceBaselineResource{}
+}
+
+type spaceBaselineResource struct {
+	client *ExtendedGqlClient
+}
+
+type spaceBaselineResourceModel struct {
+	// scope
+	SpaceID types.String `tfsdk:"space_id"`
+
+	// baseline
+	TemplateSpaceMrn types.String `tfsdk:"template_space_mrn"`
+	Policies         types.List   `tfsdk:"policies"`
+	Frameworks       types.List   `tfsdk:"frameworks"`
+	SpaceSettings    types.Object `tfsdk:"space_settings"`
+
+	// reconciliation
+	Drift types.List `tfsdk:"drift"`
+}
+
+type spaceBaselinePolicyModel struct {
+	Mrn   types.String `tfsdk:"mrn"`
+	State types.String `tfsdk:"state"`
+}
+
+type spaceBaselineFrameworkModel struct {
+	Mrn   types.String `tfsdk:"mrn"`
+	State types.String `tfsdk:"state"`
+}
+
+type spaceBaselineDriftModel struct {
+	Kind     types.String `tfsdk:"kind"`
+	Item     types.String `tfsdk:"item"`
+	Expected types.String `tfsdk:"expected"`
+	Actual   types.String `tfsdk:"actual"`
+}
+
+func spaceBaselineDriftAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"kind":     types.StringType,
+		"item":     types.StringType,
+		"expected": types.StringType,
+		"actual":   types.StringType,
+	}
+}
+
+// spaceBaseline is the desired state of a space, either read from a template space or
+// configured inline. Every map is keyed by the item that is compared with the target space.
+type spaceBaseline struct {
+	Policies      map[string]string
+	Frameworks    map[string]string
+	SpaceSettings *SpaceSettingsInput
+}
+
+func (r *spaceBaselineResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_space_baseline"
+}
+
+func (r *spaceBaselineResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Reconcile the policy assignments, compliance frameworks and settings of a Mondoo space against a baseline.
+
+The baseline is either read from a template space or configured inline. Items that are not part of the baseline are left as they are, and destroying the resource does not change the space.`,
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "Mondoo space identifier of the space to reconcile. If there is no space ID, the provider space is used.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"template_space_mrn": schema.StringAttribute{
+				MarkdownDescription: "MRN of the template space. Its active policies, enabled and previewed frameworks and space settings form the baseline. Conflicts with `policies`, `frameworks` and `space_settings`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("policies"),
+						path.MatchRoot("frameworks"),
+						path.MatchRoot("space_settings"),
+					),
+				},
+			},
+			"policies": schema.ListNestedAttribute{
+				MarkdownDescription: "Policies of the baseline.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"mrn": schema.StringAttribute{
+							MarkdownDescription: "Policy MRN.",
+							Required:            true,
+						},
+						"state": schema.StringAttribute{
+							MarkdownDescription: "Policy assignment state (preview, enabled, or disabled). Default is `enabled`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("enabled"),
+							Validators: []validator.String{
+								stringvalidator.OneOf("enabled", "disabled", "preview"),
+							},
+						},
+					},
+				},
+			},
+			"frameworks": schema.ListNestedAttribute{
+				MarkdownDescription: "Compliance frameworks of the baseline.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"mrn": schema.StringAttribute{
+							MarkdownDescription: "Compliance framework MRN.",
+							Required:            true,
+						},
+						"state": schema.StringAttribute{
+							MarkdownDescription: "State of the compliance framework. Default is `ENABLED`. Other valid values are `PREVIEW` and `DISABLED`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(frameworkStateEnabled),
+							Validators: []validator.String{
+								stringvalidator.OneOf(frameworkStateEnabled, frameworkStatePreview, frameworkStateDisabled),
+							},
+						},
+					},
+				},
+			},
+			"space_settings": baselineSpaceSettingsSchemaAttribute(),
+			"drift": schema.ListNestedAttribute{
+				MarkdownDescription: "Items of the space that differ from the baseline, as found when the resource was last refreshed. An update is planned whenever there is drift.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"kind": schema.StringAttribute{
+							MarkdownDescription: "Kind of the item, one of `policy`, `framework` or `space_setting`.",
+							Computed:            true,
+						},
+						"item": schema.StringAttribute{
+							MarkdownDescription: "MRN of the policy or framework, or path of the space setting.",
+							Computed:            true,
+						},
+						"expected": schema.StringAttribute{
+							MarkdownDescription: "Value of the item in the baseline.",
+							Computed:            true,
+						},
+						"actual": schema.StringAttribute{
+							MarkdownDescription: "Value of the item in the space.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// baselineSpaceSettingsSchemaAttribute returns the schema of the space settings without computed
+// values, only the configured settings are part of the baseline.
+func baselineSpaceSettingsSchemaAttribute() schema.SingleNestedAttribute {
+	attribute := optionalSpaceSettingsAttribute(spaceSettingsSchemaAttribute()).(schema.SingleNestedAttribute)
+	attribute.MarkdownDescription = "Space settings of the baseline. Only configured settings are reconciled. Conflicts with `template_space_mrn`."
+	return attribute
+}
+
+func optionalSpaceSettingsAttribute(attribute schema.Attribute) schema.Attribute {
+	switch a := attribute.(type) {
+	case schema.SingleNestedAttribute:
+		attributes := make(map[string]schema.Attribute, len(a.Attributes))
+		for name, nested := range a.Attributes {
+			attributes[name] = optionalSpaceSettingsAttribute(nested)
+		}
+		a.Attributes = attributes
+		a.Computed = false
+		a.PlanModifiers = nil
+		return a
+	case schema.BoolAttribute:
+		a.Computed = false
+		return a
+	case schema.Int32Attribute:
+		a.Computed = false
+		return a
+	}
+	return attribute
+}
+
+func (r *spaceBaselineResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data spaceBaselineResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateSpaceBaselineResourceModel(&data)...)
+}
+
+func validateSpaceBaselineResourceModel(data *spaceBaselineResourceModel) (diagnostics diag.Diagnostics) {
+	// values may be unknown during validation, they will be checked once they are known
+	if data.TemplateSpaceMrn.IsUnknown() || data.Policies.IsUnknown() || data.Frameworks.IsUnknown() || data.SpaceSettings.IsUnknown() {
+		return
+	}
+
+	if data.TemplateSpaceMrn.IsNull() && data.Policies.IsNull() && data.Frameworks.IsNull() && data.SpaceSettings.IsNull() {
+		diagnostics.AddError(
+			"MissingAttributeError",
+			"Either template_space_mrn or at least one of policies, frameworks and space_settings must be set.",
+		)
+	}
+
+	// elements may be unknown during validation, duplicates are checked once they are known
+	policies := []spaceBaselinePolicyModel{}
+	if d := data.Policies.ElementsAs(context.Background(), &policies, false); data.Policies.IsNull() || d.HasError() {
+		policies = nil
+	}
+	seen := map[string]bool{}
+	for _, p := range policies {
+		if p.Mrn.IsUnknown() {
+			continue
+		}
+		if seen[p.Mrn.ValueString()] {
+			diagnostics.AddError(
+				"DuplicatePolicyError",
+				fmt.Sprintf("Policy %s is configured more than once.", p.Mrn.ValueString()),
+			)
+		}
+		seen[p.Mrn.ValueString()] = true
+	}
+
+	frameworks := []spaceBaselineFrameworkModel{}
+	if d := data.Frameworks.ElementsAs(context.Background(), &frameworks, false); data.Frameworks.IsNull() || d.HasError() {
+		frameworks = nil
+	}
+	seen = map[string]bool{}
+	for _, f := range frameworks {
+		if f.Mrn.IsUnknown() {
+			continue
+		}
+		if seen[f.Mrn.ValueString()] {
+			diagnostics.AddError(
+				"DuplicateFrameworkError",
+				fmt.Sprintf("Framework %s is configured more than once.", f.Mrn.ValueString()),
+			)
+		}
+		seen[f.Mrn.ValueString()] = true
+	}
+	return
+}
+
+// ModifyPlan plans an update when the space drifted from the baseline, the update reconciles
+// the space and clears the drift.
+func (r *spaceBaselineResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	noDrift := types.ListValueMust(types.ObjectType{AttrTypes: spaceBaselineDriftAttrTypes()}, []attr.Value{})
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("drift"), noDrift)...)
+}
+
+func (r *spaceBaselineResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ExtendedGqlClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client. Got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// getBaseline returns the desired state, either read from the template space or from the configuration.
+func (r *spaceBaselineResource) getBaseline(ctx context.Context, data *spaceBaselineResourceModel) (*spaceBaseline, diag.Diagnostics) {
+	if data.TemplateSpaceMrn.IsNull() {
+		baseline := &spaceBaseline{
+			Policies:   map[string]string{},
+			Frameworks: map[string]string{},
+		}
+		var diags diag.Diagnostics
+		policies := []spaceBaselinePolicyModel{}
+		if !data.Policies.IsNull() {
+			diags.Append(data.Policies.ElementsAs(ctx, &policies, false)...)
+		}
+		for _, p := range policies {
+			baseline.Policies[p.Mrn.ValueString()] = p.State.ValueString()
+		}
+		frameworks := []spaceBaselineFrameworkModel{}
+		if !data.Frameworks.IsNull() {
+			diags.Append(data.Frameworks.ElementsAs(ctx, &frameworks, false)...)
+		}
+		for _, f := range frameworks {
+			baseline.Frameworks[f.Mrn.ValueString()] = f.State.ValueString()
+		}
+		settings, d := ObjectToSpaceSettingsInput(ctx, data.SpaceSettings)
+		diags.Append(d...)
+		baseline.SpaceSettings = settings
+		return baseline, diags
+	}
+
+	var diags diag.Diagnostics
+	templateMrn := data.TemplateSpaceMrn.ValueString()
+	baseline, err := readSpaceBaseline(ctx, r.client, templateMrn)
+	if err != nil {
+		diags.AddAttributeError(path.Root("template_space_mrn"), "Client Error",
+			fmt.Sprintf("Unable to read template space %s. Got error: %s", templateMrn, err),
+		)
+		return nil, diags
+	}
+
+	// only what is turned on in the template is part of the baseline
+	baseline.removeDisabled()
+	return baseline, diags
+}
+
+// removeDisabled removes the policies and frameworks that are disabled.
+func (b *spaceBaseline) removeDisabled() {
+	for mrn, state := range b.Policies {
+		if state == "disabled" {
+			delete(b.Policies, mrn)
+		}
+	}
+	for mrn, state := range b.Frameworks {
+		if state == frameworkStateDisabled {
+			delete(b.Frameworks, mrn)
+		}
+	}
+}
+
+// readSpaceBaseline returns the policy assignments, framework states and settings of a space.
+func readSpaceBaseline(ctx context.Context, client *ExtendedGqlClient, spaceMrn string) (*spaceBaseline, error) {
+	activePolicies, err := client.GetActivePolicies(ctx, spaceMrn)
+	if err != nil {
+		return nil, err
+	}
+	policies := map[string]string{}
+	for _, p := range activePolicies {
+		// policies inherited from the organization are not assigned to the space itself
+		if string(p.AssignedScope) == spaceMrn {
+			policies[string(p.Mrn)] = policyStateFromAPI(string(p.Action))
+		}
+	}
+
+	frameworks, err := client.ListFrameworks(ctx, spaceMrn)
+	if err != nil {
+		return nil, err
+	}
+	frameworkStates := map[string]string{}
+	for _, framework := range frameworks {
+		frameworkStates[string(framework.Mrn)] = frameworkStateFromAPI(string(framework.State))
+	}
+
+	space, err := client.GetSpace(ctx, spaceMrn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &spaceBaseline{
+		Policies:      policies,
+		Frameworks:    frameworkStates,
+		SpaceSettings: FlattenSpaceSettingsInput(space.Settings),
+	}, nil
+}
+
+// diffSpaceBaseline returns every item of the baseline that differs in the space, sorted by kind and item.
+func diffSpaceBaseline(ctx context.Context, baseline, actual *spaceBaseline) ([]spaceBaselineDriftModel, diag.Diagnostics) {
+	drift := diffBaselineItems(baselineDriftPolicy, baseline.Policies, actual.Policies, "disabled")
+	drift = append(drift, diffBaselineItems(baselineDriftFramework, baseline.Frameworks, actual.Frameworks, frameworkStateDisabled)...)
+
+	expectedSettings, diags := spaceSettingsValues(ctx, baseline.SpaceSettings)
+	if diags.HasError() {
+		return nil, diags
+	}
+	actualSettings, diags := spaceSettingsValues(ctx, actual.SpaceSettings)
+	if diags.HasError() {
+		return nil, diags
+	}
+	drift = append(drift, diffBaselineItems(baselineDriftSpaceSetting, expectedSettings, actualSettings, "null")...)
+	return drift, nil
+}
+
+// diffBaselineItems compares the expected items with the actual ones. Items that are missing
+// in the actual items have the provided missing value.
+func diffBaselineItems(kind string, expected, actual map[string]string, missing string) []spaceBaselineDriftModel {
+	items := make([]string, 0, len(expected))
+	for item := range expected {
+		items = append(items, item)
+	}
+	sort.Strings(items)
+
+	drift := []spaceBaselineDriftModel{}
+	for _, item := range items {
+		actualValue, ok := actual[item]
+		if !ok {
+			actualValue = missing
+		}
+		if actualValue == expected[item] {
+			continue
+		}
+		drift = append(drift, spaceBaselineDriftModel{
+			Kind:     types.StringValue(kind),
+			Item:     types.StringValue(item),
+			Expected: types.StringValue(expected[item]),
+			Actual:   types.StringValue(actualValue),
+		})
+	}
+	return drift
+}
+
+// spaceSettingsValues flattens the configured space settings into a map of setting paths to values,
+// like `cases_configuration.auto_create`. Settings that are not configured are left out.
+func spaceSettingsValues(ctx context.Context, settings *SpaceSettingsInput) (map[string]string, diag.Diagnostics) {
+	values := map[string]string{}
+	if settings == nil {
+		return values, nil
+	}
+
+	obj, diags := SpaceSettingsInputToObject(ctx, settings)
+	if diags.HasError() {
+		return nil, diags
+	}
+	collectSpaceSettingsValues(values, "", obj)
+	return values, nil
+}
+
+func collectSpaceSettingsValues(values map[string]string, prefix string, value attr.Value) {
+	if value.IsNull() || value.IsUnknown() {
+		return
+	}
+	obj, ok := value.(types.Object)
+	if !ok {
+		values[prefix] = value.String()
+		return
+	}
+	for name, nested := range obj.Attributes() {
+		collectSpaceSettingsValues(values, strings.TrimPrefix(prefix+"."+name, "."), nested)
+	}
+}
+
+// reconcileSpaceBaseline moves every drifted item of the space to its baseline state.
+func reconcileSpaceBaseline(ctx context.Context, client *ExtendedGqlClient, spaceMrn string, baseline *spaceBaseline, drift []spaceBaselineDriftModel) error {
+	policies := map[string][]string{}
+	settingsDrift := false
+	for _, d := range drift {
+		switch d.Kind.ValueString() {
+		case baselineDriftPolicy:
+			state := d.Expected.ValueString()
+			policies[state] = append(policies[state], d.Item.ValueString())
+		case baselineDriftFramework:
+			frameworkMrn, state := d.Item.ValueString(), d.Expected.ValueString()
+			tflog.Debug(ctx, "Updating framework state", map[string]interface{}{"framework_mrn": frameworkMrn, "state": state})
+			if err := client.ApplyFrameworkAction(ctx, frameworkMrn, spaceMrn, frameworkMutationAction(state)); err != nil {
+				return fmt.Errorf("unable to set framework %s to %s: %w", frameworkMrn, state, err)
+			}
+		case baselineDriftSpaceSetting:
+			settingsDrift = true
+		}
+	}
+
+	if mrns := policies["enabled"]; len(mrns) > 0 {
+		tflog.Debug(ctx, "Enabling policies", map[string]interface{}{"policy_mrns": mrns})
+		if err := client.AssignPolicy(ctx, spaceMrn, mondoov1.PolicyActionActive, mrns); err != nil {
+			return fmt.Errorf("unable to enable policies: %w", err)
+		}
+	}
+	if mrns := policies["preview"]; len(mrns) > 0 {
+		tflog.Debug(ctx, "Previewing policies", map[string]interface{}{"policy_mrns": mrns})
+		if err := client.AssignPolicy(ctx, spaceMrn, mondoov1.PolicyActionIgnore, mrns); err != nil {
+			return fmt.Errorf("unable to preview policies: %w", err)
+		}
+	}
+	if mrns := policies["disabled"]; len(mrns) > 0 {
+		tflog.Debug(ctx, "Disabling policies", map[string]interface{}{"policy_mrns": mrns})
+		if err := client.UnassignPolicy(ctx, spaceMrn, mrns); err != nil {
+			return fmt.Errorf("unable to disable policies: %w", err)
+		}
+	}
+
+	if settingsDrift {
+		space, err := client.GetSpace(ctx, spaceMrn)
+		if err != nil {
+			return err
+		}
+		tflog.Debug(ctx, "Updating space settings")
+		err = client.UpdateSpace(ctx, space.Id, space.Name, space.Description, ExpandSpaceSettings(baseline.SpaceSettings), nil)
+		if err != nil {
+			return fmt.Errorf("unable to update space settings: %w", err)
+		}
+	}
+	return nil
+}
+
+// apply reconciles the space of the plan with the baseline.
+func (r *spaceBaselineResource) apply(ctx context.Context, data *spaceBaselineResourceModel) (diags diag.Diagnostics) {
+	// Compute and validate the space
+	space, err := r.client.ComputeSpace(data.SpaceID)
+	if err != nil {
+		diags.AddError("Invalid Configuration", err.Error())
+		return
+	}
+	ctx = tflog.SetField(ctx, "space_mrn", space.MRN())
+
+	baseline, d := r.getBaseline(ctx, data)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	actual, err := readSpaceBaseline(ctx, r.client, space.MRN())
+	if err != nil {
+		diags.AddError("Client Error",
+			fmt.Sprintf("Unable to read space. Got error: %s", err),
+		)
+		return
+	}
+
+	drift, d := diffSpaceBaseline(ctx, baseline, actual)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reconciling space baseline", map[string]interface{}{"drift": len(drift)})
+	if err := reconcileSpaceBaseline(ctx, r.client, space.MRN(), baseline, drift); err != nil {
+		diags.AddError("Client Error",
+			fmt.Sprintf("Unable to reconcile space baseline. Got error: %s", err),
+		)
+		return
+	}
+
+	data.SpaceID = types.StringValue(space.ID())
+	data.Drift = types.ListValueMust(types.ObjectType{AttrTypes: spaceBaselineDriftAttrTypes()}, []attr.Value{})
+	return
+}
+
+func (r *spaceBaselineResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data spaceBaselineResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *spaceBaselineResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data spaceBaselineResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	space, err := r.client.ComputeSpace(data.SpaceID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+		return
+	}
+
+	baseline, diags := r.getBaseline(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	actual, err := readSpaceBaseline(ctx, r.client, space.MRN())
+	if err != nil {
+		resp.Diagnostics.
+			AddError("Client Error",
+				fmt.Sprintf("Unable to read space. Got error: %s", err),
+			)
+		return
+	}
+
+	drift, diags := diffSpaceBaseline(ctx, baseline, actual)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, d := range drift {
+		tflog.Info(ctx, "Space drifted from baseline", map[string]interface{}{
+			"kind":     d.Kind.ValueString(),
+			"item":     d.Item.ValueString(),
+			"expected": d.Expected.ValueString(),
+			"actual":   d.Actual.ValueString(),
+		})
+	}
+
+	data.Drift, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: spaceBaselineDriftAttrTypes()}, drift)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *spaceBaselineResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data spaceBaselineResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *spaceBaselineResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The baseline only reconciles the space, removing it leaves the space as it is.
+	tflog.Debug(ctx, "Removing space baseline from state")
+}
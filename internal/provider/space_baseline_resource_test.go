@@ -0,0 +1,214 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccSpaceBaselineResource(t *testing.T) {
+	orgID, err := getOrgId()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Reconcile a new space with an inline baseline
+			{
+				Config: testAccSpaceBaselineResourceConfig(orgID, "enabled"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("mondoo_space_baseline.inline", "space_id", "mondoo_space.target", "id"),
+					resource.TestCheckResourceAttr("mondoo_space_baseline.inline", "policies.#", "1"),
+					resource.TestCheckResourceAttr("mondoo_space_baseline.inline", "policies.0.state", "enabled"),
+					resource.TestCheckResourceAttr("mondoo_space_baseline.inline", "space_settings.cases_configuration.auto_create", "true"),
+					resource.TestCheckResourceAttr("mondoo_space_baseline.inline", "drift.#", "0"),
+				),
+			},
+			// Change the baseline
+			{
+				Config: testAccSpaceBaselineResourceConfig(orgID, "preview"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mondoo_space_baseline.inline", "policies.0.state", "preview"),
+					resource.TestCheckResourceAttr("mondoo_space_baseline.inline", "drift.#", "0"),
+				),
+			},
+			// Reconcile a space with a template space
+			{
+				Config: testAccSpaceBaselineResourceConfig(orgID, "preview") + `
+resource "mondoo_space" "copy" {
+  org_id = mondoo_space.target.org_id
+  name   = "baseline copy"
+}
+
+resource "mondoo_space_baseline" "template" {
+  space_id           = mondoo_space.copy.id
+  template_space_mrn = mondoo_space.target.mrn
+
+  depends_on = [mondoo_space_baseline.inline]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("mondoo_space_baseline.template", "template_space_mrn", "mondoo_space.target", "mrn"),
+					resource.TestCheckResourceAttr("mondoo_space_baseline.template", "drift.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSpaceBaselineResourceValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "mondoo_space_baseline" "empty" {
+  space_id = "my-space"
+}
+`,
+				ExpectError: regexp.MustCompile("template_space_mrn"),
+			},
+		},
+	})
+}
+
+func testAccSpaceBaselineResourceConfig(orgID, policyState string) string {
+	return fmt.Sprintf(`
+resource "mondoo_space" "target" {
+  org_id = %[1]q
+  name   = "baseline target"
+}
+
+resource "mondoo_space_baseline" "inline" {
+  space_id = mondoo_space.target.id
+
+  policies = [
+    {
+      mrn   = "//policy.api.mondoo.app/policies/mondoo-linux-security"
+      state = %[2]q
+    },
+  ]
+
+  space_settings = {
+    cases_configuration = {
+      auto_create = true
+    }
+  }
+}
+`, orgID, policyState)
+}
+
+func TestDiffBaselineItems(t *testing.T) {
+	drift := diffBaselineItems(baselineDriftPolicy,
+		map[string]string{"b": "enabled", "a": "preview", "c": "disabled", "d": "enabled"},
+		map[string]string{"a": "enabled", "b": "enabled", "d": "preview"},
+		"disabled",
+	)
+
+	require.Len(t, drift, 2)
+	assert.Equal(t, spaceBaselineDriftModel{
+		Kind:     types.StringValue("policy"),
+		Item:     types.StringValue("a"),
+		Expected: types.StringValue("preview"),
+		Actual:   types.StringValue("enabled"),
+	}, drift[0])
+	assert.Equal(t, "d", drift[1].Item.ValueString())
+	assert.Equal(t, "preview", drift[1].Actual.ValueString())
+
+	assert.Empty(t, diffBaselineItems(baselineDriftFramework, map[string]string{}, map[string]string{"a": "ENABLED"}, frameworkStateDisabled))
+}
+
+func TestSpaceSettingsValues(t *testing.T) {
+	ctx := context.Background()
+
+	values, diags := spaceSettingsValues(ctx, &SpaceSettingsInput{
+		CasesConfiguration: &CasesConfiguration{
+			AutoCreate:        types.BoolValue(true),
+			AggregationWindow: types.Int32Null(),
+		},
+		GarbageCollectAssetsConfiguration: &GarbageCollectAssetsConfiguration{
+			Enabled:   types.BoolValue(false),
+			AfterDays: types.Int32Value(30),
+		},
+	})
+	require.False(t, diags.HasError())
+	assert.Equal(t, map[string]string{
+		"cases_configuration.auto_create":                 "true",
+		"garbage_collect_assets_configuration.enabled":    "false",
+		"garbage_collect_assets_configuration.after_days": "30",
+	}, values)
+
+	values, diags = spaceSettingsValues(ctx, nil)
+	require.False(t, diags.HasError())
+	assert.Empty(t, values)
+}
+
+func TestDiffSpaceBaseline(t *testing.T) {
+	ctx := context.Background()
+
+	drift, diags := diffSpaceBaseline(ctx,
+		&spaceBaseline{
+			Policies:   map[string]string{"//policy": "enabled"},
+			Frameworks: map[string]string{"//framework": frameworkStatePreview},
+			SpaceSettings: &SpaceSettingsInput{
+				TerminatedAssetsConfiguration: &TerminatedAssetsConfiguration{Cleanup: types.BoolValue(true)},
+			},
+		},
+		&spaceBaseline{
+			Policies:      map[string]string{"//policy": "enabled", "//other": "preview"},
+			Frameworks:    map[string]string{},
+			SpaceSettings: &SpaceSettingsInput{},
+		},
+	)
+	require.False(t, diags.HasError())
+	require.Len(t, drift, 2)
+	assert.Equal(t, "framework", drift[0].Kind.ValueString())
+	assert.Equal(t, "DISABLED", drift[0].Actual.ValueString())
+	assert.Equal(t, "space_setting", drift[1].Kind.ValueString())
+	assert.Equal(t, "terminated_assets_configuration.cleanup", drift[1].Item.ValueString())
+	assert.Equal(t, "null", drift[1].Actual.ValueString())
+}
+
+func TestValidateSpaceBaselineResourceModel(t *testing.T) {
+	policyType := types.ObjectType{AttrTypes: map[string]attr.Type{"mrn": types.StringType, "state": types.StringType}}
+	policy := func(mrn string) attr.Value {
+		return types.ObjectValueMust(policyType.AttrTypes, map[string]attr.Value{
+			"mrn":   types.StringValue(mrn),
+			"state": types.StringValue("enabled"),
+		})
+	}
+	model := func(policies types.List) *spaceBaselineResourceModel {
+		return &spaceBaselineResourceModel{
+			TemplateSpaceMrn: types.StringNull(),
+			Policies:         policies,
+			Frameworks:       types.ListNull(policyType),
+			SpaceSettings:    types.ObjectNull(SpaceSettingsInputAttrTypes()),
+		}
+	}
+
+	diags := validateSpaceBaselineResourceModel(model(types.ListNull(policyType)))
+	assert.True(t, diags.HasError())
+
+	diags = validateSpaceBaselineResourceModel(model(types.ListValueMust(policyType, []attr.Value{policy("//a"), policy("//b")})))
+	assert.False(t, diags.HasError())
+
+	diags = validateSpaceBaselineResourceModel(model(types.ListValueMust(policyType, []attr.Value{policy("//a"), policy("//a")})))
+	assert.True(t, diags.HasError())
+
+	diags = validateSpaceBaselineResourceModel(model(types.ListUnknown(policyType)))
+	assert.False(t, diags.HasError())
+}
@@ -0,0 +1,448 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	mondoov1 "go.mondoo.com/mondoo-go"
+	"go.mondoo.com/terraform-provider-mondoo/internal/mondoovalidator"
+	"go.mondoo.com/terraform-provider-mondoo/internal/mrn"
+)
+
+var _ resource.Resource = (*spaceCloneResource)(nil)
+
+func NewSpaceCloneResource() resource.Resource {
+	return &spaceCloneResource{}
+}
+
+type spaceCloneResource struct {
+	client *ExtendedGqlClient
+}
+
+type spaceCloneResourceModel struct {
+	// scope
+	SpaceID       types.String `tfsdk:"space_id"`
+	SourceSpaceID types.String `tfsdk:"source_space_id"`
+
+	// what to copy
+	CopyCustomPolicies   types.Bool `tfsdk:"copy_custom_policies"`
+	CopyQueryPacks       types.Bool `tfsdk:"copy_query_packs"`
+	CopyCustomFrameworks types.Bool `tfsdk:"copy_custom_frameworks"`
+	CopyAssignments      types.Bool `tfsdk:"copy_assignments"`
+	CopyWorkspaces       types.Bool `tfsdk:"copy_workspaces"`
+	CopySpaceSettings    types.Bool `tfsdk:"copy_space_settings"`
+
+	// copied content
+	PolicyMrns    types.List `tfsdk:"policy_mrns"`
+	QueryPackMrns types.List `tfsdk:"query_pack_mrns"`
+	FrameworkMrns types.List `tfsdk:"framework_mrns"`
+	WorkspaceMrns types.List `tfsdk:"workspace_mrns"`
+}
+
+func (r *spaceCloneResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_space_clone"
+}
+
+// copyAttribute returns the schema of an attribute that selects content to copy.
+func copyAttribute(description string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: description + " Defaults to `true`.",
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(true),
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+func (r *spaceCloneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Copy the content of a Mondoo space into another space.
+
+The content is copied once, when the resource is created. Integrations and assets are not copied. Destroying the resource leaves the copied content in the space. When copying fails, the resource keeps the content copied so far and the next apply copies again, skipping workspaces that already exist in the space.`,
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "Mondoo space identifier of the space to copy into. If there is no space ID, the provider space is used.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_space_id": schema.StringAttribute{
+				MarkdownDescription: "Mondoo space identifier of the space to copy from.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					mondoovalidator.Id(),
+				},
+			},
+			"copy_custom_policies":   copyAttribute("Whether to copy the custom policies of the source space."),
+			"copy_query_packs":       copyAttribute("Whether to copy the custom query packs of the source space."),
+			"copy_custom_frameworks": copyAttribute("Whether to copy the custom compliance frameworks of the source space."),
+			"copy_assignments":       copyAttribute("Whether to copy the policies and compliance frameworks that are enabled or in preview in the source space."),
+			"copy_workspaces":        copyAttribute("Whether to copy the workspaces of the source space."),
+			"copy_space_settings":    copyAttribute("Whether to copy the space settings of the source space."),
+			"policy_mrns": schema.ListAttribute{
+				MarkdownDescription: "MRNs of the custom policies created in the space.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"query_pack_mrns": schema.ListAttribute{
+				MarkdownDescription: "MRNs of the custom query packs created in the space.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"framework_mrns": schema.ListAttribute{
+				MarkdownDescription: "MRNs of the custom compliance frameworks created in the space.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"workspace_mrns": schema.ListAttribute{
+				MarkdownDescription: "MRNs of the workspaces copied into the space.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *spaceCloneResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ExtendedGqlClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client. Got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// isSpaceContent returns whether the MRN belongs to content that was created in the space,
+// like custom policies, in contrast to public content that is only used in the space.
+func isSpaceContent(contentMrn string, spaceID string) bool {
+	parsed, err := mrn.Parse(contentMrn)
+	if err != nil {
+		return false
+	}
+	return parsed.SpaceID == spaceID
+}
+
+// rescopeMrn returns the MRN that content of the source space has once it is copied into the target space.
+func rescopeMrn(contentMrn string, sourceSpaceID, targetSpaceID string) string {
+	if !isSpaceContent(contentMrn, sourceSpaceID) {
+		return contentMrn
+	}
+	return strings.Replace(contentMrn, "/spaces/"+sourceSpaceID+"/", "/spaces/"+targetSpaceID+"/", 1)
+}
+
+// copyCustomPolicies copies the custom policies or query packs of the source space and returns the created MRNs,
+// also the ones created before an error. Copying a policy again updates the copy.
+func (r *spaceCloneResource) copyCustomPolicies(ctx context.Context, source, target Space, catalogType string) ([]string, error) {
+	policies, err := r.client.GetPolicies(ctx, source.MRN(), catalogType, false)
+	if err != nil {
+		return nil, err
+	}
+
+	created := []string{}
+	for _, policy := range *policies {
+		if !isSpaceContent(string(policy.Mrn), source.ID()) {
+			continue
+		}
+
+		tflog.Debug(ctx, "Copying custom policy", map[string]interface{}{"policy_mrn": string(policy.Mrn)})
+		content, err := r.client.DownloadBundle(ctx, string(policy.Mrn))
+		if err != nil {
+			return created, fmt.Errorf("unable to download %s: %w", policy.Mrn, err)
+		}
+
+		if catalogType == "QUERYPACK" {
+			payload, err := r.client.SetCustomQueryPack(ctx, target.MRN(), ToPtr(true), []byte(content))
+			if err != nil {
+				return created, fmt.Errorf("unable to copy %s: %w", policy.Mrn, err)
+			}
+			created = append(created, ConvertStrings(payload.QueryPackMrns)...)
+		} else {
+			payload, err := r.client.SetCustomPolicy(ctx, target.MRN(), ToPtr(true), []byte(content))
+			if err != nil {
+				return created, fmt.Errorf("unable to copy %s: %w", policy.Mrn, err)
+			}
+			created = append(created, ConvertStrings(payload.PolicyMrns)...)
+		}
+	}
+	return created, nil
+}
+
+// copyCustomFrameworks copies the custom compliance frameworks of the source space and returns the created MRNs,
+// also the ones created before an error. Copying a framework again updates the copy.
+func (r *spaceCloneResource) copyCustomFrameworks(ctx context.Context, source, target Space) ([]string, error) {
+	frameworks, err := r.client.ListFrameworks(ctx, source.MRN())
+	if err != nil {
+		return nil, err
+	}
+
+	created := []string{}
+	for _, framework := range frameworks {
+		if !isSpaceContent(string(framework.Mrn), source.ID()) {
+			continue
+		}
+
+		tflog.Debug(ctx, "Copying custom framework", map[string]interface{}{"framework_mrn": string(framework.Mrn)})
+		content, err := r.client.DownloadBundle(ctx, string(framework.Mrn))
+		if err != nil {
+			return created, fmt.Errorf("unable to download %s: %w", framework.Mrn, err)
+		}
+		if err := r.client.UploadFramework(ctx, target.MRN(), []byte(content)); err != nil {
+			return created, fmt.Errorf("unable to copy %s: %w", framework.Mrn, err)
+		}
+		created = append(created, rescopeMrn(string(framework.Mrn), source.ID(), target.ID()))
+	}
+	return created, nil
+}
+
+// copyAssignments enables the policies and frameworks of the source space and copies its settings.
+// Custom content is assigned with the MRN it got in the target space.
+func (r *spaceCloneResource) copyAssignments(ctx context.Context, source, target Space, assignments, settings bool) error {
+	sourceBaseline, err := readSpaceBaseline(ctx, r.client, source.MRN())
+	if err != nil {
+		return err
+	}
+	sourceBaseline.removeDisabled()
+
+	baseline := &spaceBaseline{
+		Policies:   map[string]string{},
+		Frameworks: map[string]string{},
+	}
+	if assignments {
+		for policyMrn, state := range sourceBaseline.Policies {
+			baseline.Policies[rescopeMrn(policyMrn, source.ID(), target.ID())] = state
+		}
+		for frameworkMrn, state := range sourceBaseline.Frameworks {
+			baseline.Frameworks[rescopeMrn(frameworkMrn, source.ID(), target.ID())] = state
+		}
+	}
+	if settings {
+		baseline.SpaceSettings = sourceBaseline.SpaceSettings
+	}
+
+	actual, err := readSpaceBaseline(ctx, r.client, target.MRN())
+	if err != nil {
+		return err
+	}
+	drift, diags := diffSpaceBaseline(ctx, baseline, actual)
+	if diags.HasError() {
+		return fmt.Errorf("unable to compare the spaces: %v", diags)
+	}
+	return reconcileSpaceBaseline(ctx, r.client, target.MRN(), baseline, drift)
+}
+
+// workspaceMrnsByName returns the MRNs of the workspaces by their name.
+func workspaceMrnsByName(workspaces []Workspace) map[string]string {
+	mrns := make(map[string]string, len(workspaces))
+	for _, workspace := range workspaces {
+		mrns[workspace.Name] = workspace.Mrn
+	}
+	return mrns
+}
+
+// copyWorkspaces copies the workspaces of the source space and returns the MRNs of the copies, also the
+// ones created before an error. Workspaces that already exist in the target space by name are not copied
+// again, so that a failed copy can be retried.
+func (r *spaceCloneResource) copyWorkspaces(ctx context.Context, source, target Space) ([]string, error) {
+	workspaces, err := r.client.ListWorkspaces(ctx, source.MRN())
+	if err != nil {
+		return nil, err
+	}
+	targetWorkspaces, err := r.client.ListWorkspaces(ctx, target.MRN())
+	if err != nil {
+		return nil, err
+	}
+	existing := workspaceMrnsByName(targetWorkspaces)
+
+	created := []string{}
+	for _, workspace := range workspaces {
+		if existingMrn, ok := existing[workspace.Name]; ok {
+			tflog.Debug(ctx, "Workspace already exists in the space", map[string]interface{}{"workspace_mrn": existingMrn})
+			created = append(created, existingMrn)
+			continue
+		}
+
+		tflog.Debug(ctx, "Copying workspace", map[string]interface{}{"workspace_mrn": workspace.Mrn})
+		model := WorkspaceResourceModel{Selections: renderSelectionsFromGraphql(workspace.Selections)}
+		copied, err := r.client.CreateWorkspace(ctx, CreateWorkspaceInput{
+			OwnerMrn:    mondoov1.String(target.MRN()),
+			Name:        mondoov1.String(workspace.Name),
+			Description: mondoov1.NewStringPtr(mondoov1.String(workspace.Description)),
+			Selections:  renderSelectionsFromModel(&model),
+		})
+		if err != nil {
+			return created, fmt.Errorf("unable to copy workspace %s: %w", workspace.Name, err)
+		}
+		created = append(created, copied.Mrn)
+	}
+	return created, nil
+}
+
+// clone copies the content of the source space into the target space. Custom content is copied
+// first, so that it can be assigned afterwards. When a step fails, the model holds the content
+// that was copied before.
+func (r *spaceCloneResource) clone(ctx context.Context, data *spaceCloneResourceModel, source, target Space) (diags diag.Diagnostics) {
+	copied := map[string][]string{}
+	defer func() {
+		data.PolicyMrns = ConvertListValue(copied["custom policies"])
+		data.QueryPackMrns = ConvertListValue(copied["query packs"])
+		data.FrameworkMrns = ConvertListValue(copied["custom frameworks"])
+		data.WorkspaceMrns = ConvertListValue(copied["workspaces"])
+	}()
+
+	steps := []struct {
+		enabled types.Bool
+		name    string
+		copy    func() ([]string, error)
+	}{
+		{data.CopyCustomPolicies, "custom policies", func() ([]string, error) {
+			return r.copyCustomPolicies(ctx, source, target, "POLICY")
+		}},
+		{data.CopyQueryPacks, "query packs", func() ([]string, error) {
+			return r.copyCustomPolicies(ctx, source, target, "QUERYPACK")
+		}},
+		{data.CopyCustomFrameworks, "custom frameworks", func() ([]string, error) {
+			return r.copyCustomFrameworks(ctx, source, target)
+		}},
+		{data.CopyWorkspaces, "workspaces", func() ([]string, error) {
+			return r.copyWorkspaces(ctx, source, target)
+		}},
+	}
+	for _, step := range steps {
+		copied[step.name] = []string{}
+		if !step.enabled.ValueBool() {
+			continue
+		}
+		tflog.Debug(ctx, "Copying "+step.name)
+		mrns, err := step.copy()
+		copied[step.name] = append(copied[step.name], mrns...)
+		if err != nil {
+			diags.AddError("Client Error",
+				fmt.Sprintf("Unable to copy %s of space %s. Got error: %s", step.name, source.ID(), err),
+			)
+			return
+		}
+	}
+
+	if data.CopyAssignments.ValueBool() || data.CopySpaceSettings.ValueBool() {
+		tflog.Debug(ctx, "Copying assignments and space settings")
+		err := r.copyAssignments(ctx, source, target, data.CopyAssignments.ValueBool(), data.CopySpaceSettings.ValueBool())
+		if err != nil {
+			diags.AddError("Client Error",
+				fmt.Sprintf("Unable to copy assignments and settings of space %s. Got error: %s", source.ID(), err),
+			)
+			return
+		}
+	}
+	return
+}
+
+func (r *spaceCloneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data spaceCloneResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Compute and validate the space
+	target, err := r.client.ComputeSpace(data.SpaceID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+		return
+	}
+	source, err := SpaceFrom(data.SourceSpaceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source_space_id"), "Invalid Configuration", err.Error())
+		return
+	}
+	if source.ID() == target.ID() {
+		resp.Diagnostics.AddError("Invalid Configuration",
+			fmt.Sprintf("Space %s cannot be copied into itself.", source.ID()),
+		)
+		return
+	}
+	ctx = tflog.SetField(ctx, "space_mrn", target.MRN())
+	ctx = tflog.SetField(ctx, "source_space_mrn", source.MRN())
+
+	tflog.Debug(ctx, "Cloning space")
+	resp.Diagnostics.Append(r.clone(ctx, &data, source, target)...)
+
+	data.SpaceID = types.StringValue(target.ID())
+
+	// Save data into Terraform state, also when the clone failed, to keep track of the content
+	// that was copied. Terraform taints the resource and copies again on the next apply.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *spaceCloneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data spaceCloneResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The content is only copied once, changes to the copied content are not tracked.
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *spaceCloneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data spaceCloneResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every attribute requires a replacement, there is nothing to update
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *spaceCloneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The copied content belongs to the space, it is removed together with the space.
+	tflog.Debug(ctx, "Removing space clone from state")
+}
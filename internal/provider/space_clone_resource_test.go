@@ -0,0 +1,126 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccSpaceCloneResource(t *testing.T) {
+	orgID, err := getOrgId()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpaceCloneResourceConfig(orgID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("mondoo_space_clone.test", "space_id", "mondoo_space.target", "id"),
+					resource.TestCheckResourceAttr("mondoo_space_clone.test", "copy_custom_policies", "true"),
+					resource.TestCheckResourceAttr("mondoo_space_clone.test", "copy_workspaces", "true"),
+					resource.TestCheckResourceAttr("mondoo_space_clone.test", "policy_mrns.#", "0"),
+					resource.TestCheckResourceAttr("mondoo_space_clone.test", "workspace_mrns.#", "1"),
+				),
+			},
+			// The content is only copied once
+			{
+				Config:   testAccSpaceCloneResourceConfig(orgID),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccSpaceCloneResourceConfig(orgID string) string {
+	return fmt.Sprintf(`
+resource "mondoo_space" "source" {
+  org_id = %[1]q
+  name   = "clone source"
+}
+
+resource "mondoo_space_baseline" "source" {
+  space_id = mondoo_space.source.id
+
+  policies = [
+    {
+      mrn = "//policy.api.mondoo.app/policies/mondoo-linux-security"
+    },
+  ]
+}
+
+resource "mondoo_workspace" "source" {
+  space_id = mondoo_space.source.id
+  name     = "Linux"
+
+  asset_selections = [
+    {
+      conditions = [
+        {
+          operator = "AND"
+          key_value_condition = {
+            field    = "LABELS"
+            operator = "CONTAINS"
+            values = [
+              {
+                key   = "environment"
+                value = "production"
+              }
+            ]
+          }
+        }
+      ]
+    }
+  ]
+}
+
+resource "mondoo_space" "target" {
+  org_id = %[1]q
+  name   = "clone target"
+}
+
+resource "mondoo_space_clone" "test" {
+  space_id        = mondoo_space.target.id
+  source_space_id = mondoo_space.source.id
+
+  depends_on = [mondoo_space_baseline.source, mondoo_workspace.source]
+}
+`, orgID)
+}
+
+func TestIsSpaceContent(t *testing.T) {
+	assert.True(t, isSpaceContent("//policy.api.mondoo.app/spaces/source-space/policies/custom", "source-space"))
+	assert.False(t, isSpaceContent("//policy.api.mondoo.app/spaces/other-space/policies/custom", "source-space"))
+	assert.False(t, isSpaceContent("//policy.api.mondoo.app/policies/mondoo-linux-security", "source-space"))
+	assert.False(t, isSpaceContent("not an mrn", "source-space"))
+}
+
+func TestRescopeMrn(t *testing.T) {
+	assert.Equal(t,
+		"//policy.api.mondoo.app/spaces/target-space/frameworks/custom",
+		rescopeMrn("//policy.api.mondoo.app/spaces/source-space/frameworks/custom", "source-space", "target-space"),
+	)
+	assert.Equal(t,
+		"//policy.api.mondoo.app/frameworks/cis-controls-8",
+		rescopeMrn("//policy.api.mondoo.app/frameworks/cis-controls-8", "source-space", "target-space"),
+	)
+}
+
+func TestWorkspaceMrnsByName(t *testing.T) {
+	assert.Equal(t, map[string]string{
+		"production": "//captain.api.mondoo.app/spaces/target-space/workspaces/1",
+		"staging":    "//captain.api.mondoo.app/spaces/target-space/workspaces/2",
+	}, workspaceMrnsByName([]Workspace{
+		{Mrn: "//captain.api.mondoo.app/spaces/target-space/workspaces/1", Name: "production"},
+		{Mrn: "//captain.api.mondoo.app/spaces/target-space/workspaces/2", Name: "staging"},
+	}))
+	assert.Empty(t, workspaceMrnsByName(nil))
+}
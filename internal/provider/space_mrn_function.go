@@ -0,0 +1,66 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"go.mondoo.com/terraform-provider-mondoo/internal/mrn"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = (*spaceMrnFunction)(nil)
+
+func NewSpaceMrnFunction() function.Function {
+	return &spaceMrnFunction{}
+}
+
+type spaceMrnFunction struct{}
+
+func (f *spaceMrnFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "space_mrn"
+}
+
+func (f *spaceMrnFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Build a space MRN",
+		MarkdownDescription: "Returns the Mondoo Resource Name (MRN) of the space with the given identifier.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "id",
+				MarkdownDescription: "The space identifier, e.g. `hungry-poet-123456`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *spaceMrnFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &id))
+	if resp.Error != nil {
+		return
+	}
+
+	if funcErr := validateMrnID(0, id); funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, mrn.Space(id)))
+}
+
+// validateMrnID ensures an identifier can be used as a single MRN path segment.
+func validateMrnID(position int64, id string) *function.FuncError {
+	if id == "" {
+		return function.NewArgumentFuncError(position, "identifier must not be empty")
+	}
+	if strings.Contains(id, "/") {
+		return function.NewArgumentFuncError(position, "identifier must not contain '/', pass an ID rather than an MRN")
+	}
+	return nil
+}
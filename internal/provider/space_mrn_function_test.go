@@ -0,0 +1,39 @@
+// Copyright Mondoo, Inc. 2024, 2026
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestSpaceMrnFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::mondoo::space_mrn("hungry-poet-123456")
+}
+`,
+				Check: resource.TestCheckOutput("test", "//captain.api.mondoo.app/spaces/hungry-poet-123456"),
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::mondoo::space_mrn("//captain.api.mondoo.app/spaces/hungry-poet-123456")
+}
+`,
+				ExpectError: regexp.MustCompile(`identifier must not contain '/'`),
+			},
+		},
+	})
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -45,6 +46,10 @@ type SpaceModel struct {
 	SpaceMrn      types.String `tfsdk:"mrn"`
 	SpaceSettings types.Object `tfsdk:"space_settings"`
 	Annotations   types.Map    `tfsdk:"annotations"`
+
+	// destroy safeguards
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+	ForceDestroy       types.Bool `tfsdk:"force_destroy"`
 }
 
 type SpaceSettingsInput struct {
@@ -245,143 +250,160 @@ func (r *SpaceResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Optional:            true,
 				ElementType:         types.StringType,
 			},
-			"space_settings": schema.SingleNestedAttribute{
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "Whether Terraform is prevented from destroying the space. Set it to `false` and apply before destroying the space. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"force_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Whether to destroy the space even if it still has assets or integrations. Defaults to `false`.",
 				Optional:            true,
 				Computed:            true,
-				MarkdownDescription: "Space settings.",
+				Default:             booldefault.StaticBool(false),
+			},
+			"space_settings": spaceSettingsSchemaAttribute(),
+		},
+	}
+}
+
+// spaceSettingsSchemaAttribute returns the schema of the space settings.
+func spaceSettingsSchemaAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Space settings.",
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.UseStateForUnknown(),
+		},
+		Attributes: map[string]schema.Attribute{
+			"terminated_assets_configuration": schema.SingleNestedAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Terminated assets configuration for the space.",
 				PlanModifiers: []planmodifier.Object{
 					objectplanmodifier.UseStateForUnknown(),
 				},
 				Attributes: map[string]schema.Attribute{
-					"terminated_assets_configuration": schema.SingleNestedAttribute{
+					"cleanup": schema.BoolAttribute{
 						Optional:            true,
 						Computed:            true,
-						MarkdownDescription: "Terminated assets configuration for the space.",
-						PlanModifiers: []planmodifier.Object{
-							objectplanmodifier.UseStateForUnknown(),
-						},
-						Attributes: map[string]schema.Attribute{
-							"cleanup": schema.BoolAttribute{
-								Optional:            true,
-								Computed:            true,
-								MarkdownDescription: "Whether to cleanup terminated assets.",
-							},
-						},
+						MarkdownDescription: "Whether to cleanup terminated assets.",
 					},
-					"unused_service_accounts_configuration": schema.SingleNestedAttribute{
+				},
+			},
+			"unused_service_accounts_configuration": schema.SingleNestedAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Unused service accounts configuration for the space.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"cleanup": schema.BoolAttribute{
 						Optional:            true,
 						Computed:            true,
-						MarkdownDescription: "Unused service accounts configuration for the space.",
-						PlanModifiers: []planmodifier.Object{
-							objectplanmodifier.UseStateForUnknown(),
-						},
-						Attributes: map[string]schema.Attribute{
-							"cleanup": schema.BoolAttribute{
-								Optional:            true,
-								Computed:            true,
-								MarkdownDescription: "Whether to cleanup unused service accounts.",
-							},
-						},
+						MarkdownDescription: "Whether to cleanup unused service accounts.",
 					},
-					"garbage_collect_assets_configuration": schema.SingleNestedAttribute{
+				},
+			},
+			"garbage_collect_assets_configuration": schema.SingleNestedAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Garbage collect assets configuration for the space.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
 						Optional:            true,
 						Computed:            true,
-						MarkdownDescription: "Garbage collect assets configuration for the space.",
-						PlanModifiers: []planmodifier.Object{
-							objectplanmodifier.UseStateForUnknown(),
-						},
-						Attributes: map[string]schema.Attribute{
-							"enabled": schema.BoolAttribute{
-								Optional:            true,
-								Computed:            true,
-								MarkdownDescription: "Whether to enable garbage collection.",
-							},
-							"after_days": schema.Int32Attribute{
-								Optional:            true,
-								Computed:            true,
-								MarkdownDescription: "After how many days to garbage collect. ",
-							},
-						},
+						MarkdownDescription: "Whether to enable garbage collection.",
 					},
-					"platform_vulnerability_configuration": schema.SingleNestedAttribute{
+					"after_days": schema.Int32Attribute{
 						Optional:            true,
 						Computed:            true,
-						MarkdownDescription: "Platform vulnerability configuration for the space.",
-						PlanModifiers: []planmodifier.Object{
-							objectplanmodifier.UseStateForUnknown(),
-						},
-						Attributes: map[string]schema.Attribute{
-							"enabled": schema.BoolAttribute{
-								Optional:            true,
-								Computed:            true,
-								MarkdownDescription: "Whether to enable platform vulnerability analysis.",
-							},
-						},
+						MarkdownDescription: "After how many days to garbage collect. ",
 					},
-					"eol_assets_configuration": schema.SingleNestedAttribute{
+				},
+			},
+			"platform_vulnerability_configuration": schema.SingleNestedAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Platform vulnerability configuration for the space.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
 						Optional:            true,
 						Computed:            true,
-						MarkdownDescription: "EOL platform configuration for the space.",
-						PlanModifiers: []planmodifier.Object{
-							objectplanmodifier.UseStateForUnknown(),
-						},
-						Attributes: map[string]schema.Attribute{
-							"enabled": schema.BoolAttribute{
-								Optional:            true,
-								Computed:            true,
-								MarkdownDescription: "Whether to enable EOL assets analysis.",
-							},
-							"months_in_advance": schema.Int32Attribute{
-								Optional:            true,
-								Computed:            true,
-								MarkdownDescription: "How many months in advance should EOL be applied as risk factor.",
-							},
-						},
+						MarkdownDescription: "Whether to enable platform vulnerability analysis.",
 					},
-					"cases_configuration": schema.SingleNestedAttribute{
+				},
+			},
+			"eol_assets_configuration": schema.SingleNestedAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "EOL platform configuration for the space.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
 						Optional:            true,
 						Computed:            true,
-						MarkdownDescription: "Cases configuration for the space.",
-						PlanModifiers: []planmodifier.Object{
-							objectplanmodifier.UseStateForUnknown(),
-						},
-						Attributes: map[string]schema.Attribute{
-							"auto_create": schema.BoolAttribute{
-								Optional:            true,
-								Computed:            true,
-								MarkdownDescription: "Whether to enable auto-create cases on drift.",
-							},
-							"aggregation_window": schema.Int32Attribute{
-								Optional:            true,
-								Computed:            true,
-								MarkdownDescription: "Aggregate findings for the same asset within this window. The value is specified in hours. 0 means no aggregation.",
-							},
-						},
+						MarkdownDescription: "Whether to enable EOL assets analysis.",
 					},
-					"exceptions_configuration": schema.SingleNestedAttribute{
+					"months_in_advance": schema.Int32Attribute{
 						Optional:            true,
 						Computed:            true,
-						MarkdownDescription: "Exceptions configuration for the space.",
-						PlanModifiers: []planmodifier.Object{
-							objectplanmodifier.UseStateForUnknown(),
-						},
-						Attributes: map[string]schema.Attribute{
-							"require_approval": schema.BoolAttribute{
-								Optional:            true,
-								Computed:            true,
-								MarkdownDescription: "Whether to require approval for exceptions.",
-							},
-							"allow_indefinite_valid_until": schema.BoolAttribute{
-								Optional:            true,
-								Computed:            true,
-								MarkdownDescription: "Whether to allow creation of exception groups with indefinite valid until.",
-							},
-							"allow_self_approval": schema.BoolAttribute{
-								Optional:            true,
-								Computed:            true,
-								MarkdownDescription: "Whether a user can approve their own exception requests.",
-							},
-						},
+						MarkdownDescription: "How many months in advance should EOL be applied as risk factor.",
+					},
+				},
+			},
+			"cases_configuration": schema.SingleNestedAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Cases configuration for the space.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"auto_create": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "Whether to enable auto-create cases on drift.",
+					},
+					"aggregation_window": schema.Int32Attribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "Aggregate findings for the same asset within this window. The value is specified in hours. 0 means no aggregation.",
+					},
+				},
+			},
+			"exceptions_configuration": schema.SingleNestedAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Exceptions configuration for the space.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"require_approval": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "Whether to require approval for exceptions.",
+					},
+					"allow_indefinite_valid_until": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "Whether to allow creation of exception groups with indefinite valid until.",
+					},
+					"allow_self_approval": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "Whether a user can approve their own exception requests.",
 					},
 				},
 			},
@@ -512,6 +534,9 @@ func (r *SpaceResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		OrgID:         types.StringValue(spacePayload.Organization.Id),
 		SpaceSettings: spaceSettings,
 		Annotations:   flattenAnnotations(spacePayload.Annotations),
+		// the safeguards only exist in Terraform, keep them from the prior state
+		DeletionProtection: types.BoolValue(data.DeletionProtection.ValueBool()),
+		ForceDestroy:       types.BoolValue(data.ForceDestroy.ValueBool()),
 	}
 
 	if spacePayload.Description != "" {
@@ -589,6 +614,30 @@ func (r *SpaceResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	if data.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError("Deletion Protection Enabled",
+			fmt.Sprintf("Space %s has deletion_protection enabled. Set deletion_protection = false and apply before destroying it.", data.SpaceID.ValueString()),
+		)
+		return
+	}
+
+	if !data.ForceDestroy.ValueBool() {
+		contents, err := r.client.GetSpaceContents(ctx, data.SpaceMrn.ValueString())
+		if err != nil {
+			resp.Diagnostics.
+				AddError("Client Error",
+					fmt.Sprintf("Unable to check the contents of space. Got error: %s", err),
+				)
+			return
+		}
+		if !contents.IsEmpty() {
+			resp.Diagnostics.AddError("Space Is Not Empty",
+				fmt.Sprintf("Space %s still has %s. Remove them first or set force_destroy = true and apply to delete the space with everything in it.", data.SpaceID.ValueString(), contents),
+			)
+			return
+		}
+	}
+
 	// Do GraphQL request to API to delete the resource.
 	err := r.client.DeleteSpace(ctx, data.SpaceID.ValueString())
 	if err != nil {
@@ -618,12 +667,14 @@ func (r *SpaceResource) ImportState(ctx context.Context, req resource.ImportStat
 	}
 
 	model := SpaceModel{
-		SpaceID:       types.StringValue(spacePayload.Id),
-		SpaceMrn:      types.StringValue(spacePayload.Mrn),
-		Name:          types.StringValue(spacePayload.Name),
-		OrgID:         types.StringValue(spacePayload.Organization.Id),
-		SpaceSettings: spaceSettings,
-		Annotations:   flattenAnnotations(spacePayload.Annotations),
+		SpaceID:            types.StringValue(spacePayload.Id),
+		SpaceMrn:           types.StringValue(spacePayload.Mrn),
+		Name:               types.StringValue(spacePayload.Name),
+		OrgID:              types.StringValue(spacePayload.Organization.Id),
+		SpaceSettings:      spaceSettings,
+		Annotations:        flattenAnnotations(spacePayload.Annotations),
+		DeletionProtection: types.BoolValue(false),
+		ForceDestroy:       types.BoolValue(false),
 	}
 
 	if spacePayload.Description != "" {
@@ -6,9 +6,11 @@ package provider
 import (
 	"fmt"
 	"math/rand"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccSpaceResource(t *testing.T) {
@@ -256,3 +258,62 @@ resource "mondoo_space" "test" {
 		requireApproval, allowIndefinite, allowSelfApproval,
 	)
 }
+
+func TestAccSpaceResourceDeletionProtection(t *testing.T) {
+	orgID, err := getOrgId()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create a protected space
+			{
+				Config: testAccSpaceResourceConfigWithDeletionProtection(orgID, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mondoo_space.test", "deletion_protection", "true"),
+					resource.TestCheckResourceAttr("mondoo_space.test", "force_destroy", "false"),
+				),
+			},
+			// Destroy is refused while the space is protected
+			{
+				Config:      testAccSpaceResourceConfigWithDeletionProtection(orgID, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("Deletion Protection Enabled"),
+			},
+			// ImportState testing, the safeguards are not stored in Mondoo
+			{
+				ResourceName:            "mondoo_space.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
+			},
+			// Remove the protection, delete testing automatically occurs in TestCase
+			{
+				Config: testAccSpaceResourceConfigWithDeletionProtection(orgID, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mondoo_space.test", "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSpaceResourceConfigWithDeletionProtection(resourceOrgID string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+resource "mondoo_space" "test" {
+  org_id              = %[1]q
+  name                = "protected"
+  deletion_protection = %[2]t
+}
+`, resourceOrgID, deletionProtection)
+}
+
+func TestSpaceContents(t *testing.T) {
+	assert.True(t, spaceContents{}.IsEmpty())
+	assert.False(t, spaceContents{Assets: 3}.IsEmpty())
+	assert.False(t, spaceContents{Integrations: 1}.IsEmpty())
+	assert.Equal(t, "3 asset(s) and 1 integration(s)", spaceContents{Assets: 3, Integrations: 1}.String())
+}
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSpaceFrom(t *testing.T) {
@@ -35,11 +36,6 @@ func TestSpaceFrom(t *testing.T) {
 			input:    "",
 			expected: Space(""),
 		},
-		{
-			name:     "Invalid MRN without space ID segment",
-			input:    "//captain.api.mondoo.app/spaces/",
-			expected: Space(""),
-		},
 		{
 			name:     "Non-MRN format",
 			input:    "not-an-mrn",
@@ -49,12 +45,27 @@ func TestSpaceFrom(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := SpaceFrom(tt.input)
+			result, err := SpaceFrom(tt.input)
+			require.NoError(t, err)
 			assert.Equal(t, tt.expected, result, "Expected SpaceFrom result to match")
 		})
 	}
 }
 
+func TestSpaceFrom_Invalid(t *testing.T) {
+	for _, input := range []string{
+		"//captain.api.mondoo.app/spaces/",
+		"//captain.api.mondoo.app/spaces/1234/foo",
+		"//captain.api.mondoo.app/organizations/my-org",
+		"//policy.api.mondoo.app/spaces/1234/policies/my-policy",
+	} {
+		t.Run(input, func(t *testing.T) {
+			_, err := SpaceFrom(input)
+			assert.Error(t, err)
+		})
+	}
+}
+
 func TestSpace_ID(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -6,18 +6,36 @@ package provider
 import (
 	"context"
 	"fmt"
-
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	mondoov1 "go.mondoo.com/mondoo-go"
 )
 
-var _ resource.Resource = (*WorkspaceResource)(nil)
+var (
+	_ resource.Resource                   = (*WorkspaceResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*WorkspaceResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*WorkspaceResource)(nil)
+)
+
+// workspacePreviewAssetLimit is the maximum number of assets listed when previewing asset selections.
+const workspacePreviewAssetLimit = 25
 
 func NewWorkspaceResource() resource.Resource {
 	return &WorkspaceResource{}
@@ -43,6 +61,10 @@ type WorkspaceResourceModel struct {
 	Description types.String `tfsdk:"description"`
 	// A list of workspace selections. (Required.)
 	Selections []WorkspaceSelectionModel `tfsdk:"asset_selections"`
+	// Whether plans list the assets matched by the selections. (Optional.)
+	PreviewAssets types.Bool `tfsdk:"preview_assets"`
+	// Number of assets currently matched by the selections. (Computed.)
+	AssetCount types.Int64 `tfsdk:"asset_count"`
 }
 
 type WorkspaceSelectionModel struct {
@@ -54,6 +76,14 @@ type WorkspaceConditionModel struct {
 	// Operator determining how the condition is joined with the other conditions in the list. (Required.)
 	Operator types.String `tfsdk:"operator"`
 
+	WorkspaceLeafConditionModel
+
+	// Group of conditions. (Optional.)
+	Group *WorkspaceConditionGroupModel `tfsdk:"group"`
+}
+
+// WorkspaceLeafConditionModel holds the conditions that match a single asset attribute.
+type WorkspaceLeafConditionModel struct {
 	// String condition. (Optional.)
 	StringCondition *WorkspaceGenericCondition `tfsdk:"string_condition"`
 	// Int condition. (Optional.)
@@ -62,6 +92,50 @@ type WorkspaceConditionModel struct {
 	RatingCondition *WorkspaceGenericCondition `tfsdk:"rating_condition"`
 	// Key-value condition. (Optional.)
 	KeyValueCondition *WorkspaceKeyValueCondition `tfsdk:"key_value_condition"`
+	// Date condition. (Optional.)
+	DateCondition *WorkspaceDateCondition `tfsdk:"date_condition"`
+}
+
+// count returns the number of conditions that are set.
+func (m WorkspaceLeafConditionModel) count() int {
+	count := 0
+	if m.StringCondition != nil {
+		count++
+	}
+	if m.IntCondition != nil {
+		count++
+	}
+	if m.RatingCondition != nil {
+		count++
+	}
+	if m.KeyValueCondition != nil {
+		count++
+	}
+	if m.DateCondition != nil {
+		count++
+	}
+	return count
+}
+
+type WorkspaceConditionGroupModel struct {
+	// Operator determining how the conditions in the group are joined. (Required.)
+	Operator types.String `tfsdk:"operator"`
+	// Conditions of the group. (Required.)
+	Conditions []WorkspaceGroupConditionModel `tfsdk:"conditions"`
+}
+
+type WorkspaceGroupConditionModel struct {
+	WorkspaceLeafConditionModel
+
+	// Nested group of conditions. (Optional.)
+	Group *WorkspaceNestedConditionGroupModel `tfsdk:"group"`
+}
+
+type WorkspaceNestedConditionGroupModel struct {
+	// Operator determining how the conditions in the group are joined. (Required.)
+	Operator types.String `tfsdk:"operator"`
+	// Conditions of the group. (Required.)
+	Conditions []WorkspaceLeafConditionModel `tfsdk:"conditions"`
 }
 
 type WorkspaceGenericCondition struct {
@@ -86,11 +160,38 @@ type WorkspaceKeyValue struct {
 	Value types.String `tfsdk:"value"`
 }
 
+type WorkspaceDateCondition struct {
+	// Field to match. (Required.)
+	Field types.String `tfsdk:"field"`
+	// Operator to use. (Required.)
+	Operator types.String `tfsdk:"operator"`
+	// Date (YYYY-MM-DD) to compare with. (Optional.)
+	Value types.String `tfsdk:"value"`
+	// Number of days before the evaluation time to compare with. (Optional.)
+	RelativeDays types.Int32 `tfsdk:"relative_days"`
+}
+
 func (r *WorkspaceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_workspace"
 }
 
 func (r *WorkspaceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	conditionAttributes := workspaceLeafConditionAttributes()
+	conditionAttributes["operator"] = schema.StringAttribute{
+		Required: true,
+		MarkdownDescription: "Operator determining how the condition is joined with the other " +
+			"conditions in the list. Valid values: `AND`, `AND_NOT`",
+	}
+	groupConditionAttributes := workspaceLeafConditionAttributes()
+	groupConditionAttributes["group"] = workspaceConditionGroupAttribute(
+		"A nested group of conditions. Nested groups can only contain conditions, not further groups.",
+		workspaceLeafConditionAttributes(),
+	)
+	conditionAttributes["group"] = workspaceConditionGroupAttribute(
+		"A group of conditions that is evaluated as a single condition.",
+		groupConditionAttributes,
+	)
+
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: `Allows management of Mondoo workspaces.`,
@@ -125,7 +226,7 @@ func (r *WorkspaceResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 			"asset_selections": schema.ListNestedAttribute{
-				MarkdownDescription: "A list of workspace selections.",
+				MarkdownDescription: "A list of workspace selections. Selections are ORed together.",
 				Required:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -133,123 +234,206 @@ func (r *WorkspaceResource) Schema(_ context.Context, _ resource.SchemaRequest,
 							Required:            true,
 							MarkdownDescription: "A list of conditions for the selection.",
 							NestedObject: schema.NestedAttributeObject{
-								Attributes: map[string]schema.Attribute{
-									"operator": schema.StringAttribute{
-										Required: true,
-										MarkdownDescription: "Operator determining how the condition is joined with the other " +
-											"conditions in the list. Valid values: `AND`, `AND_NOT`",
-									},
-									"string_condition": schema.SingleNestedAttribute{
-										MarkdownDescription: "A condition with values of type string.",
-										Optional:            true,
-										Attributes: map[string]schema.Attribute{
-											"field": schema.StringAttribute{
-												MarkdownDescription: fmt.Sprintf(
-													"String field to match. Valid values: %q", displayPossibleStringFields(),
-												),
-												Required: true,
-											},
-											"operator": schema.StringAttribute{
-												MarkdownDescription: fmt.Sprintf(
-													"String operator. Valid values: %q", displayPossibleStringOperators(),
-												),
-												Required: true,
-											},
-											"values": schema.ListAttribute{
-												MarkdownDescription: "String values to match. Values are ORed together.",
-												ElementType:         types.StringType,
-												Required:            true,
-											},
-										},
-									},
-									"int_condition": schema.SingleNestedAttribute{
-										MarkdownDescription: "A condition with values of type int.",
-										Optional:            true,
-										Attributes: map[string]schema.Attribute{
-											"field": schema.StringAttribute{
-												MarkdownDescription: fmt.Sprintf(
-													"Numeric field to match. Valid values: %q", displayPossibleIntFields(),
-												),
-												Required: true,
-											},
-											"operator": schema.StringAttribute{
-												MarkdownDescription: fmt.Sprintf(
-													"Numeric operator. Valid values: %q", displayPossibleNumericOperators(),
-												),
-												Required: true,
-											},
-											"values": schema.ListAttribute{
-												MarkdownDescription: "Int values to match. Values are ORed together.",
-												ElementType:         types.Int32Type,
-												Required:            true,
-											},
-										},
-									},
-									"rating_condition": schema.SingleNestedAttribute{
-										MarkdownDescription: "A condition with values of type int.",
-										Optional:            true,
-										Attributes: map[string]schema.Attribute{
-											"field": schema.StringAttribute{
-												MarkdownDescription: fmt.Sprintf(
-													"Rating field to match. Valid values: %q", displayPossibleRatingFields(),
-												),
-												Required: true,
-											},
-											"operator": schema.StringAttribute{
-												MarkdownDescription: fmt.Sprintf(
-													"Rating operator. Valid values: %q", displayPossibleRatingOperators(),
-												),
-												Required: true,
-											},
-											"values": schema.ListAttribute{
-												MarkdownDescription: "Int values to match. Values are ORed together.",
-												ElementType:         types.StringType,
-												Required:            true,
-											},
-										},
-									},
-									"key_value_condition": schema.SingleNestedAttribute{
-										MarkdownDescription: "A condition with values of type key:value.",
-										Optional:            true,
-										Attributes: map[string]schema.Attribute{
-											"field": schema.StringAttribute{
-												MarkdownDescription: fmt.Sprintf(
-													"key:value field to match. Valid values: %q",
-													displayPossibleKeyValueFields(),
-												),
-												Required: true,
-											},
-											"operator": schema.StringAttribute{
-												MarkdownDescription: fmt.Sprintf(
-													"Rating operator. Valid values: %q", displayPossibleKeyValueOperators(),
-												),
-												Required: true,
-											},
-											"values": schema.ListNestedAttribute{
-												MarkdownDescription: "key:value list to match. Values are ORed together.",
-												Required:            true,
-												NestedObject: schema.NestedAttributeObject{
-													Attributes: map[string]schema.Attribute{
-														"key": schema.StringAttribute{
-															MarkdownDescription: "The key.",
-															Required:            true,
-														},
-														"value": schema.StringAttribute{
-															MarkdownDescription: "The value.",
-															Required:            true,
-														},
-													},
-												},
-											},
-										},
-									},
-								},
+								Attributes: conditionAttributes,
+							},
+						},
+					},
+				},
+			},
+			"preview_assets": schema.BoolAttribute{
+				MarkdownDescription: "Whether plans that create the workspace or change its asset selections " +
+					fmt.Sprintf("list the assets matched by the selections (up to %d). Defaults to `false`.", workspacePreviewAssetLimit),
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"asset_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of assets matched by the asset selections. Null if the assets can't be counted.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// workspaceLeafConditionAttributes returns the attributes of the conditions that match a single asset attribute.
+func workspaceLeafConditionAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"string_condition": schema.SingleNestedAttribute{
+			MarkdownDescription: "A condition with values of type string.",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"field": schema.StringAttribute{
+					MarkdownDescription: fmt.Sprintf(
+						"String field to match. Valid values: %q", displayPossibleStringFields(),
+					),
+					Required: true,
+				},
+				"operator": schema.StringAttribute{
+					MarkdownDescription: fmt.Sprintf(
+						"String operator. Valid values: %q", displayPossibleStringOperators(),
+					),
+					Required: true,
+				},
+				"values": schema.ListAttribute{
+					MarkdownDescription: "String values to match. Values are ORed together.",
+					ElementType:         types.StringType,
+					Required:            true,
+				},
+			},
+		},
+		"int_condition": schema.SingleNestedAttribute{
+			MarkdownDescription: "A condition with values of type int.",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"field": schema.StringAttribute{
+					MarkdownDescription: fmt.Sprintf(
+						"Numeric field to match. Valid values: %q", displayPossibleIntFields(),
+					),
+					Required: true,
+				},
+				"operator": schema.StringAttribute{
+					MarkdownDescription: fmt.Sprintf(
+						"Numeric operator. Valid values: %q", displayPossibleNumericOperators(),
+					),
+					Required: true,
+				},
+				"values": schema.ListAttribute{
+					MarkdownDescription: "Int values to match. Values are ORed together.",
+					ElementType:         types.Int32Type,
+					Required:            true,
+				},
+			},
+		},
+		"rating_condition": schema.SingleNestedAttribute{
+			MarkdownDescription: "A condition with values of type int.",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"field": schema.StringAttribute{
+					MarkdownDescription: fmt.Sprintf(
+						"Rating field to match. Valid values: %q", displayPossibleRatingFields(),
+					),
+					Required: true,
+				},
+				"operator": schema.StringAttribute{
+					MarkdownDescription: fmt.Sprintf(
+						"Rating operator. Valid values: %q", displayPossibleRatingOperators(),
+					),
+					Required: true,
+				},
+				"values": schema.ListAttribute{
+					MarkdownDescription: "Int values to match. Values are ORed together.",
+					ElementType:         types.StringType,
+					Required:            true,
+				},
+			},
+		},
+		"key_value_condition": schema.SingleNestedAttribute{
+			MarkdownDescription: "A condition with values of type key:value.",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"field": schema.StringAttribute{
+					MarkdownDescription: fmt.Sprintf(
+						"key:value field to match. Valid values: %q",
+						displayPossibleKeyValueFields(),
+					),
+					Required: true,
+				},
+				"operator": schema.StringAttribute{
+					MarkdownDescription: fmt.Sprintf(
+						"Rating operator. Valid values: %q", displayPossibleKeyValueOperators(),
+					),
+					Required: true,
+				},
+				"values": schema.ListNestedAttribute{
+					MarkdownDescription: "key:value list to match. Values are ORed together.",
+					Required:            true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"key": schema.StringAttribute{
+								MarkdownDescription: "The key.",
+								Required:            true,
+							},
+							"value": schema.StringAttribute{
+								MarkdownDescription: "The value.",
+								Required:            true,
 							},
 						},
 					},
 				},
 			},
 		},
+		"date_condition": schema.SingleNestedAttribute{
+			MarkdownDescription: "A condition on a point in time, such as when an asset was last scanned.",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"field": schema.StringAttribute{
+					MarkdownDescription: fmt.Sprintf(
+						"Date field to match. Valid values: %q", displayPossibleDateFields(),
+					),
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(ConvertStrings(displayPossibleDateFields())...),
+					},
+				},
+				"operator": schema.StringAttribute{
+					MarkdownDescription: fmt.Sprintf(
+						"Date operator. Valid values: %q", displayPossibleDateOperators(),
+					),
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(ConvertStrings(displayPossibleDateOperators())...),
+					},
+				},
+				"value": schema.StringAttribute{
+					MarkdownDescription: "The date (`YYYY-MM-DD`) to compare with. Conflicts with `relative_days`.",
+					Optional:            true,
+					Validators: []validator.String{
+						stringvalidator.RegexMatches(regexp.MustCompile(`^[1-9][0-9]{3}-(0[1-9]|1[0-2])-(0[1-9]|[1-2][0-9]|3[0-1])$`), "Date must be in the format 'YYYY-MM-DD'"),
+						stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("relative_days")),
+					},
+				},
+				"relative_days": schema.Int32Attribute{
+					MarkdownDescription: "The number of days before the time the workspace is evaluated to compare with. " +
+						"For example, `BEFORE` with `30` matches assets last scanned more than 30 days ago. Conflicts with `value`.",
+					Optional: true,
+					Validators: []validator.Int32{
+						int32validator.AtLeast(0),
+					},
+				},
+			},
+		},
+	}
+}
+
+// workspaceConditionGroupAttribute returns a group of conditions with the given member attributes.
+func workspaceConditionGroupAttribute(description string, memberAttributes map[string]schema.Attribute) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"operator": schema.StringAttribute{
+				MarkdownDescription: "Operator determining how the conditions of the group are joined. `AND` matches assets that match " +
+					"all conditions, `OR` matches assets that match any condition and `NOT` matches assets that match none of the conditions. " +
+					fmt.Sprintf("Valid values: %q", displayPossibleGroupOperators()),
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(ConvertStrings(displayPossibleGroupOperators())...),
+				},
+			},
+			"conditions": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of conditions for the group. Each condition sets exactly one condition type.",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: memberAttributes,
+				},
+			},
+		},
 	}
 }
 
@@ -273,44 +457,162 @@ func (r *WorkspaceResource) Configure(_ context.Context, req resource.ConfigureR
 	r.client = client
 }
 
+func (r *WorkspaceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data WorkspaceResourceModel
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		// conditions that are not yet known are validated once they are
+		return
+	}
+
+	resp.Diagnostics.Append(validateWorkspaceResourceModel(&data)...)
+}
+
+func validateWorkspaceResourceModel(data *WorkspaceResourceModel) (diagnostics diag.Diagnostics) {
+	validate := func(location string, count int) {
+		switch {
+		case count == 0:
+			diagnostics.AddError(
+				"MissingAttributeError",
+				fmt.Sprintf("%s must set one of string_condition, int_condition, rating_condition, key_value_condition, date_condition or group.", location),
+			)
+		case count > 1:
+			diagnostics.AddError(
+				"ConflictingAttributesError",
+				fmt.Sprintf("%s must set only one of string_condition, int_condition, rating_condition, key_value_condition, date_condition or group.", location),
+			)
+		}
+	}
+
+	for i, selection := range data.Selections {
+		for j, condition := range selection.Conditions {
+			location := fmt.Sprintf("asset_selections[%d].conditions[%d]", i, j)
+			count := condition.count()
+			if condition.Group != nil {
+				count++
+				for k, member := range condition.Group.Conditions {
+					memberLocation := fmt.Sprintf("%s.group.conditions[%d]", location, k)
+					memberCount := member.count()
+					if member.Group != nil {
+						memberCount++
+						for l, nestedMember := range member.Group.Conditions {
+							validate(fmt.Sprintf("%s.group.conditions[%d]", memberLocation, l), nestedMember.count())
+						}
+					}
+					validate(memberLocation, memberCount)
+				}
+			}
+			validate(location, count)
+		}
+	}
+	return
+}
+
+func (r *WorkspaceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to plan when the workspace is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planned, prior types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("asset_selections"), &planned)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("asset_selections"), &prior)...)
+	}
+	if resp.Diagnostics.HasError() || planned.Equal(prior) {
+		return
+	}
+
+	// the asset count is only kept from the state while the selections don't change
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("asset_count"), types.Int64Unknown())...)
+	}
+
+	// nothing to preview when the provider is not configured yet
+	if r.client == nil {
+		return
+	}
+
+	var previewAssets types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("preview_assets"), &previewAssets)...)
+	if resp.Diagnostics.HasError() || !previewAssets.ValueBool() {
+		return
+	}
+
+	// only preview selections that are fully known
+	if value, err := planned.ToTerraformValue(ctx); err != nil || !value.IsFullyKnown() {
+		return
+	}
+
+	var data WorkspaceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	space, err := r.client.ComputeSpace(data.SpaceID)
+	if err != nil {
+		// the space is validated when the workspace is created
+		return
+	}
+
+	preview, err := r.client.PreviewWorkspaceSelections(ctx, space.MRN(), renderSelectionsFromModel(&data), workspacePreviewAssetLimit)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Workspace Asset Preview",
+			fmt.Sprintf("Unable to preview the assets of workspace %s. Got error: %s", data.Name.ValueString(), err),
+		)
+		return
+	}
+
+	resp.Diagnostics.AddWarning("Workspace Asset Preview", renderWorkspacePreview(data.Name.ValueString(), preview))
+}
+
+// renderWorkspacePreview describes the assets matched by the asset selections of a workspace.
+func renderWorkspacePreview(name string, preview WorkspaceSelectionPreviewPayload) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "The asset selections of workspace %s match %d assets.", name, preview.TotalCount)
+	if len(preview.Assets) > 0 {
+		sb.WriteString("\n")
+	}
+	for _, asset := range preview.Assets {
+		fmt.Fprintf(&sb, "\n  - %s (%s)", asset.Name, asset.Mrn)
+	}
+	if more := preview.TotalCount - len(preview.Assets); more > 0 && len(preview.Assets) > 0 {
+		fmt.Fprintf(&sb, "\n  ... and %d more", more)
+	}
+	return sb.String()
+}
+
 func renderSelectionsFromGraphql(response WorkspaceSelections) []WorkspaceSelectionModel {
 	selectionModel := make([]WorkspaceSelectionModel, len(response.Selections))
 	for i, selection := range response.Selections {
 		conditionsModel := make([]WorkspaceConditionModel, len(selection.Conditions))
 		for j, condition := range selection.Conditions {
 			newCondition := WorkspaceConditionModel{
-				Operator: types.StringValue(string(condition.Operator)),
+				Operator:                    types.StringValue(string(condition.Operator)),
+				WorkspaceLeafConditionModel: renderLeafConditionFromGraphql(condition.Condition.WorkspaceLeafCondition),
 			}
-			switch condition.Condition.Typename {
-			case "WorkspaceSelectionKeyValueCondition":
-				newCondition.KeyValueCondition = &WorkspaceKeyValueCondition{
-					Field:    types.StringValue(string(condition.Condition.KeyValueCondition.Field)),
-					Operator: types.StringValue(string(condition.Condition.KeyValueCondition.Operator)),
+			if condition.Condition.Typename == "WorkspaceSelectionGroupCondition" {
+				group := condition.Condition.GroupCondition
+				newCondition.Group = &WorkspaceConditionGroupModel{
+					Operator:   types.StringValue(group.Operator),
+					Conditions: make([]WorkspaceGroupConditionModel, len(group.Conditions)),
 				}
-				for _, kv := range condition.Condition.KeyValueCondition.Values {
-					newCondition.KeyValueCondition.Values = append(newCondition.KeyValueCondition.Values,
-						WorkspaceKeyValue{
-							Key:   types.StringValue(kv.Key),
-							Value: types.StringValue(kv.Value),
-						})
-				}
-			case "WorkspaceSelectionStringCondition":
-				newCondition.StringCondition = &WorkspaceGenericCondition{
-					Field:    types.StringValue(string(condition.Condition.StringCondition.Field)),
-					Operator: types.StringValue(string(condition.Condition.StringCondition.Operator)),
-					Values:   ConvertListValue(condition.Condition.StringCondition.ValuesStringSlice()),
-				}
-			case "WorkspaceSelectionIntCondition":
-				newCondition.IntCondition = &WorkspaceGenericCondition{
-					Field:    types.StringValue(string(condition.Condition.IntCondition.Field)),
-					Operator: types.StringValue(string(condition.Condition.IntCondition.Operator)),
-					Values:   ConvertListValueInt32(condition.Condition.IntCondition.Values),
-				}
-			case "WorkspaceSelectionRatingCondition":
-				newCondition.RatingCondition = &WorkspaceGenericCondition{
-					Field:    types.StringValue(string(condition.Condition.RatingCondition.Field)),
-					Operator: types.StringValue(string(condition.Condition.RatingCondition.Operator)),
-					Values:   ConvertListValue(condition.Condition.RatingCondition.Values),
+				for k, member := range group.Conditions {
+					newMember := WorkspaceGroupConditionModel{
+						WorkspaceLeafConditionModel: renderLeafConditionFromGraphql(member.Condition.WorkspaceLeafCondition),
+					}
+					if member.Condition.Typename == "WorkspaceSelectionGroupCondition" {
+						nested := member.Condition.GroupCondition
+						newMember.Group = &WorkspaceNestedConditionGroupModel{
+							Operator:   types.StringValue(nested.Operator),
+							Conditions: make([]WorkspaceLeafConditionModel, len(nested.Conditions)),
+						}
+						for l, nestedMember := range nested.Conditions {
+							newMember.Group.Conditions[l] = renderLeafConditionFromGraphql(nestedMember.Condition)
+						}
+					}
+					newCondition.Group.Conditions[k] = newMember
 				}
 			}
 
@@ -321,53 +623,86 @@ func renderSelectionsFromGraphql(response WorkspaceSelections) []WorkspaceSelect
 	return selectionModel
 }
 
-func renderSelectionsFromModel(data *WorkspaceResourceModel) mondoov1.WorkspaceSelectionsInput {
-	selectionInput := mondoov1.WorkspaceSelectionsInput{
-		Selections: []mondoov1.WorkspaceSelectionInput{},
+func renderLeafConditionFromGraphql(condition WorkspaceLeafCondition) WorkspaceLeafConditionModel {
+	newCondition := WorkspaceLeafConditionModel{}
+	switch condition.Typename {
+	case "WorkspaceSelectionKeyValueCondition":
+		newCondition.KeyValueCondition = &WorkspaceKeyValueCondition{
+			Field:    types.StringValue(string(condition.KeyValueCondition.Field)),
+			Operator: types.StringValue(string(condition.KeyValueCondition.Operator)),
+		}
+		for _, kv := range condition.KeyValueCondition.Values {
+			newCondition.KeyValueCondition.Values = append(newCondition.KeyValueCondition.Values,
+				WorkspaceKeyValue{
+					Key:   types.StringValue(kv.Key),
+					Value: types.StringValue(kv.Value),
+				})
+		}
+	case "WorkspaceSelectionStringCondition":
+		newCondition.StringCondition = &WorkspaceGenericCondition{
+			Field:    types.StringValue(string(condition.StringCondition.Field)),
+			Operator: types.StringValue(string(condition.StringCondition.Operator)),
+			Values:   ConvertListValue(condition.StringCondition.ValuesStringSlice()),
+		}
+	case "WorkspaceSelectionIntCondition":
+		newCondition.IntCondition = &WorkspaceGenericCondition{
+			Field:    types.StringValue(string(condition.IntCondition.Field)),
+			Operator: types.StringValue(string(condition.IntCondition.Operator)),
+			Values:   ConvertListValueInt32(condition.IntCondition.Values),
+		}
+	case "WorkspaceSelectionRatingCondition":
+		newCondition.RatingCondition = &WorkspaceGenericCondition{
+			Field:    types.StringValue(string(condition.RatingCondition.Field)),
+			Operator: types.StringValue(string(condition.RatingCondition.Operator)),
+			Values:   ConvertListValue(condition.RatingCondition.Values),
+		}
+	case "WorkspaceSelectionDateCondition":
+		newCondition.DateCondition = &WorkspaceDateCondition{
+			Field:        types.StringValue(condition.DateCondition.Field),
+			Operator:     types.StringValue(condition.DateCondition.Operator),
+			Value:        types.StringNull(),
+			RelativeDays: types.Int32PointerValue(condition.DateCondition.RelativeDays),
+		}
+		if value := condition.DateCondition.Value; value != nil && *value != "" {
+			if t, err := time.Parse(time.RFC3339, *value); err == nil {
+				newCondition.DateCondition.Value = types.StringValue(t.UTC().Format(time.DateOnly))
+			}
+		}
+	}
+	return newCondition
+}
+
+func renderSelectionsFromModel(data *WorkspaceResourceModel) WorkspaceSelectionsInput {
+	selectionInput := WorkspaceSelectionsInput{
+		Selections: []WorkspaceSelectionInput{},
 	}
 	for _, selection := range data.Selections {
-		newSelection := mondoov1.WorkspaceSelectionInput{
-			Conditions: []mondoov1.WorkspaceSelectionConditionInput{},
+		newSelection := WorkspaceSelectionInput{
+			Conditions: []WorkspaceSelectionConditionInput{},
 		}
 		for _, condition := range selection.Conditions {
-
-			newCondition := mondoov1.WorkspaceSelectionConditionInput{
-				Operator: mondoov1.WorkspaceSelectionConditionOperator(condition.Operator.ValueString()),
-			}
-			if condition.KeyValueCondition != nil {
-				var values []mondoov1.KeyValueInput
-				for _, kv := range condition.KeyValueCondition.Values {
-					values = append(values, mondoov1.KeyValueInput{
-						Key:   mondoov1.String(kv.Key.ValueString()),
-						Value: mondoov1.NewStringPtr(mondoov1.String(kv.Value.ValueString())),
-					})
-				}
-				newCondition.KeyValueCondition = &mondoov1.WorkspaceSelectionKeyValueConditionInput{
-					Field:    mondoov1.WorkspaceSelectionConditionKeyValueField(condition.KeyValueCondition.Field.ValueString()),
-					Operator: mondoov1.WorkspaceSelectionConditionKeyValueOperator(condition.KeyValueCondition.Operator.ValueString()),
-					Values:   values,
-				}
-			}
-			if condition.IntCondition != nil {
-				newCondition.IntCondition = &mondoov1.WorkspaceSelectionIntConditionInput{
-					Field:    mondoov1.WorkspaceSelectionConditionIntField(condition.IntCondition.Field.ValueString()),
-					Operator: mondoov1.WorkspaceSelectionConditionNumericOperator(condition.IntCondition.Operator.ValueString()),
-					Values:   ConvertSlice[mondoov1.Int](condition.IntCondition.Values),
+			newCondition := renderLeafConditionFromModel(condition.WorkspaceLeafConditionModel)
+			newCondition.Operator = ToPtr(mondoov1.WorkspaceSelectionConditionOperator(condition.Operator.ValueString()))
+			if condition.Group != nil {
+				group := &WorkspaceSelectionGroupConditionInput{
+					Operator:   WorkspaceSelectionGroupOperator(condition.Group.Operator.ValueString()),
+					Conditions: []WorkspaceSelectionConditionInput{},
 				}
-			}
-			if condition.StringCondition != nil {
-				newCondition.StringCondition = &mondoov1.WorkspaceSelectionStringConditionInput{
-					Field:    mondoov1.WorkspaceSelectionConditionStringField(condition.StringCondition.Field.ValueString()),
-					Operator: mondoov1.WorkspaceSelectionConditionStringOperator(condition.StringCondition.Operator.ValueString()),
-					Values:   ConvertSlice[mondoov1.String](condition.StringCondition.Values),
-				}
-			}
-			if condition.RatingCondition != nil {
-				newCondition.RatingCondition = &mondoov1.WorkspaceSelectionRatingConditionInput{
-					Field:    mondoov1.WorkspaceSelectionConditionRatingField(condition.RatingCondition.Field.ValueString()),
-					Operator: mondoov1.WorkspaceSelectionConditionRatingOperator(condition.RatingCondition.Operator.ValueString()),
-					Values:   ConvertSlice[mondoov1.ScoreRating](condition.RatingCondition.Values),
+				for _, member := range condition.Group.Conditions {
+					newMember := renderLeafConditionFromModel(member.WorkspaceLeafConditionModel)
+					if member.Group != nil {
+						nested := &WorkspaceSelectionGroupConditionInput{
+							Operator:   WorkspaceSelectionGroupOperator(member.Group.Operator.ValueString()),
+							Conditions: []WorkspaceSelectionConditionInput{},
+						}
+						for _, nestedMember := range member.Group.Conditions {
+							nested.Conditions = append(nested.Conditions, renderLeafConditionFromModel(nestedMember))
+						}
+						newMember.GroupCondition = nested
+					}
+					group.Conditions = append(group.Conditions, newMember)
 				}
+				newCondition.GroupCondition = group
 			}
 			newSelection.Conditions = append(newSelection.Conditions, newCondition)
 		}
@@ -376,6 +711,122 @@ func renderSelectionsFromModel(data *WorkspaceResourceModel) mondoov1.WorkspaceS
 	return selectionInput
 }
 
+func renderLeafConditionFromModel(condition WorkspaceLeafConditionModel) WorkspaceSelectionConditionInput {
+	newCondition := WorkspaceSelectionConditionInput{}
+	if condition.KeyValueCondition != nil {
+		var values []mondoov1.KeyValueInput
+		for _, kv := range condition.KeyValueCondition.Values {
+			values = append(values, mondoov1.KeyValueInput{
+				Key:   mondoov1.String(kv.Key.ValueString()),
+				Value: mondoov1.NewStringPtr(mondoov1.String(kv.Value.ValueString())),
+			})
+		}
+		newCondition.KeyValueCondition = &mondoov1.WorkspaceSelectionKeyValueConditionInput{
+			Field:    mondoov1.WorkspaceSelectionConditionKeyValueField(condition.KeyValueCondition.Field.ValueString()),
+			Operator: mondoov1.WorkspaceSelectionConditionKeyValueOperator(condition.KeyValueCondition.Operator.ValueString()),
+			Values:   values,
+		}
+	}
+	if condition.IntCondition != nil {
+		newCondition.IntCondition = &mondoov1.WorkspaceSelectionIntConditionInput{
+			Field:    mondoov1.WorkspaceSelectionConditionIntField(condition.IntCondition.Field.ValueString()),
+			Operator: mondoov1.WorkspaceSelectionConditionNumericOperator(condition.IntCondition.Operator.ValueString()),
+			Values:   ConvertSlice[mondoov1.Int](condition.IntCondition.Values),
+		}
+	}
+	if condition.StringCondition != nil {
+		newCondition.StringCondition = &mondoov1.WorkspaceSelectionStringConditionInput{
+			Field:    mondoov1.WorkspaceSelectionConditionStringField(condition.StringCondition.Field.ValueString()),
+			Operator: mondoov1.WorkspaceSelectionConditionStringOperator(condition.StringCondition.Operator.ValueString()),
+			Values:   ConvertSlice[mondoov1.String](condition.StringCondition.Values),
+		}
+	}
+	if condition.RatingCondition != nil {
+		newCondition.RatingCondition = &mondoov1.WorkspaceSelectionRatingConditionInput{
+			Field:    mondoov1.WorkspaceSelectionConditionRatingField(condition.RatingCondition.Field.ValueString()),
+			Operator: mondoov1.WorkspaceSelectionConditionRatingOperator(condition.RatingCondition.Operator.ValueString()),
+			Values:   ConvertSlice[mondoov1.ScoreRating](condition.RatingCondition.Values),
+		}
+	}
+	if condition.DateCondition != nil {
+		newCondition.DateCondition = &WorkspaceSelectionDateConditionInput{
+			Field:    WorkspaceSelectionConditionDateField(condition.DateCondition.Field.ValueString()),
+			Operator: WorkspaceSelectionConditionDateOperator(condition.DateCondition.Operator.ValueString()),
+		}
+		if value := condition.DateCondition.Value.ValueString(); value != "" {
+			// dates are compared at the start of the day (UTC)
+			newCondition.DateCondition.Value = mondoov1.NewStringPtr(mondoov1.String(value + "T00:00:00Z"))
+		}
+		if !condition.DateCondition.RelativeDays.IsNull() {
+			newCondition.DateCondition.RelativeDays = ToPtr(mondoov1.Int(condition.DateCondition.RelativeDays.ValueInt32()))
+		}
+	}
+	return newCondition
+}
+
+type CreateWorkspaceInput struct {
+	OwnerMrn    mondoov1.String          `json:"ownerMrn"`
+	Name        mondoov1.String          `json:"name"`
+	Description *mondoov1.String         `json:"description,omitempty"`
+	Selections  WorkspaceSelectionsInput `json:"selections"`
+}
+type UpdateWorkspaceInput struct {
+	Mrn         mondoov1.String           `json:"mrn"`
+	Name        *mondoov1.String          `json:"name,omitempty"`
+	Description *mondoov1.String          `json:"description,omitempty"`
+	Selections  *WorkspaceSelectionsInput `json:"selections,omitempty"`
+}
+type WorkspaceSelectionsInput struct {
+	Selections []WorkspaceSelectionInput `json:"selections"`
+}
+type WorkspaceSelectionInput struct {
+	Conditions []WorkspaceSelectionConditionInput `json:"conditions"`
+}
+type WorkspaceSelectionConditionInput struct {
+	// Operator is only set for the top-level conditions of a selection, conditions
+	// of a group are joined by the operator of the group.
+	Operator          *mondoov1.WorkspaceSelectionConditionOperator      `json:"operator,omitempty"`
+	StringCondition   *mondoov1.WorkspaceSelectionStringConditionInput   `json:"stringCondition,omitempty"`
+	IntCondition      *mondoov1.WorkspaceSelectionIntConditionInput      `json:"intCondition,omitempty"`
+	RatingCondition   *mondoov1.WorkspaceSelectionRatingConditionInput   `json:"ratingCondition,omitempty"`
+	KeyValueCondition *mondoov1.WorkspaceSelectionKeyValueConditionInput `json:"keyValueCondition,omitempty"`
+	DateCondition     *WorkspaceSelectionDateConditionInput              `json:"dateCondition,omitempty"`
+	GroupCondition    *WorkspaceSelectionGroupConditionInput             `json:"groupCondition,omitempty"`
+}
+type WorkspaceSelectionDateConditionInput struct {
+	Field        WorkspaceSelectionConditionDateField    `json:"field"`
+	Operator     WorkspaceSelectionConditionDateOperator `json:"operator"`
+	Value        *mondoov1.String                        `json:"value,omitempty"`
+	RelativeDays *mondoov1.Int                           `json:"relativeDays,omitempty"`
+}
+type WorkspaceSelectionGroupConditionInput struct {
+	Operator   WorkspaceSelectionGroupOperator    `json:"operator"`
+	Conditions []WorkspaceSelectionConditionInput `json:"conditions"`
+}
+
+type WorkspaceSelectionConditionDateField string
+
+const (
+	WorkspaceSelectionConditionDateFieldLastScanned WorkspaceSelectionConditionDateField = "LAST_SCANNED"
+	WorkspaceSelectionConditionDateFieldCreated     WorkspaceSelectionConditionDateField = "CREATED"
+	WorkspaceSelectionConditionDateFieldLastUpdated WorkspaceSelectionConditionDateField = "LAST_UPDATED"
+)
+
+type WorkspaceSelectionConditionDateOperator string
+
+const (
+	WorkspaceSelectionConditionDateOperatorBefore WorkspaceSelectionConditionDateOperator = "BEFORE"
+	WorkspaceSelectionConditionDateOperatorAfter  WorkspaceSelectionConditionDateOperator = "AFTER"
+)
+
+type WorkspaceSelectionGroupOperator string
+
+const (
+	WorkspaceSelectionGroupOperatorAnd WorkspaceSelectionGroupOperator = "AND"
+	WorkspaceSelectionGroupOperatorOr  WorkspaceSelectionGroupOperator = "OR"
+	WorkspaceSelectionGroupOperatorNot WorkspaceSelectionGroupOperator = "NOT"
+)
+
 type Workspace struct {
 	Mrn         string              `json:"mrn"`
 	OwnerMrn    string              `json:"ownerMrn"`
@@ -394,11 +845,16 @@ type WorkspaceCondition struct {
 	Condition Condition
 }
 type Condition struct {
+	WorkspaceLeafCondition
+	GroupCondition WorkspaceSelectionGroupCondition `graphql:"... on WorkspaceSelectionGroupCondition"`
+}
+type WorkspaceLeafCondition struct {
 	Typename          mondoov1.String                     `graphql:"__typename"`
 	StringCondition   WorkspaceSelectionStringCondition   `graphql:"... on WorkspaceSelectionStringCondition"`
 	IntCondition      WorkspaceSelectionIntCondition      `graphql:"... on WorkspaceSelectionIntCondition"`
 	RatingCondition   WorkspaceSelectionRatingCondition   `graphql:"... on WorkspaceSelectionRatingCondition"`
 	KeyValueCondition WorkspaceSelectionKeyValueCondition `graphql:"... on WorkspaceSelectionKeyValueCondition"`
+	DateCondition     WorkspaceSelectionDateCondition     `graphql:"... on WorkspaceSelectionDateCondition"`
 }
 type WorkspaceSelectionKeyValueCondition struct {
 	Field    mondoov1.WorkspaceSelectionConditionKeyValueField    `graphql:"keyValueField: field"`
@@ -433,6 +889,34 @@ type WorkspaceSelectionRatingCondition struct {
 	Values   []string                                           `graphql:"ratingValues: values"`
 }
 
+type WorkspaceSelectionDateCondition struct {
+	Field        string  `graphql:"dateField: field"`
+	Operator     string  `graphql:"dateOperator: operator"`
+	Value        *string `graphql:"dateValue: value"`
+	RelativeDays *int32  `graphql:"dateRelativeDays: relativeDays"`
+}
+
+// The GraphQL query needs a distinct type for every level of nesting, groups
+// can be nested one level deep.
+type WorkspaceSelectionGroupCondition struct {
+	Operator   string                        `graphql:"groupOperator: operator"`
+	Conditions []WorkspaceGroupConditionItem `graphql:"groupConditions: conditions"`
+}
+type WorkspaceGroupConditionItem struct {
+	Condition WorkspaceGroupCondition
+}
+type WorkspaceGroupCondition struct {
+	WorkspaceLeafCondition
+	GroupCondition WorkspaceSelectionNestedGroupCondition `graphql:"... on WorkspaceSelectionGroupCondition"`
+}
+type WorkspaceSelectionNestedGroupCondition struct {
+	Operator   string                              `graphql:"groupOperator: operator"`
+	Conditions []WorkspaceNestedGroupConditionItem `graphql:"groupConditions: conditions"`
+}
+type WorkspaceNestedGroupConditionItem struct {
+	Condition WorkspaceLeafCondition
+}
+
 func (r *WorkspaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data WorkspaceResourceModel
 
@@ -453,7 +937,7 @@ func (r *WorkspaceResource) Create(ctx context.Context, req resource.CreateReque
 
 	// Do GraphQL request to API to create the resource
 
-	createInput := mondoov1.CreateWorkspaceInput{
+	createInput := CreateWorkspaceInput{
 		OwnerMrn:    mondoov1.String(space.MRN()),
 		Name:        mondoov1.String(data.Name.ValueString()),
 		Description: mondoov1.NewStringPtr(mondoov1.String(data.Description.ValueString())),
@@ -464,13 +948,7 @@ func (r *WorkspaceResource) Create(ctx context.Context, req resource.CreateReque
 		"input": fmt.Sprintf("%+v", createInput),
 	})
 
-	var createMutation struct {
-		Workspace struct {
-			Workspace
-		} `graphql:"createWorkspace(input: $input)"`
-	}
-
-	err = r.client.Mutate(ctx, &createMutation, createInput, nil)
+	workspace, err := r.client.CreateWorkspace(ctx, createInput)
 	if err != nil {
 		resp.Diagnostics.
 			AddError("Client Error",
@@ -481,14 +959,18 @@ func (r *WorkspaceResource) Create(ctx context.Context, req resource.CreateReque
 
 	// Write logs using the tflog package
 	tflog.Debug(ctx, "created workspace", map[string]interface{}{
-		"response": fmt.Sprintf("%+v", createMutation),
+		"response": fmt.Sprintf("%+v", workspace),
 	})
 	// Save space mrn into the Terraform state.
-	data.SpaceID = types.StringValue(SpaceFrom(createMutation.Workspace.OwnerMrn).ID())
-	data.Mrn = types.StringValue(createMutation.Workspace.Mrn)
-	data.Name = types.StringValue(createMutation.Workspace.Name)
-	data.Description = types.StringValue(createMutation.Workspace.Description)
-	data.Selections = renderSelectionsFromGraphql(createMutation.Workspace.Selections)
+	data.SpaceID = types.StringValue(space.ID())
+	data.Mrn = types.StringValue(workspace.Mrn)
+	data.Name = types.StringValue(workspace.Name)
+	data.Description = types.StringValue(workspace.Description)
+	data.Selections = renderSelectionsFromGraphql(workspace.Selections)
+
+	var diags diag.Diagnostics
+	data.AssetCount, diags = r.assetCount(ctx, workspace.OwnerMrn, &data)
+	resp.Diagnostics.Append(diags...)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -517,13 +999,35 @@ func (r *WorkspaceResource) queryWorkspace(ctx context.Context, mrn string) (Wor
 		"payload": fmt.Sprintf("%+v", q),
 	})
 
-	return WorkspaceResourceModel{
-		SpaceID:     types.StringValue(SpaceFrom(q.Workspace.OwnerMrn).ID()),
-		Mrn:         types.StringValue(q.Workspace.Mrn),
-		Name:        types.StringValue(q.Workspace.Name),
-		Description: types.StringValue(q.Workspace.Description),
-		Selections:  renderSelectionsFromGraphql(q.Workspace.Selections),
-	}, nil
+	space, err := SpaceFrom(q.Workspace.OwnerMrn)
+	if err != nil {
+		return WorkspaceResourceModel{}, err
+	}
+
+	m := WorkspaceResourceModel{
+		SpaceID:       types.StringValue(space.ID()),
+		Mrn:           types.StringValue(q.Workspace.Mrn),
+		Name:          types.StringValue(q.Workspace.Name),
+		Description:   types.StringValue(q.Workspace.Description),
+		Selections:    renderSelectionsFromGraphql(q.Workspace.Selections),
+		PreviewAssets: types.BoolValue(false),
+	}
+	return m, nil
+}
+
+// assetCount returns the number of assets in the scope matched by the asset selections of the
+// workspace. Counting is best-effort, the count is null with a warning if it fails, so that the
+// workspace itself can still be managed.
+func (r *WorkspaceResource) assetCount(ctx context.Context, scopeMrn string, data *WorkspaceResourceModel) (types.Int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	preview, err := r.client.PreviewWorkspaceSelections(ctx, scopeMrn, renderSelectionsFromModel(data), 0)
+	if err != nil {
+		diags.AddWarning("Unable to count assets of workspace",
+			fmt.Sprintf("The asset_count attribute is not set. Got error: %s", err),
+		)
+		return types.Int64Null(), diags
+	}
+	return types.Int64Value(int64(preview.TotalCount)), diags
 }
 
 func (r *WorkspaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -545,6 +1049,15 @@ func (r *WorkspaceResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	// preview_assets only affects plans and is not stored by the API
+	if !data.PreviewAssets.IsNull() {
+		m.PreviewAssets = data.PreviewAssets
+	}
+
+	var diags diag.Diagnostics
+	m.AssetCount, diags = r.assetCount(ctx, Space(m.SpaceID.ValueString()).MRN(), &m)
+	resp.Diagnostics.Append(diags...)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &m)...)
 }
@@ -571,7 +1084,7 @@ func (r *WorkspaceResource) Update(ctx context.Context, req resource.UpdateReque
 	// Do GraphQL request to API to create the resource
 
 	selections := renderSelectionsFromModel(&data)
-	updateInput := mondoov1.UpdateWorkspaceInput{
+	updateInput := UpdateWorkspaceInput{
 		Mrn:         mondoov1.String(data.Mrn.ValueString()),
 		Name:        mondoov1.NewStringPtr(mondoov1.String(data.Name.ValueString())),
 		Description: mondoov1.NewStringPtr(mondoov1.String(data.Description.ValueString())),
@@ -602,12 +1115,19 @@ func (r *WorkspaceResource) Update(ctx context.Context, req resource.UpdateReque
 		"response": fmt.Sprintf("%+v", createMutation),
 	})
 	// Save space mrn into the Terraform state.
-	data.SpaceID = types.StringValue(SpaceFrom(createMutation.Workspace.OwnerMrn).ID())
+	data.SpaceID = types.StringValue(space.ID())
 	data.Mrn = types.StringValue(createMutation.Workspace.Mrn)
 	data.Name = types.StringValue(createMutation.Workspace.Name)
 	data.Description = types.StringValue(createMutation.Workspace.Description)
 	data.Selections = renderSelectionsFromGraphql(createMutation.Workspace.Selections)
 
+	// the planned count is kept unless the selections changed, see ModifyPlan
+	if data.AssetCount.IsUnknown() {
+		var diags diag.Diagnostics
+		data.AssetCount, diags = r.assetCount(ctx, createMutation.Workspace.OwnerMrn, &data)
+		resp.Diagnostics.Append(diags...)
+	}
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -657,6 +1177,10 @@ func (r *WorkspaceResource) ImportState(ctx context.Context, req resource.Import
 		return
 	}
 
+	var diags diag.Diagnostics
+	m.AssetCount, diags = r.assetCount(ctx, Space(m.SpaceID.ValueString()).MRN(), &m)
+	resp.Diagnostics.Append(diags...)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &m)...)
 }
 
@@ -713,3 +1237,23 @@ func displayPossibleKeyValueFields() []mondoov1.WorkspaceSelectionConditionKeyVa
 		mondoov1.WorkspaceSelectionConditionKeyValueFieldAnnotations,
 	}
 }
+func displayPossibleDateFields() []WorkspaceSelectionConditionDateField {
+	return []WorkspaceSelectionConditionDateField{
+		WorkspaceSelectionConditionDateFieldLastScanned,
+		WorkspaceSelectionConditionDateFieldCreated,
+		WorkspaceSelectionConditionDateFieldLastUpdated,
+	}
+}
+func displayPossibleDateOperators() []WorkspaceSelectionConditionDateOperator {
+	return []WorkspaceSelectionConditionDateOperator{
+		WorkspaceSelectionConditionDateOperatorBefore,
+		WorkspaceSelectionConditionDateOperatorAfter,
+	}
+}
+func displayPossibleGroupOperators() []WorkspaceSelectionGroupOperator {
+	return []WorkspaceSelectionGroupOperator{
+		WorkspaceSelectionGroupOperatorAnd,
+		WorkspaceSelectionGroupOperatorOr,
+		WorkspaceSelectionGroupOperatorNot,
+	}
+}
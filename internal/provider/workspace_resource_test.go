@@ -8,7 +8,12 @@ import (
 	"math/rand"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	mondoov1 "go.mondoo.com/mondoo-go"
 )
 
 func TestAccWorkspaceResource(t *testing.T) {
@@ -29,6 +34,7 @@ func TestAccWorkspaceResource(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("mondoo_workspace.test", "name", randName1),
 					resource.TestCheckResourceAttr("mondoo_workspace.test", "space_id", accSpace.ID()),
+					resource.TestCheckResourceAttrSet("mondoo_workspace.test", "asset_count"),
 				),
 			},
 			// Update and Read testing
@@ -39,6 +45,17 @@ func TestAccWorkspaceResource(t *testing.T) {
 					resource.TestCheckResourceAttr("mondoo_workspace.test", "space_id", accSpace.ID()),
 				),
 			},
+			// Update with date conditions and condition groups
+			{
+				Config: testAccWorkspaceResourceWithGroupsConfig(accSpace.ID(), randName1Updated),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mondoo_workspace.test", "asset_selections.0.conditions.0.date_condition.relative_days", "30"),
+					resource.TestCheckResourceAttr("mondoo_workspace.test", "asset_selections.0.conditions.1.group.operator", "OR"),
+					resource.TestCheckResourceAttr("mondoo_workspace.test", "asset_selections.0.conditions.1.group.conditions.#", "2"),
+					resource.TestCheckResourceAttr("mondoo_workspace.test", "asset_selections.0.conditions.1.group.conditions.1.group.operator", "NOT"),
+					resource.TestCheckResourceAttrSet("mondoo_workspace.test", "asset_count"),
+				),
+			},
 			// Create and Read testing
 			{
 				Config: testAccWorkspaceResourceWithSpaceInProviderConfig(accSpace.ID(), randName2, "qa"),
@@ -118,3 +135,262 @@ resource "mondoo_workspace" "test" {
 }
 `, spaceID, name, env)
 }
+
+func testAccWorkspaceResourceWithGroupsConfig(spaceID, name string) string {
+	return fmt.Sprintf(`
+resource "mondoo_workspace" "test" {
+  space_id         = %[1]q
+  name             = %[2]q
+  preview_assets   = true
+  asset_selections = [
+    {
+      conditions = [
+        {
+          operator = "AND"
+          date_condition = {
+            field         = "LAST_SCANNED"
+            operator      = "BEFORE"
+            relative_days = 30
+          }
+        },
+        {
+          operator = "AND"
+          group = {
+            operator = "OR"
+            conditions = [
+              {
+                string_condition = {
+                  field    = "PLATFORM"
+                  operator = "EQUAL"
+                  values   = ["debian"]
+                }
+              },
+              {
+                group = {
+                  operator = "NOT"
+                  conditions = [
+                    {
+                      date_condition = {
+                        field    = "CREATED"
+                        operator = "AFTER"
+                        value    = "2024-01-01"
+                      }
+                    }
+                  ]
+                }
+              }
+            ]
+          }
+        }
+      ]
+    }
+  ]
+}
+`, spaceID, name)
+}
+
+func TestValidateWorkspaceResourceModel(t *testing.T) {
+	stringCondition := &WorkspaceGenericCondition{
+		Field:    types.StringValue("PLATFORM"),
+		Operator: types.StringValue("EQUAL"),
+		Values:   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("debian")}),
+	}
+	dateCondition := &WorkspaceDateCondition{
+		Field:        types.StringValue("LAST_SCANNED"),
+		Operator:     types.StringValue("BEFORE"),
+		Value:        types.StringNull(),
+		RelativeDays: types.Int32Value(30),
+	}
+	model := func(conditions ...WorkspaceConditionModel) *WorkspaceResourceModel {
+		return &WorkspaceResourceModel{
+			Selections: []WorkspaceSelectionModel{{Conditions: conditions}},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		data    *WorkspaceResourceModel
+		summary string
+	}{
+		{
+			name: "single condition",
+			data: model(WorkspaceConditionModel{
+				Operator:                    types.StringValue("AND"),
+				WorkspaceLeafConditionModel: WorkspaceLeafConditionModel{DateCondition: dateCondition},
+			}),
+		},
+		{
+			name: "nested groups",
+			data: model(WorkspaceConditionModel{
+				Operator: types.StringValue("AND"),
+				Group: &WorkspaceConditionGroupModel{
+					Operator: types.StringValue("OR"),
+					Conditions: []WorkspaceGroupConditionModel{
+						{WorkspaceLeafConditionModel: WorkspaceLeafConditionModel{StringCondition: stringCondition}},
+						{Group: &WorkspaceNestedConditionGroupModel{
+							Operator:   types.StringValue("NOT"),
+							Conditions: []WorkspaceLeafConditionModel{{DateCondition: dateCondition}},
+						}},
+					},
+				},
+			}),
+		},
+		{
+			name:    "empty condition",
+			data:    model(WorkspaceConditionModel{Operator: types.StringValue("AND")}),
+			summary: "MissingAttributeError",
+		},
+		{
+			name: "condition and group",
+			data: model(WorkspaceConditionModel{
+				Operator:                    types.StringValue("AND"),
+				WorkspaceLeafConditionModel: WorkspaceLeafConditionModel{StringCondition: stringCondition},
+				Group: &WorkspaceConditionGroupModel{
+					Operator:   types.StringValue("OR"),
+					Conditions: []WorkspaceGroupConditionModel{{WorkspaceLeafConditionModel: WorkspaceLeafConditionModel{DateCondition: dateCondition}}},
+				},
+			}),
+			summary: "ConflictingAttributesError",
+		},
+		{
+			name: "two conditions in nested group member",
+			data: model(WorkspaceConditionModel{
+				Operator: types.StringValue("AND"),
+				Group: &WorkspaceConditionGroupModel{
+					Operator: types.StringValue("OR"),
+					Conditions: []WorkspaceGroupConditionModel{
+						{Group: &WorkspaceNestedConditionGroupModel{
+							Operator: types.StringValue("NOT"),
+							Conditions: []WorkspaceLeafConditionModel{
+								{StringCondition: stringCondition, DateCondition: dateCondition},
+							},
+						}},
+					},
+				},
+			}),
+			summary: "ConflictingAttributesError",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diagnostics := validateWorkspaceResourceModel(tt.data)
+			if tt.summary == "" {
+				assert.False(t, diagnostics.HasError())
+				return
+			}
+			require.Len(t, diagnostics, 1)
+			assert.Equal(t, tt.summary, diagnostics[0].Summary())
+		})
+	}
+}
+
+func TestRenderWorkspaceSelections(t *testing.T) {
+	data := &WorkspaceResourceModel{
+		Selections: []WorkspaceSelectionModel{{
+			Conditions: []WorkspaceConditionModel{
+				{
+					Operator: types.StringValue("AND"),
+					WorkspaceLeafConditionModel: WorkspaceLeafConditionModel{DateCondition: &WorkspaceDateCondition{
+						Field:        types.StringValue("CREATED"),
+						Operator:     types.StringValue("AFTER"),
+						Value:        types.StringValue("2024-01-01"),
+						RelativeDays: types.Int32Null(),
+					}},
+				},
+				{
+					Operator: types.StringValue("AND_NOT"),
+					Group: &WorkspaceConditionGroupModel{
+						Operator: types.StringValue("OR"),
+						Conditions: []WorkspaceGroupConditionModel{{
+							Group: &WorkspaceNestedConditionGroupModel{
+								Operator: types.StringValue("AND"),
+								Conditions: []WorkspaceLeafConditionModel{{DateCondition: &WorkspaceDateCondition{
+									Field:        types.StringValue("LAST_SCANNED"),
+									Operator:     types.StringValue("BEFORE"),
+									Value:        types.StringNull(),
+									RelativeDays: types.Int32Value(7),
+								}}},
+							},
+						}},
+					},
+				},
+			},
+		}},
+	}
+
+	input := renderSelectionsFromModel(data)
+	require.Len(t, input.Selections, 1)
+	conditions := input.Selections[0].Conditions
+	require.Len(t, conditions, 2)
+	assert.Equal(t, mondoov1.WorkspaceSelectionConditionOperator("AND"), *conditions[0].Operator)
+	assert.Equal(t, mondoov1.String("2024-01-01T00:00:00Z"), *conditions[0].DateCondition.Value)
+	assert.Nil(t, conditions[0].DateCondition.RelativeDays)
+
+	group := conditions[1].GroupCondition
+	require.NotNil(t, group)
+	assert.Equal(t, WorkspaceSelectionGroupOperatorOr, group.Operator)
+	require.Len(t, group.Conditions, 1)
+	assert.Nil(t, group.Conditions[0].Operator)
+	nested := group.Conditions[0].GroupCondition
+	require.NotNil(t, nested)
+	require.Len(t, nested.Conditions, 1)
+	assert.Equal(t, mondoov1.Int(7), *nested.Conditions[0].DateCondition.RelativeDays)
+
+	value := "2024-01-01T00:00:00Z"
+	relativeDays := int32(7)
+	response := WorkspaceSelections{Selections: []WorkspaceSelection{{
+		Conditions: []WorkspaceCondition{
+			{
+				Operator: "AND",
+				Condition: Condition{WorkspaceLeafCondition: WorkspaceLeafCondition{
+					Typename: "WorkspaceSelectionDateCondition",
+					DateCondition: WorkspaceSelectionDateCondition{
+						Field: "CREATED", Operator: "AFTER", Value: &value,
+					},
+				}},
+			},
+			{
+				Operator: "AND_NOT",
+				Condition: Condition{
+					WorkspaceLeafCondition: WorkspaceLeafCondition{Typename: "WorkspaceSelectionGroupCondition"},
+					GroupCondition: WorkspaceSelectionGroupCondition{
+						Operator: "OR",
+						Conditions: []WorkspaceGroupConditionItem{{Condition: WorkspaceGroupCondition{
+							WorkspaceLeafCondition: WorkspaceLeafCondition{Typename: "WorkspaceSelectionGroupCondition"},
+							GroupCondition: WorkspaceSelectionNestedGroupCondition{
+								Operator: "AND",
+								Conditions: []WorkspaceNestedGroupConditionItem{{Condition: WorkspaceLeafCondition{
+									Typename: "WorkspaceSelectionDateCondition",
+									DateCondition: WorkspaceSelectionDateCondition{
+										Field: "LAST_SCANNED", Operator: "BEFORE", RelativeDays: &relativeDays,
+									},
+								}}},
+							},
+						}}},
+					},
+				},
+			},
+		},
+	}}}
+
+	assert.Equal(t, data.Selections, renderSelectionsFromGraphql(response))
+}
+
+func TestRenderWorkspacePreview(t *testing.T) {
+	assert.Equal(t, "The asset selections of workspace prod match 0 assets.",
+		renderWorkspacePreview("prod", WorkspaceSelectionPreviewPayload{}))
+
+	preview := WorkspaceSelectionPreviewPayload{
+		TotalCount: 3,
+		Assets: []WorkspaceSelectionPreviewAsset{
+			{Mrn: "//assets.api.mondoo.app/spaces/test/assets/1", Name: "web-1"},
+			{Mrn: "//assets.api.mondoo.app/spaces/test/assets/2", Name: "web-2"},
+		},
+	}
+	assert.Equal(t, "The asset selections of workspace prod match 3 assets.\n"+
+		"\n  - web-1 (//assets.api.mondoo.app/spaces/test/assets/1)"+
+		"\n  - web-2 (//assets.api.mondoo.app/spaces/test/assets/2)"+
+		"\n  ... and 1 more",
+		renderWorkspacePreview("prod", preview))
+}